@@ -180,6 +180,14 @@ export default defineConfig({
               text: '⚡ Commands',
               link: '/developers/commands',
             },
+            {
+              text: '📊 Scoreboards',
+              link: '/developers/scoreboards',
+            },
+            {
+              text: '💬 Dialogs',
+              link: '/developers/dialogs',
+            },
             {
               text: '💡 Examples',
               link: '/developers/examples/simple-proxy',
@@ -217,6 +225,18 @@ export default defineConfig({
               text: '🌐 ForcedHosts Routing',
               link: '/guide/forced-hosts',
             },
+            {
+              text: '🩺 Server Health Checks',
+              link: '/guide/health-checks',
+            },
+            {
+              text: '🔍 Server Discovery',
+              link: '/guide/discovery',
+            },
+            {
+              text: '🎨 Resource Packs',
+              link: '/guide/resource-packs',
+            },
           ],
         },
         {
@@ -287,6 +307,14 @@ export default defineConfig({
               text: 'Commands',
               link: '/developers/commands',
             },
+            {
+              text: 'Scoreboards',
+              link: '/developers/scoreboards',
+            },
+            {
+              text: 'Dialogs',
+              link: '/developers/dialogs',
+            },
           ],
         },
         {
@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/zapr"
@@ -54,6 +56,7 @@ Visit the website https://gate.minekube.com/ for more information.`
 		configFile  string
 		verbosity   int
 		showVersion bool
+		noConsole   bool
 	)
 	app.Flags = []cli.Flag{
 		&cli.StringFlag{
@@ -77,6 +80,12 @@ Visit the website https://gate.minekube.com/ for more information.`
 			EnvVars:     []string{"GATE_VERBOSITY"},
 			Destination: &verbosity,
 		},
+		&cli.BoolFlag{
+			Name:        "no-console",
+			Usage:       "Disable reading proxy commands from standard input",
+			EnvVars:     []string{"GATE_NO_CONSOLE"},
+			Destination: &noConsole,
+		},
 		&cli.BoolFlag{
 			Name:        "version",
 			Aliases:     []string{"V"},
@@ -116,11 +125,19 @@ Visit the website https://gate.minekube.com/ for more information.`
 			verbosity = math.MaxInt8
 		}
 
+		// Log output goes through the console so that it does not garble the input line.
+		var con *gate.Console
+		logOut := io.Writer(os.Stderr)
+		if !noConsole {
+			con = gate.NewConsole(os.Stdin, os.Stderr)
+			logOut = con
+		}
+
 		// Create or get logger
 
 		var log logr.Logger
 		if log, err = logr.FromContext(c.Context); err != nil {
-			log, err = newLogger(debug, verbosity)
+			log, err = newLogger(logOut, debug, verbosity)
 
 			if err != nil {
 				return cli.Exit(fmt.Errorf("error creating zap logger: %w", err), 1)
@@ -135,10 +152,14 @@ Visit the website https://gate.minekube.com/ for more information.`
 		log.Info("using config file", "config", v.ConfigFileUsed())
 
 		// Start Gate
-		if err = gate.Start(c.Context,
+		opts := []gate.StartOption{
 			gate.WithConfig(*cfg),
 			gate.WithAutoConfigReload(v.ConfigFileUsed()),
-		); err != nil {
+		}
+		if con != nil {
+			opts = append(opts, gate.WithConsole(con))
+		}
+		if err = gate.Start(c.Context, opts...); err != nil {
 			return cli.Exit(fmt.Errorf("error running Gate: %w", err), 1)
 		}
 		return nil
@@ -161,9 +182,9 @@ func initViper(c *cli.Context, configFile string) (*viper.Viper, error) {
 	return v, nil
 }
 
-// newLogger returns a new zap logger with a modified production
+// newLogger returns a new zap logger writing to w with a modified production
 // or development default config to ensure human readability.
-func newLogger(debug bool, v int) (l logr.Logger, err error) {
+func newLogger(w io.Writer, debug bool, v int) (l logr.Logger, err error) {
 	var cfg zap.Config
 	if debug {
 		cfg = zap.NewDevelopmentConfig()
@@ -176,7 +197,23 @@ func newLogger(debug bool, v int) (l logr.Logger, err error) {
 	cfg.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
 	cfg.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
 
-	zl, err := cfg.Build()
+	zl, err := cfg.Build(zap.WrapCore(func(zapcore.Core) zapcore.Core {
+		// Replace the built core writing to stderr with one writing to w,
+		// keeping the sampling of the config that was applied to the built core.
+		var core zapcore.Core = zapcore.NewCore(
+			zapcore.NewConsoleEncoder(cfg.EncoderConfig),
+			zapcore.AddSync(w),
+			cfg.Level,
+		)
+		if s := cfg.Sampling; s != nil {
+			var opts []zapcore.SamplerOption
+			if s.Hook != nil {
+				opts = append(opts, zapcore.SamplerHook(s.Hook))
+			}
+			core = zapcore.NewSamplerWithOptions(core, time.Second, s.Initial, s.Thereafter, opts...)
+		}
+		return core
+	}))
 	if err != nil {
 		return logr.Discard(), err
 	}
@@ -7,6 +7,7 @@ import (
 	"net"
 	"os"
 	"sync"
+	"sync/atomic"
 
 	"github.com/go-logr/logr"
 	"github.com/pires/go-proxyproto"
@@ -34,6 +35,7 @@ type Integration struct {
 	mu             sync.RWMutex
 	unsubs         []func()
 	manager        *managed.Runner
+	listening      atomic.Bool
 }
 
 // GeyserConnection represents a connection from Geyser.
@@ -159,6 +161,12 @@ func (i *Integration) Stop() {
 	}
 }
 
+// Listening reports whether the integration is currently
+// listening for connections from Geyser.
+func (i *Integration) Listening() bool {
+	return i.listening.Load()
+}
+
 func (i *Integration) listenAndServe() error {
 	if i.ctx.Err() != nil {
 		return i.ctx.Err()
@@ -175,6 +183,9 @@ func (i *Integration) listenAndServe() error {
 	defer cancel()
 	go func() { <-ctx.Done(); _ = ln.Close() }()
 
+	i.listening.Store(true)
+	defer i.listening.Store(false)
+
 	defer i.log.Info("stopped listening for geyser connections", "addr", i.config.GeyserListenAddr)
 	i.log.Info("listening for geyser connections", "addr", i.config.GeyserListenAddr)
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sync"
 
 	"github.com/go-logr/logr"
 	"github.com/robinbraemer/event"
@@ -58,12 +59,27 @@ type Proxy struct {
 	event  event.Manager
 	config *config.Config
 
+	mu                sync.RWMutex // protects geyserIntegration
 	geyserIntegration *geyser.Integration
 	javaProxy         *jproxy.Proxy // Reference to Java proxy for integration
 }
 
 func (p *Proxy) Event() event.Manager { return p.event }
 
+// Ready reports whether the Geyser integration is running
+// and listening for connections from Geyser.
+func (p *Proxy) Ready() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.geyserIntegration != nil && p.geyserIntegration.Listening()
+}
+
+func (p *Proxy) setIntegration(integration *geyser.Integration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.geyserIntegration = integration
+}
+
 func (p *Proxy) Start(ctx context.Context) error {
 	p.log = logr.FromContextOrDiscard(ctx)
 
@@ -74,7 +90,7 @@ func (p *Proxy) Start(ctx context.Context) error {
 		return err
 	}
 
-	p.geyserIntegration = integration
+	p.setIntegration(integration)
 
 	if err := integration.Start(); err != nil {
 		p.log.Error(err, "failed to start geyser integration")
@@ -99,7 +115,7 @@ func (p *Proxy) Start(ctx context.Context) error {
 				p.log.Error(err, "failed to re-initialize geyser integration")
 				return
 			}
-			p.geyserIntegration = integ
+			p.setIntegration(integ)
 			if err := integ.Start(); err != nil {
 				p.log.Error(err, "failed to restart geyser integration")
 				return
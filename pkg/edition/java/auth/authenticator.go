@@ -12,14 +12,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"strings"
 	"sync"
 	"time"
 
-	"github.com/go-logr/logr"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -41,11 +39,20 @@ type Authenticator interface {
 	GenerateServerID(decryptedSharedSecret []byte) (serverID string, err error)
 	// AuthenticateJoin Authenticates a joining user. The ip is optional.
 	AuthenticateJoin(ctx context.Context, serverID, username, ip string) (Response, error)
-	// SetHasJoinedURLFn sets the HasJoinedURLFn.
+	// SetHasJoinedURLFn sets the HasJoinedURLFn, replacing the session servers.
 	// If not set, DefaultHasJoinedURL is used.
 	SetHasJoinedURLFn(fn HasJoinedURLFn)
 }
 
+// SessionServerSetter is implemented by the Authenticator returned by New
+// to replace the session servers at runtime, e.g. on config reload.
+type SessionServerSetter interface {
+	// SetSessionServers sets the session servers tried in order and how to fail over between them.
+	// If servers is empty, the official Mojang session server is used.
+	// Session servers that were already set keep their circuit breaker state.
+	SetSessionServers(servers []SessionServer, failover Failover)
+}
+
 // Response is the authentication response.
 type Response interface {
 	OnlineMode() bool // Whether the user is in online mode
@@ -101,6 +108,12 @@ type Options struct {
 	// online mode user.
 	// If not set, DefaultHasJoinedURL is used.
 	HasJoinedURLFn HasJoinedURLFn
+	// SessionServers are the session servers tried in order
+	// to authenticate a joining online mode user.
+	// If set, HasJoinedURLFn is ignored.
+	SessionServers []SessionServer
+	// Failover configures how requests fail over between SessionServers.
+	Failover Failover
 	// The servers private key.
 	// If none is set, a new one will be generated.
 	PrivateKey *rsa.PrivateKey
@@ -141,33 +154,75 @@ func New(options Options) (Authenticator, error) {
 	cli.Transport = otelhttp.NewTransport(cli.Transport)
 	cli.Transport = withHeader(cli.Transport, version.UserAgentHeader())
 
-	hasJoinedURLFn := options.HasJoinedURLFn
-	if hasJoinedURLFn == nil {
-		hasJoinedURLFn = DefaultHasJoinedURL
+	metrics, err := newSessionServerMetrics()
+	if err != nil {
+		return nil, fmt.Errorf("error creating session server metrics: %w", err)
 	}
 
-	return &authenticator{
-		private:        private,
-		public:         public,
-		cli:            cli,
-		hasJoinedURLFn: hasJoinedURLFn,
-	}, nil
+	a := &authenticator{
+		private: private,
+		public:  public,
+		cli:     cli,
+		metrics: metrics,
+	}
+	if len(options.SessionServers) != 0 {
+		a.SetSessionServers(options.SessionServers, options.Failover)
+	} else {
+		a.SetHasJoinedURLFn(options.HasJoinedURLFn)
+	}
+	return a, nil
 }
 
 type authenticator struct {
-	private        *rsa.PrivateKey
-	public         []byte // ASN.1 DER form encoded
-	cli            *http.Client
-	hasJoinedURLFn HasJoinedURLFn
+	private *rsa.PrivateKey
+	public  []byte // ASN.1 DER form encoded
+	cli     *http.Client
+	metrics *sessionServerMetrics
+
+	mu        sync.RWMutex // protects below fields
+	endpoints []*endpoint
+	failover  Failover
 }
 
-var _ Authenticator = (*authenticator)(nil)
+var (
+	_ Authenticator       = (*authenticator)(nil)
+	_ SessionServerSetter = (*authenticator)(nil)
+)
 
 func (a *authenticator) SetHasJoinedURLFn(fn HasJoinedURLFn) {
+	name := "custom"
 	if fn == nil {
 		fn = DefaultHasJoinedURL
+		name = defaultHasJoinedBaseURL.Host
 	}
-	a.hasJoinedURLFn = fn
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.endpoints = []*endpoint{{name: name, hasJoinedURL: fn}}
+	a.failover = Failover{}
+}
+
+func (a *authenticator) SetSessionServers(servers []SessionServer, failover Failover) {
+	if len(servers) == 0 {
+		servers = []SessionServer{{}}
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	endpoints := make([]*endpoint, 0, len(servers))
+	for _, s := range servers {
+		e := findEndpoint(a.endpoints, s)
+		if e == nil {
+			e = newEndpoint(s)
+		}
+		endpoints = append(endpoints, e)
+	}
+	a.endpoints = endpoints
+	a.failover = failover
+}
+
+func (a *authenticator) sessionServers() ([]*endpoint, Failover) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.endpoints, a.failover
 }
 
 func (a *authenticator) PublicKey() []byte {
@@ -196,49 +251,11 @@ func (a *authenticator) AuthenticateJoin(ctx context.Context, serverID, username
 	))
 	defer span.End()
 
-	hasJoinedURL := a.hasJoinedURLFn(serverID, username, ip)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, hasJoinedURL, nil)
+	resp, err := a.hasJoined(ctx, serverID, username, ip)
 	if err != nil {
-		return nil, fmt.Errorf("error creating authentication request: %w", err)
+		return nil, fmt.Errorf("error authenticating join with sessionserver: %w", err)
 	}
-
-	log := logr.FromContextOrDiscard(ctx).V(1).WithName("authnJoin").WithName("request")
-	log.Info("authenticating user against sessionserver", "url", hasJoinedURL)
-
-	start := time.Now()
-	resp, err := a.cli.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("error authenticating join with Mojang sessionserver: %w", err)
-	}
-	defer func() { _ = resp.Body.Close() }()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("error reading response body: %v", err)
-	}
-
-	switch resp.StatusCode {
-	case http.StatusOK:
-	case http.StatusUnauthorized:
-		// Player has invalid/outdated session auth token and
-		// should restart the game or re-login to Mojang.
-	case http.StatusNoContent:
-		log.Info("sessionserver could not find user, potentially offline mode")
-	default:
-		return nil, fmt.Errorf("got unexpected status code (%d) from Mojang sessionserver", resp.StatusCode)
-	}
-
-	onlineMode := resp.StatusCode == http.StatusOK && len(body) != 0
-
-	log.Info("user authenticated against sessionserver",
-		"onlineMode", onlineMode,
-		"time", time.Since(start).String(),
-		"statusCode", resp.StatusCode)
-
-	return &response{
-		onlineMode: onlineMode,
-		body:       body,
-	}, nil
+	return resp, nil
 }
 
 func (a *authenticator) GenerateServerID(decryptedSharedSecret []byte) (string, error) {
@@ -1,7 +1,15 @@
 package auth
 
 import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -18,3 +26,103 @@ func TestHasJoinedURL(t *testing.T) {
 		require.Equal(t, e.expected, actual)
 	}
 }
+
+// newSessionServer returns a session server responding with the status code after the delay.
+func newSessionServer(t *testing.T, status int, delay time.Duration) (SessionServer, *atomic.Int32) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			_, _ = w.Write([]byte(`{"id":"069a79f444e94726a5befca90e38aaf5","name":"Notch"}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL + "/session/minecraft/hasJoined")
+	require.NoError(t, err)
+	return SessionServer{URL: u}, &requests
+}
+
+func newTestAuthenticator(t *testing.T, failover Failover, servers ...SessionServer) Authenticator {
+	key, err := rsa.GenerateKey(rand.Reader, DefaultPrivateKeyBits)
+	require.NoError(t, err)
+	a, err := New(Options{PrivateKey: key, SessionServers: servers, Failover: failover})
+	require.NoError(t, err)
+	return a
+}
+
+func TestSessionServerFailover(t *testing.T) {
+	down, downRequests := newSessionServer(t, http.StatusServiceUnavailable, 0)
+	slow, _ := newSessionServer(t, http.StatusOK, time.Second)
+	slow.Timeout = 50 * time.Millisecond
+	up, upRequests := newSessionServer(t, http.StatusOK, 0)
+
+	a := newTestAuthenticator(t, Failover{FailureThreshold: 2, BreakDuration: time.Minute}, down, slow, up)
+	for i := 0; i < 3; i++ {
+		resp, err := a.AuthenticateJoin(context.Background(), "id", "Notch", "")
+		require.NoError(t, err)
+		require.True(t, resp.OnlineMode())
+		gp, err := resp.GameProfile()
+		require.NoError(t, err)
+		require.Equal(t, "Notch", gp.Name)
+	}
+	require.Equal(t, int32(3), upRequests.Load())
+	// skipped by the circuit breaker after 2 failures
+	require.Equal(t, int32(2), downRequests.Load())
+}
+
+func TestSessionServerUnauthorized(t *testing.T) {
+	unauthorized, _ := newSessionServer(t, http.StatusUnauthorized, 0)
+	up, upRequests := newSessionServer(t, http.StatusOK, 0)
+
+	a := newTestAuthenticator(t, Failover{}, unauthorized, up)
+	resp, err := a.AuthenticateJoin(context.Background(), "id", "Notch", "")
+	require.NoError(t, err)
+	require.False(t, resp.OnlineMode())
+	require.Zero(t, upRequests.Load(), "definitive responses must not fail over")
+}
+
+func TestSessionServerHedging(t *testing.T) {
+	slow, _ := newSessionServer(t, http.StatusOK, time.Second)
+	up, upRequests := newSessionServer(t, http.StatusOK, 0)
+
+	a := newTestAuthenticator(t, Failover{HedgeDelay: 20 * time.Millisecond}, slow, up)
+	start := time.Now()
+	resp, err := a.AuthenticateJoin(context.Background(), "id", "Notch", "")
+	require.NoError(t, err)
+	require.True(t, resp.OnlineMode())
+	require.Less(t, time.Since(start), time.Second/2)
+	require.Equal(t, int32(1), upRequests.Load())
+}
+
+func TestSessionServersAllDown(t *testing.T) {
+	down1, _ := newSessionServer(t, http.StatusInternalServerError, 0)
+	down2, _ := newSessionServer(t, http.StatusBadGateway, 0)
+
+	a := newTestAuthenticator(t, Failover{}, down1, down2)
+	_, err := a.AuthenticateJoin(context.Background(), "id", "Notch", "")
+	require.ErrorContains(t, err, "(500)")
+	require.ErrorContains(t, err, "(502)")
+}
+
+func TestSetSessionServersKeepsCircuitBreakers(t *testing.T) {
+	down, downRequests := newSessionServer(t, http.StatusServiceUnavailable, 0)
+	up, _ := newSessionServer(t, http.StatusOK, 0)
+	failover := Failover{FailureThreshold: 1, BreakDuration: time.Minute}
+
+	a := newTestAuthenticator(t, failover, down, up)
+	_, err := a.AuthenticateJoin(context.Background(), "id", "Notch", "")
+	require.NoError(t, err)
+	require.Equal(t, int32(1), downRequests.Load())
+
+	// e.g. on config reload
+	a.(SessionServerSetter).SetSessionServers([]SessionServer{down, up}, failover)
+	_, err = a.AuthenticateJoin(context.Background(), "id", "Notch", "")
+	require.NoError(t, err)
+	require.Equal(t, int32(1), downRequests.Load(), "must still be skipped by the circuit breaker")
+}
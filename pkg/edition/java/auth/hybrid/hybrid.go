@@ -0,0 +1,175 @@
+// Package hybrid decides per username whether a joining player is authenticated
+// online or let in offline, so that premium and cracked players can share a proxy.
+package hybrid
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// Mode is the mode a username logs in with.
+type Mode string
+
+const (
+	// Online authenticates the player with the session server.
+	Online Mode = "online"
+	// Offline lets the player in without authentication.
+	Offline Mode = "offline"
+)
+
+// Checker checks whether a username belongs to a premium account.
+type Checker interface {
+	IsPremium(ctx context.Context, username string) (bool, error)
+}
+
+// CheckerFunc is a function implementing Checker.
+type CheckerFunc func(ctx context.Context, username string) (bool, error)
+
+// IsPremium implements Checker.
+func (fn CheckerFunc) IsPremium(ctx context.Context, username string) (bool, error) {
+	return fn(ctx, username)
+}
+
+// Static is a Checker with a fixed set of premium usernames.
+// It is useful for tests and networks without access to a profile API.
+type Static map[string]struct{}
+
+// NewStatic returns a Static checker with the premium usernames.
+func NewStatic(premium ...string) Static {
+	s := make(Static, len(premium))
+	for _, name := range premium {
+		s[strings.ToLower(name)] = struct{}{}
+	}
+	return s
+}
+
+// IsPremium implements Checker.
+func (s Static) IsPremium(_ context.Context, username string) (bool, error) {
+	_, ok := s[strings.ToLower(username)]
+	return ok, nil
+}
+
+// Resolver resolves the Mode of joining usernames.
+//
+// Usernames once authenticated online are protected from offline impersonation:
+// they always log in online, even if the Checker reports them as not premium later on.
+// If the Checker fails, usernames last looked up or seen offline stay offline and
+// all other usernames must log in online.
+type Resolver struct {
+	checker  Checker
+	store    Store
+	cacheTTL time.Duration
+
+	mu    sync.Mutex
+	cache map[string]cachedCheck // by lower case username
+}
+
+type cachedCheck struct {
+	premium bool
+	expires time.Time
+}
+
+// staleTTL is how long expired cache entries are kept
+// as fallback for failed checks.
+const staleTTL = 24 * time.Hour
+
+// NewResolver returns a new Resolver caching the results of the Checker for cacheTTL,
+// 0 = not cached. If store is nil, modes are only kept in memory.
+func NewResolver(checker Checker, store Store, cacheTTL time.Duration) *Resolver {
+	if store == nil {
+		store = NewMemoryStore()
+	}
+	return &Resolver{checker: checker, store: store, cacheTTL: cacheTTL}
+}
+
+// Resolve returns the Mode the username must log in with.
+// Call Authenticated once the player was authenticated online
+// and LoggedInOffline once the player logged in offline.
+func (r *Resolver) Resolve(ctx context.Context, username string) Mode {
+	log := logr.FromContextOrDiscard(ctx).WithName("hybrid").WithValues("username", username)
+
+	stored, found := r.store.Get(username)
+	if found && stored == Online {
+		return Online
+	}
+
+	name := strings.ToLower(username)
+	r.mu.Lock()
+	c, cached := r.cache[name]
+	r.mu.Unlock()
+	if cached && time.Now().Before(c.expires) {
+		return mode(c.premium)
+	}
+
+	premium, err := r.check(ctx, username)
+	if err != nil {
+		if cached {
+			log.Info("could not check premium account, using last checked mode", "error", err.Error())
+			return mode(c.premium)
+		}
+		if found && stored == Offline {
+			log.Info("could not check premium account, using last seen offline mode", "error", err.Error())
+			return Offline
+		}
+		log.Info("could not check premium account, requiring online mode", "error", err.Error())
+		return Online
+	}
+	// Modes are recorded once the player logged in successfully,
+	// so that unauthenticated clients can't lock out cracked players
+	// and clients disconnecting during login don't fill the store.
+	return mode(premium)
+}
+
+func (r *Resolver) check(ctx context.Context, username string) (bool, error) {
+	premium, err := r.checker.IsPremium(ctx, username)
+	if err != nil || r.cacheTTL <= 0 {
+		return premium, err
+	}
+	now := time.Now()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cache == nil {
+		r.cache = map[string]cachedCheck{}
+	}
+	for n, e := range r.cache {
+		if now.Sub(e.expires) > staleTTL {
+			delete(r.cache, n)
+		}
+	}
+	r.cache[strings.ToLower(username)] = cachedCheck{premium: premium, expires: now.Add(r.cacheTTL)}
+	return premium, nil
+}
+
+func mode(premium bool) Mode {
+	if premium {
+		return Online
+	}
+	return Offline
+}
+
+// Authenticated records that the username was authenticated online,
+// requiring it to always log in online from now on.
+func (r *Resolver) Authenticated(ctx context.Context, username string) {
+	if stored, found := r.store.Get(username); found && stored == Online {
+		return
+	}
+	if err := r.store.Put(username, Online); err != nil {
+		logr.FromContextOrDiscard(ctx).WithName("hybrid").Error(err, "error storing mode", "username", username)
+	}
+}
+
+// LoggedInOffline records that the username logged in offline,
+// letting it log in offline while the Checker fails.
+// Usernames already stored are left unchanged.
+func (r *Resolver) LoggedInOffline(ctx context.Context, username string) {
+	if _, found := r.store.Get(username); found {
+		return
+	}
+	if err := r.store.Put(username, Offline); err != nil {
+		logr.FromContextOrDiscard(ctx).WithName("hybrid").Error(err, "error storing mode", "username", username)
+	}
+}
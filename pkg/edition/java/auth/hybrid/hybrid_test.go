@@ -0,0 +1,112 @@
+package hybrid
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver(t *testing.T) {
+	ctx := context.Background()
+	premium := NewStatic("Notch")
+	var down bool
+	checker := CheckerFunc(func(ctx context.Context, username string) (bool, error) {
+		if down {
+			return false, errors.New("profile api down")
+		}
+		return premium.IsPremium(ctx, username)
+	})
+	r := NewResolver(checker, nil, 0)
+
+	require.Equal(t, Online, r.Resolve(ctx, "notch"))
+	require.Equal(t, Offline, r.Resolve(ctx, "Cracked"))
+	r.LoggedInOffline(ctx, "Cracked")
+	require.Equal(t, Offline, r.Resolve(ctx, "Unfinished"), "never logged in")
+
+	// Once authenticated online, the name is protected from offline impersonation
+	r.Authenticated(ctx, "Notch")
+	delete(premium, "notch")
+	require.Equal(t, Online, r.Resolve(ctx, "Notch"))
+
+	down = true
+	require.Equal(t, Offline, r.Resolve(ctx, "cracked"), "last seen offline")
+	require.Equal(t, Online, r.Resolve(ctx, "Unknown"), "fail closed")
+	require.Equal(t, Online, r.Resolve(ctx, "Unfinished"), "not stored before login")
+}
+
+func TestResolver_cache(t *testing.T) {
+	ctx := context.Background()
+	var checks int
+	var down bool
+	checker := CheckerFunc(func(ctx context.Context, username string) (bool, error) {
+		if down {
+			return false, errors.New("profile api down")
+		}
+		checks++
+		return false, nil
+	})
+	r := NewResolver(checker, nil, time.Hour)
+
+	require.Equal(t, Offline, r.Resolve(ctx, "Cracked"))
+	require.Equal(t, Offline, r.Resolve(ctx, "cracked"))
+	require.Equal(t, 1, checks)
+
+	r.cache["cracked"] = cachedCheck{expires: time.Now().Add(-time.Minute)}
+	down = true
+	require.Equal(t, Offline, r.Resolve(ctx, "Cracked"), "last checked")
+	require.Equal(t, Online, r.Resolve(ctx, "Unknown"), "fail closed")
+}
+
+func TestProfileAPI(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/profiles/Notch":
+			_, _ = w.Write([]byte(`{"id":"069a79f444e94726a5befca90e38aaf5","name":"Notch"}`))
+		case "/profiles/Cracked":
+			w.WriteHeader(http.StatusNotFound)
+		case "/profiles/Gone":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer srv.Close()
+	u, err := url.Parse(srv.URL + "/profiles/")
+	require.NoError(t, err)
+	api := &ProfileAPI{URL: u, Client: srv.Client()}
+
+	ctx := context.Background()
+	for name, want := range map[string]bool{"Notch": true, "Cracked": false, "Gone": false} {
+		premium, err := api.IsPremium(ctx, name)
+		require.NoError(t, err, name)
+		require.Equal(t, want, premium, name)
+	}
+	_, err = api.IsPremium(ctx, "Limited")
+	require.ErrorContains(t, err, "429")
+}
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data", "modes.json")
+	s, err := NewFileStore(path)
+	require.NoError(t, err)
+	require.NoError(t, s.Put("Notch", Online))
+	require.NoError(t, s.Put("cracked", Offline))
+
+	s, err = NewFileStore(path)
+	require.NoError(t, err)
+	mode, ok := s.Get("notch")
+	require.True(t, ok)
+	require.Equal(t, Online, mode)
+	mode, ok = s.Get("Cracked")
+	require.True(t, ok)
+	require.Equal(t, Offline, mode)
+	_, ok = s.Get("unknown")
+	require.False(t, ok)
+}
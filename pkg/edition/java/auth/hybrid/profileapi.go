@@ -0,0 +1,91 @@
+package hybrid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+// DefaultProfileAPIURL is the base URL of the official Mojang profile API.
+const DefaultProfileAPIURL = `https://api.mojang.com/users/profiles/minecraft/`
+
+var defaultProfileAPIURL, _ = url.Parse(DefaultProfileAPIURL)
+
+// ProfileAPI is a Checker looking up usernames with a profile API
+// compatible to the Mojang API (GET <URL>/<username>).
+// A username is premium if the API responds with status 200,
+// and not premium with status 204 or 404.
+type ProfileAPI struct {
+	// URL is the base URL the username is appended to.
+	// If nil, DefaultProfileAPIURL is used.
+	URL *url.URL
+	// Timeout is the timeout of a lookup, 0 = no timeout.
+	Timeout time.Duration
+	// Client is the http client to use.
+	// If nil, http.DefaultClient is used.
+	Client *http.Client
+}
+
+var _ Checker = (*ProfileAPI)(nil)
+
+// IsPremium implements Checker.
+func (a *ProfileAPI) IsPremium(ctx context.Context, username string) (bool, error) {
+	_, premium, err := a.Lookup(ctx, username)
+	return premium, err
+}
+
+// Lookup returns the UUID of the premium account with the username.
+// Returns false if there is no such account.
+func (a *ProfileAPI) Lookup(ctx context.Context, username string) (uuid.UUID, bool, error) {
+	if a.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, a.Timeout)
+		defer cancel()
+	}
+
+	base := a.URL
+	if base == nil {
+		base = defaultProfileAPIURL
+	}
+	u := base.JoinPath(username).String()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return uuid.Nil, false, fmt.Errorf("error creating profile request: %w", err)
+	}
+
+	cli := a.Client
+	if cli == nil {
+		cli = http.DefaultClient
+	}
+	resp, err := cli.Do(req)
+	if err != nil {
+		return uuid.Nil, false, fmt.Errorf("error sending profile request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNoContent, http.StatusNotFound:
+		return uuid.Nil, false, nil
+	default:
+		return uuid.Nil, false, fmt.Errorf("got unexpected status code (%d) from profile api", resp.StatusCode)
+	}
+
+	var profile struct {
+		ID uuid.UUID `json:"id"`
+	}
+	if err = json.NewDecoder(io.LimitReader(resp.Body, 1<<16)).Decode(&profile); err != nil {
+		return uuid.Nil, false, fmt.Errorf("error decoding profile: %w", err)
+	}
+	if profile.ID == uuid.Nil {
+		return uuid.Nil, false, errors.New("profile api returned no id")
+	}
+	return profile.ID, true, nil
+}
@@ -0,0 +1,103 @@
+package hybrid
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.minekube.com/gate/pkg/util/fileutil"
+)
+
+// Store persists the Mode of usernames.
+// Usernames are case-insensitive.
+type Store interface {
+	// Get returns the stored mode of the username.
+	Get(username string) (mode Mode, found bool)
+	// Put stores the mode of the username.
+	Put(username string, mode Mode) error
+}
+
+// MemoryStore is a Store only keeping modes in memory.
+type MemoryStore struct {
+	mu    sync.RWMutex
+	modes map[string]Mode // by lower case username
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// NewMemoryStore returns a new empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{modes: map[string]Mode{}}
+}
+
+// Get implements Store.
+func (s *MemoryStore) Get(username string) (Mode, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	mode, ok := s.modes[strings.ToLower(username)]
+	return mode, ok
+}
+
+// Put implements Store.
+func (s *MemoryStore) Put(username string, mode Mode) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.modes[strings.ToLower(username)] = mode
+	return nil
+}
+
+// FileStore is a Store persisting modes to a JSON file
+// mapping lower case usernames to modes.
+type FileStore struct {
+	path string
+
+	mu    sync.Mutex
+	modes map[string]Mode // by lower case username
+}
+
+var _ Store = (*FileStore)(nil)
+
+// NewFileStore returns a FileStore loading the modes from the file at path.
+// The file is created on the first Put if it does not exist.
+func NewFileStore(path string) (*FileStore, error) {
+	s := &FileStore{path: path, modes: map[string]Mode{}}
+	if err := fileutil.ReadJSON(path, &s.modes); err != nil {
+		return nil, fmt.Errorf("error loading store: %w", err)
+	}
+	for name, mode := range s.modes {
+		if mode != Online && mode != Offline {
+			return nil, fmt.Errorf("invalid mode %q of username %q in store file %s", mode, name, path)
+		}
+		if lower := strings.ToLower(name); lower != name {
+			delete(s.modes, name)
+			s.modes[lower] = mode
+		}
+	}
+	return s, nil
+}
+
+// Get implements Store.
+func (s *FileStore) Get(username string) (Mode, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	mode, ok := s.modes[strings.ToLower(username)]
+	return mode, ok
+}
+
+// Put implements Store and rewrites the file.
+func (s *FileStore) Put(username string, mode Mode) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	name := strings.ToLower(username)
+	prev, existed := s.modes[name]
+	s.modes[name] = mode
+	if err := fileutil.WriteJSON(s.path, s.modes); err != nil {
+		if existed {
+			s.modes[name] = prev
+		} else {
+			delete(s.modes, name)
+		}
+		return fmt.Errorf("error writing store: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,323 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// SessionServer is a session server to authenticate joining online mode players.
+type SessionServer struct {
+	// URL is the base hasJoined URL of the session server.
+	// If nil, the official Mojang session server is used.
+	URL *url.URL
+	// Timeout is the timeout of a single request to the session server.
+	// If 0, only the timeout of the http client applies.
+	Timeout time.Duration
+}
+
+// Failover configures how requests fail over between multiple session servers.
+type Failover struct {
+	// HedgeDelay is the delay after which a request is also sent to the next
+	// session server if the current one did not respond yet.
+	// The first response is used. If 0, requests are not hedged.
+	HedgeDelay time.Duration
+	// FailureThreshold is the number of consecutive failures after which a
+	// session server is skipped for BreakDuration.
+	// If 0, session servers are never skipped.
+	FailureThreshold int
+	// BreakDuration is how long a failing session server is skipped.
+	// Afterward, a single failed request skips it again.
+	BreakDuration time.Duration
+}
+
+// endpoint is a session server with its circuit breaker.
+type endpoint struct {
+	server       *SessionServer // nil if created by SetHasJoinedURLFn
+	name         string         // used in logs and metrics
+	hasJoinedURL HasJoinedURLFn
+	timeout      time.Duration
+
+	mu        sync.Mutex
+	failures  int       // consecutive failures
+	openUntil time.Time // skipped until
+}
+
+func newEndpoint(s SessionServer) *endpoint {
+	base := s.URL
+	if base == nil {
+		base = defaultHasJoinedBaseURL
+	}
+	return &endpoint{
+		server:       &s,
+		name:         base.Host,
+		hasJoinedURL: CustomHasJoinedURL(base),
+		timeout:      s.Timeout,
+	}
+}
+
+// findEndpoint returns the endpoint of the session server or nil if not found.
+func findEndpoint(endpoints []*endpoint, s SessionServer) *endpoint {
+	for _, e := range endpoints {
+		if e.server != nil && e.server.Timeout == s.Timeout && sameURL(e.server.URL, s.URL) {
+			return e
+		}
+	}
+	return nil
+}
+
+func sameURL(a, b *url.URL) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.String() == b.String()
+}
+
+// available returns true if the circuit breaker of the endpoint is closed.
+func (e *endpoint) available(now time.Time) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return !now.Before(e.openUntil)
+}
+
+func (e *endpoint) succeeded() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.failures = 0
+	e.openUntil = time.Time{}
+}
+
+func (e *endpoint) failed(f Failover, now time.Time) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.failures++
+	if f.FailureThreshold > 0 && e.failures >= f.FailureThreshold {
+		e.openUntil = now.Add(f.BreakDuration)
+	}
+}
+
+// order returns the endpoints to try in order, skipping endpoints with an open circuit breaker.
+// All endpoints are returned if all are skipped, so that logins are still attempted.
+func order(endpoints []*endpoint, now time.Time) []*endpoint {
+	available := make([]*endpoint, 0, len(endpoints))
+	for _, e := range endpoints {
+		if e.available(now) {
+			available = append(available, e)
+		}
+	}
+	if len(available) == 0 {
+		return endpoints
+	}
+	return available
+}
+
+// attempt is the result of a request to a session server.
+type attempt struct {
+	endpoint *endpoint
+	resp     *response
+	err      error
+	// retry is true if the next session server should be tried.
+	retry bool
+}
+
+// hasJoined authenticates the user against the endpoints in order, failing over
+// to the next endpoint on timeouts, network and server errors.
+func (a *authenticator) hasJoined(ctx context.Context, serverID, username, ip string) (*response, error) {
+	endpoints, failover := a.sessionServers()
+	endpoints = order(endpoints, time.Now())
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel() // cancel hedged requests
+
+	attempts := make(chan *attempt, len(endpoints))
+	next, inFlight := 0, 0
+	tryNext := func() {
+		e := endpoints[next]
+		next++
+		inFlight++
+		go func() { attempts <- a.request(ctx, e, serverID, username, ip) }()
+	}
+
+	var (
+		hedge <-chan time.Time
+		timer *time.Timer
+	)
+	resetHedge := func() {
+		if timer != nil {
+			timer.Stop()
+		}
+		hedge = nil
+		if failover.HedgeDelay > 0 && next < len(endpoints) {
+			timer = time.NewTimer(failover.HedgeDelay)
+			hedge = timer.C
+		}
+	}
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+
+	tryNext()
+	resetHedge()
+	var errs error
+	for inFlight > 0 {
+		select {
+		case <-hedge:
+			tryNext()
+			resetHedge()
+		case at := <-attempts:
+			inFlight--
+			if at.err == nil {
+				at.endpoint.succeeded()
+				return at.resp, nil
+			}
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", at.endpoint.name, at.err))
+			if ctx.Err() != nil {
+				// The player disconnected, no need to try other session servers
+				return nil, errs
+			}
+			at.endpoint.failed(failover, time.Now())
+			if !at.retry {
+				return nil, errs
+			}
+			if next < len(endpoints) {
+				tryNext()
+				resetHedge()
+			}
+		}
+	}
+	return nil, errs
+}
+
+// request authenticates the user against a single session server.
+func (a *authenticator) request(ctx context.Context, e *endpoint, serverID, username, ip string) (at *attempt) {
+	at = &attempt{endpoint: e}
+	start := time.Now()
+	parent := ctx
+	defer func() {
+		a.metrics.record(parent, e.name, at.err, time.Since(start))
+	}()
+
+	if e.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.timeout)
+		defer cancel()
+	}
+
+	hasJoinedURL := e.hasJoinedURL(serverID, username, ip)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, hasJoinedURL, nil)
+	if err != nil {
+		at.err = fmt.Errorf("error creating authentication request: %w", err)
+		return at
+	}
+
+	log := logr.FromContextOrDiscard(ctx).V(1).WithName("authnJoin").WithName("request")
+	log.Info("authenticating user against sessionserver", "url", hasJoinedURL)
+
+	resp, err := a.cli.Do(req)
+	if err != nil {
+		at.err = fmt.Errorf("error sending request: %w", err)
+		at.retry = true
+		return at
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		at.err = fmt.Errorf("error reading response body: %w", err)
+		at.retry = true
+		return at
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized:
+		// Player has invalid/outdated session auth token and
+		// should restart the game or re-login to Mojang.
+	case http.StatusNoContent:
+		log.Info("sessionserver could not find user, potentially offline mode")
+	default:
+		at.err = fmt.Errorf("got unexpected status code (%d) from sessionserver", resp.StatusCode)
+		at.retry = resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+		return at
+	}
+
+	onlineMode := resp.StatusCode == http.StatusOK && len(body) != 0
+
+	log.Info("user authenticated against sessionserver",
+		"onlineMode", onlineMode,
+		"time", time.Since(start).String(),
+		"statusCode", resp.StatusCode)
+
+	at.resp = &response{
+		onlineMode: onlineMode,
+		body:       body,
+	}
+	return at
+}
+
+var meter = otel.Meter("java/auth")
+
+// sessionServerMetrics are the per session server metrics.
+type sessionServerMetrics struct {
+	duration metric.Float64Histogram
+	requests metric.Int64Counter
+}
+
+func newSessionServerMetrics() (*sessionServerMetrics, error) {
+	duration, err := meter.Float64Histogram(
+		"gate.auth.session_server.duration",
+		metric.WithDescription("The duration of requests to session servers"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	requests, err := meter.Int64Counter(
+		"gate.auth.session_server.requests",
+		metric.WithDescription("The number of requests to session servers by result"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &sessionServerMetrics{duration: duration, requests: requests}, nil
+}
+
+func (m *sessionServerMetrics) record(ctx context.Context, name string, err error, d time.Duration) {
+	result := "success"
+	switch {
+	case err == nil:
+	case ctx.Err() != nil:
+		// canceled because another session server responded first or the player disconnected
+		result = "canceled"
+	case isTimeout(err):
+		result = "timeout"
+	default:
+		result = "error"
+	}
+	// record with a fresh context, ctx may be canceled
+	attrs := metric.WithAttributes(
+		attribute.String("server.address", name),
+		attribute.String("result", result),
+	)
+	m.duration.Record(context.Background(), d.Seconds(), attrs)
+	m.requests.Add(context.Background(), 1, attrs)
+}
+
+func isTimeout(err error) bool {
+	var netErr net.Error
+	return errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout())
+}
@@ -1,7 +1,13 @@
 package config
 
 import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"net/url"
+	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
@@ -12,13 +18,28 @@ import (
 	"go.minekube.com/gate/pkg/util/configutil"
 	"go.minekube.com/gate/pkg/util/favicon"
 	"go.minekube.com/gate/pkg/util/validation"
+	"gopkg.in/yaml.v3"
 )
 
 // DefaultConfig is a default Config.
 var DefaultConfig = Config{
-	Bind:                          "0.0.0.0:25565",
-	OnlineMode:                    true,
-	Auth:                          Auth{},
+	Bind:       "0.0.0.0:25565",
+	OnlineMode: true,
+	Auth: Auth{
+		CircuitBreaker: AuthCircuitBreaker{
+			FailureThreshold: 3,
+			BreakDuration:    configutil.Duration(30 * time.Second),
+		},
+		Hybrid: AuthHybrid{
+			Timeout:  configutil.Duration(5 * time.Second),
+			CacheTTL: configutil.Duration(time.Hour),
+		},
+		OfflineUUID: AuthOfflineUUID{
+			Strategy: OfflineUUIDStrategy,
+			Timeout:  configutil.Duration(5 * time.Second),
+			CacheTTL: configutil.Duration(time.Hour),
+		},
+	},
 	OnlineModeKickExistingPlayers: false,
 	Forwarding: Forwarding{
 		Mode:           LegacyForwardingMode,
@@ -30,16 +51,64 @@ var DefaultConfig = Config{
 		// Contains Gate's icon
 		Favicon:         "data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAEAAAABACAYAAACqaXHeAAAABGdBTUEAALGPC/xhBQAAACBjSFJNAAB6JgAAgIQAAPoAAACA6AAAdTAAAOpgAAA6mAAAF3CculE8AAAABmJLR0QA/wD/AP+gvaeTAAAACXBIWXMAAAsTAAALEwEAmpwYAAAAB3RJTUUH5AgJCgs6JBZy0AAAB+lJREFUeNrtmGuMXVUZht/LOvcz7diWklbJaKGCWKXFUiiBQjAEhRSLSCEqIKAiogRTmiriBeSiUUhjMCZe2qQxNE0EUZSCidAgYMBSLBJCDC1taAg4xaq1cz/788feZzqgocCoP2Q/f87JOXvvfOtd73dZGygpKSkpKSkpKSkpKSkpKSkpKSkpKXnzwNd7w+ULD0NEBtmQBFqQBNuICFRYwzc3bf7/E+Cyo+cgAIiEbESWJdl1WSQ5VGvWRwnk/0XgpnvfmAhrv3h+HhgFFeJCBCLw8Wt//B8XIB3ogs8umJMHAOCQvtnYtfP5eRGxVNaxMmdKgqzdWSfbIuuuocHBLa2ednx16WJcd9fvXn9EAQSQyGgBwUCMMbAvAvHfcIAOaBHnl0REY9fO56+itFHSjbI/JHuxrMWyl8r6mq27m+3WKpJ1kvjG2UtevyUtyDqK0t2UNklaLalu63+fAp9bNBdZFogsq0j6OsVVkix7L8WNkh6VLVuLlXyapKbsMUkrR4aGV9caNbRnTkWKPC0kgdpv7e7n2GgH7ant8WgiYomoX8uqUXoIwKm1Rn0wJQMAGu0mBv8xhEZPAxIhOX9W8byR4VHUGjUcf86qyaVApVrB6MgYIC4leaUsS/oLySsprQcwBgRkVW1fIfsGWVVJn29Naf8CwPYUedAjQ8OsNxuHApiHwAwAIwB2AtjaaNX/CgQAiuQM27NIhixQqqaU+mTtA9AfEUMA0JzSRERMB3BUIPoCgQjsiIg/NHuaewDg0TtvxqJlK964A6447nAE0CLwM0mnygbFGzujY19WMhD5bjgZTu6hdLekE4qduDAi1jWntIEseimuIHmBrLfJVuGAAdmbZV1t6yGnNI3kBkkLaE2zRNnDsnbLGiR1EcUHK5WKlbyc5BdkvUdSPXeAB21tln3D6PDIvdV6DQBeVYRXdYDyvHsXyYW5dd1PYoNURafTwS2/fRIAsPKU+eg96C17EVhNcavskPgCQCDCtK4RuaLY0WdlPWW7T9a7JS+RdYukpbJHip3PcoHctXUmKyMZkuBK+jTJb8tqSeqn9ICthuyFsk4kubZar50P4DeTSgHZAHAEyd6i7z9LYgcA9M6chuuXnwzLoPLWiIjbKd7ezfV8B+JIkp+gVNzPj0jaKvsQ2xtkLZI1n+TRo8Mj99QatY85+WRJ62TXJW0leb6kfZ2xzp/rzcZ8ktcUi98B4hJZD1KqyLqU5E0AZgH4EoDfA/j7GxbAuc0PpshCgJcoDiHGOxIDMZfgdACh5InFbRuJflA1kffJNsVNtXptS7GzOyJii6RFsqqkZjsZ1XqtX/aLJLPiOcOSnsuybLA1tQ2S51CcXQi/RvZ9TgaBEZA/BHAugEUAjgMwH8ADkxAgARGaULlJERGAJESWWdK1kpZJHJMUyncaFD+TZdltTumxl17oP3f2nEN6Jc0DeSmIWQAato/tVm5KjbzwVos5iONiklSlVoHtOsljKMFWJvswWSsmtHMCqBffWwCOnJwANgKxp7soWdNJNYAYcTKyTLBlSklWWK7ISnnQqgDAvMXz4pmt2z4gcRWlYwrrTsxvOC+uRBSuA0AS+8UhUqUC2XWK04tYJOmCA6R476RqgJMRgW0SB2Q1Zb+dZB+JJyQByDqUrpP0fVGZk1fSOsO5AJCNbX/cfoKsNZJmSRqguE7SJol7bH9K1umyUaz/XwSgVIzfgpMySmOSQHIUwG1FK504JXVQ9NQD7f5rLILxlKRtebvxQbLOlvWELIyOjIbkJ11JqNaqMxAxOz8cGRLzRUgflTSrsPIaklcC6NgJqZJOG0+viQIExgsrRZAqnuUBWbuKHBeAXwL46cSeHnnezwCQAXh6UqNwqiTUW80XndJ6O3X7/WVO6RxSmjKtF+3eNqq16hSSVyt5vu3udXjh2V1w8ludjPz3tKNSq3ZaU3tQrVdnyn6fnf+n4h6Nf0/dexq2VKlVIWsMwKauQQGcPSHnEcA7AawHcA+ANQAOnpQDsixDZBlk/Uj2SbZOk3WQ5B/IOhOIxyNQp3iKpJOK9naErDpJ9B15GGxvL4oWZF+i5L0A/kZruaT3jhc6KSGimwIDpMaK8fZwSStJPgLgfgB3ALgAwEIAZwEYALARQA+ACwEcUYR/RwB/4mTOAgBw43nvR6okSJoj61uyz7RV3T/Pu7uAp2ytln2LrDapiyWudUoLZK2XfbgnnAEo7bb9sKwzC6tfjyy+Um81EMAMST+XdXxeawAAzwM4EcB2AMcDuBXAgn8T8kjhgpUA+ic1CeaFMN89kNudfBGlU2V9UPZcWQ1JeyU9RvInTmk3xdmSWpSeQAC2Hpd9nqxPyjpKsmTtJLlByS+KfFqSKW4OZHAlgcBuAJdTugTAoUWcOwDsLcJ6GMAyAMsLUWYCGAWwragLGwtnYNIOAICbLz4DKe0/0R13+hI8fv+jDVlJ0miWZUOykZLHT3sUQRAUUa3X8OGrvotf3bqyRYlOaSCyLJvoIjIPpd5uoG/uO/DcMzu743i1iHOsk3U6BMffPnXPbEUdyCJigOTL3htM6jD0Sr53+VmQ07gQ432aQiBQq9cAomhdQgBo9bQg7x+eim6AyDJUm00gAikRlLCvswc9noZqT6uozvGyELvvRI5ddhUeufM7ebcgX/k+BXwNCy8pKSkpKSkpKSkpKSkpKSkpKSkpKXkz8k8RHxEbZN/8lgAAACV0RVh0ZGF0ZTpjcmVhdGUAMjAyMC0wOC0wOVQxMDoxMTo0MyswMDowMN6nNEYAAAAldEVYdGRhdGU6bW9kaWZ5ADIwMjAtMDgtMDlUMTA6MTE6NDMrMDA6MDCv+oz6AAAAAElFTkSuQmCC",
 		LogPingRequests: false,
+		PingPassthrough: DisabledPingPassthroughMode,
 	},
 	Query: Query{
 		Enabled:     false,
 		Port:        25577,
 		ShowPlugins: false,
 	},
+	Rcon: Rcon{
+		Enabled:  false,
+		Bind:     "0.0.0.0:25575",
+		Password: "",
+	},
+	Queue: Queue{
+		Enabled:    false,
+		Servers:    []string{},
+		Interval:   configutil.Duration(2 * time.Second),
+		Display:    ActionBarQueueDisplay,
+		Priorities: map[string]int{},
+	},
+	Access: Access{
+		Enabled:          false,
+		File:             "access.json",
+		AllowlistMessage: defaultAllowlistMessage(),
+	},
+	Limbo: Limbo{
+		Enabled:       false,
+		RetryInterval: configutil.Duration(5 * time.Second),
+		Message:       defaultLimboMessage(),
+	},
+	Discovery: Discovery{
+		Directory: "",
+		DNS: DNSDiscovery{
+			Interval: configutil.Duration(30 * time.Second),
+			Records:  []DNSRecord{},
+		},
+	},
+	HealthCheck: HealthCheck{
+		Enabled:            false,
+		Interval:           configutil.Duration(10 * time.Second),
+		Timeout:            configutil.Duration(3 * time.Second),
+		UnhealthyThreshold: 3,
+		HealthyThreshold:   2,
+	},
+	ResourcePacks: ResourcePacks{
+		Global:  []ResourcePack{},
+		Servers: map[string][]ResourcePack{},
+		Host: ResourcePackHost{
+			Enabled:   false,
+			Bind:      "0.0.0.0:25590",
+			Directory: "resourcepacks",
+			PublicURL: "",
+		},
+	},
 	AnnounceForge:                        false,
 	Servers:                              map[string]string{},
 	Try:                                  []string{},
 	ForcedHosts:                          map[string][]string{},
+	ServerGroups:                         map[string]ServerGroup{},
 	FailoverOnUnexpectedServerDisconnect: true,
 	ConnectionTimeout:                    configutil.Duration(5000 * time.Millisecond),
 	ReadTimeout:                          configutil.Duration(30000 * time.Millisecond),
@@ -81,6 +150,12 @@ func defaultMotd() *configutil.TextComponent {
 func defaultShutdownReason() *configutil.TextComponent {
 	return text("§cGate proxy is shutting down...\nPlease reconnect in a moment!")
 }
+func defaultAllowlistMessage() *configutil.TextComponent {
+	return text("§cYou are not allowlisted on this server!")
+}
+func defaultLimboMessage() *configutil.TextComponent {
+	return text("§eNo server is available right now. You will be reconnected automatically.")
+}
 
 // Config is the configuration of the proxy.
 type Config struct { // TODO use https://github.com/projectdiscovery/yamldoc-go for generating output yaml and markdown for the docs
@@ -93,6 +168,16 @@ type Config struct { // TODO use https://github.com/projectdiscovery/yamldoc-go
 	Forwarding Forwarding `yaml:"forwarding,omitempty" json:"forwarding,omitempty"` // Player info forwarding settings.
 	Status     Status     `yaml:"status,omitempty" json:"status,omitempty"`         // Status response settings.
 	Query      Query      `yaml:"query,omitempty" json:"query,omitempty"`           // Query settings.
+	Rcon       Rcon       `yaml:"rcon,omitempty" json:"rcon,omitempty"`             // RCON settings.
+
+	Permissions Permissions `yaml:"permissions,omitempty" json:"permissions,omitempty"` // File-backed permission settings.
+	Access      Access      `yaml:"access,omitempty" json:"access,omitempty"`           // Bans and allowlist settings.
+	Queue       Queue       `yaml:"queue,omitempty" json:"queue,omitempty"`             // Login queue settings.
+	Limbo       Limbo       `yaml:"limbo,omitempty" json:"limbo,omitempty"`             // Limbo settings.
+	HealthCheck HealthCheck `yaml:"healthCheck,omitempty" json:"healthCheck,omitempty"` // Backend server health check settings.
+	Discovery   Discovery   `yaml:"discovery,omitempty" json:"discovery,omitempty"`     // Dynamic server discovery settings.
+	// Resource packs sent by the proxy.
+	ResourcePacks ResourcePacks `yaml:"resourcePacks,omitempty" json:"resourcePacks,omitempty"`
 	// Whether the proxy should present itself as a
 	// Forge/FML-compatible server. By default, this is disabled.
 	AnnounceForge bool `yaml:"announceForge,omitempty" json:"announceForge,omitempty"`
@@ -100,6 +185,7 @@ type Config struct { // TODO use https://github.com/projectdiscovery/yamldoc-go
 	Servers                              map[string]string `yaml:"servers,omitempty" json:"servers,omitempty"` // name:address
 	Try                                  []string          `yaml:"try,omitempty" json:"try,omitempty"`         // Try server names order
 	ForcedHosts                          ForcedHosts       `yaml:"forcedHosts,omitempty" json:"forcedHosts,omitempty"`
+	ServerGroups                         ServerGroups      `yaml:"serverGroups,omitempty" json:"serverGroups,omitempty"` // Usable like server names
 	FailoverOnUnexpectedServerDisconnect bool              `yaml:"failoverOnUnexpectedServerDisconnect,omitempty" json:"failoverOnUnexpectedServerDisconnect,omitempty"`
 
 	ConnectionTimeout configutil.Duration `yaml:"connectionTimeout,omitempty" json:"connectionTimeout,omitempty"` // Write timeout
@@ -129,18 +215,110 @@ type Config struct { // TODO use https://github.com/projectdiscovery/yamldoc-go
 }
 
 type (
-	ForcedHosts map[string][]string // virtualhost:server names
-	Status      struct {
+	ForcedHosts  map[string][]string    // virtualhost:server names
+	ServerGroups map[string]ServerGroup // group name:group
+	Status       struct {
 		ShowMaxPlayers  int                       `yaml:"showMaxPlayers"`
 		Motd            *configutil.TextComponent `yaml:"motd"`
 		Favicon         favicon.Favicon           `yaml:"favicon"`
 		LogPingRequests bool                      `yaml:"logPingRequests"`
+		// PingPassthrough merges parts of the ping response of the forced host
+		// server or the first try server into the proxy's ping response.
+		PingPassthrough         PingPassthroughMode `yaml:"pingPassthrough"`
+		PingPassthroughCacheTTL configutil.Duration `yaml:"pingPassthroughCacheTTL"` // 0 = default, < 0 = disabled
 	}
 	Query struct {
 		Enabled     bool `yaml:"enabled"`
 		Port        int  `yaml:"port"`
 		ShowPlugins bool `yaml:"showPlugins"`
 	}
+	Rcon struct {
+		Enabled  bool   `yaml:"enabled"`
+		Bind     string `yaml:"bind"`
+		Password string `yaml:"password"`
+	}
+	// Permissions is the config for the built-in file-backed permission provider.
+	Permissions struct {
+		File string `yaml:"file"` // Path to the permissions file, empty = disabled
+	}
+	// Access is the config for the built-in bans and allowlist.
+	Access struct {
+		Enabled bool   `yaml:"enabled"`
+		File    string `yaml:"file"` // JSON file persisting bans and the allowlist, empty = in memory only
+		// AllowlistMessage is the disconnect reason of players not on the allowlist.
+		AllowlistMessage *configutil.TextComponent `yaml:"allowlistMessage"`
+	}
+	// Limbo is the config for holding players when no server is available.
+	Limbo struct {
+		Enabled       bool                      `yaml:"enabled"`
+		RetryInterval configutil.Duration       `yaml:"retryInterval"` // How often try servers are checked
+		Message       *configutil.TextComponent `yaml:"message"`       // Sent to players moved to limbo
+	}
+	// HealthCheck is the config for actively checking the health of backend servers.
+	HealthCheck struct {
+		Enabled            bool                `yaml:"enabled"`
+		Interval           configutil.Duration `yaml:"interval"`           // How often servers are pinged
+		Timeout            configutil.Duration `yaml:"timeout"`            // Timeout of a single ping
+		UnhealthyThreshold int                 `yaml:"unhealthyThreshold"` // Consecutive failed pings to become unhealthy
+		HealthyThreshold   int                 `yaml:"healthyThreshold"`   // Consecutive successful pings to become healthy again
+	}
+	// Discovery is the config for dynamically discovering backend servers.
+	Discovery struct {
+		Directory string       `yaml:"directory"` // Path to a directory of server definition files, empty = disabled
+		DNS       DNSDiscovery `yaml:"dns"`
+	}
+	DNSDiscovery struct {
+		Interval configutil.Duration `yaml:"interval"` // How often records are resolved
+		Records  []DNSRecord         `yaml:"records"`  // Empty = disabled
+	}
+	DNSRecord struct {
+		Name string `yaml:"name"`           // Name prefix of the discovered servers
+		SRV  string `yaml:"srv,omitempty"`  // SRV record name, e.g. _minecraft._tcp.example.com
+		Host string `yaml:"host,omitempty"` // Host with A/AAAA records, if SRV is not set
+		Port int    `yaml:"port,omitempty"` // Port used with Host, default 25565
+	}
+	// ResourcePacks is the config for resource packs sent by the proxy.
+	ResourcePacks struct {
+		Global  []ResourcePack            `yaml:"global"`  // Sent to players when joining
+		Servers map[string][]ResourcePack `yaml:"servers"` // server name:packs applied while connected to the server
+		Host    ResourcePackHost          `yaml:"host"`    // Serves packs from a local directory
+	}
+	// ResourcePackHost is the config for serving resource packs from a local directory over HTTP.
+	ResourcePackHost struct {
+		Enabled   bool   `yaml:"enabled"`
+		Bind      string `yaml:"bind"`      // The address to listen for downloads
+		Directory string `yaml:"directory"` // The directory of the pack .zip files
+		PublicURL string `yaml:"publicUrl"` // The URL players download packs from, e.g. http://example.com:25590
+	}
+	// ResourcePack is a resource pack sent by the proxy.
+	ResourcePack struct {
+		URL    string `yaml:"url,omitempty"`    // Download URL
+		Hosted string `yaml:"hosted,omitempty"` // File name of a pack in the host directory, instead of URL
+		// The SHA-1 hash of the pack in hex. If empty, it is computed
+		// from File if set or else by downloading the pack from URL.
+		Hash   string                    `yaml:"hash,omitempty"`
+		File   string                    `yaml:"file,omitempty"`   // Local copy of the pack to compute the hash from
+		Force  bool                      `yaml:"force,omitempty"`  // Whether players must accept the pack
+		Prompt *configutil.TextComponent `yaml:"prompt,omitempty"` // Shown in the prompt (1.17+)
+		// Action when a player declines the pack, default none.
+		OnDecline      ResourcePackDeclineAction `yaml:"onDecline,omitempty"`
+		RedirectServer string                    `yaml:"redirectServer,omitempty"` // Server or group for the redirect action
+		DeclineMessage *configutil.TextComponent `yaml:"declineMessage,omitempty"` // Kick reason for the kick action
+	}
+	// ServerGroup is a group of servers that can be used in place of a server name.
+	// Players are sent to one of the member servers picked by the strategy.
+	ServerGroup struct {
+		Servers  []string            `yaml:"servers" json:"servers"`                       // Names of the member servers
+		Strategy liteconfig.Strategy `yaml:"strategy,omitempty" json:"strategy,omitempty"` // Default is sequential
+	}
+	// Queue is the config for queueing players for full or unavailable servers.
+	Queue struct {
+		Enabled    bool                `yaml:"enabled"`
+		Servers    []string            `yaml:"servers"`    // Names of queued servers, empty = all servers
+		Interval   configutil.Duration `yaml:"interval"`   // How often queued servers are checked
+		Display    QueueDisplay        `yaml:"display"`    // How players see their queue position
+		Priorities map[string]int      `yaml:"priorities"` // permission:priority, higher is queued first
+	}
 	Forwarding struct {
 		Mode              ForwardingMode `yaml:"mode"`
 		VelocitySecret    string         `yaml:"velocitySecret"`    // Used with "velocity" mode
@@ -166,10 +344,125 @@ type (
 	Auth struct {
 		// SessionServerURL is the base URL for the Mojang session server to authenticate online mode players.
 		// Defaults to https://sessionserver.mojang.com/session/minecraft/hasJoined
-		SessionServerURL *configutil.URL `yaml:"sessionServerUrl"` // TODO support multiple urls configutil.SingleOrMulti[URL]
+		// Ignored if SessionServers is set.
+		SessionServerURL *configutil.URL `yaml:"sessionServerUrl"`
+		// SessionServers are the session servers tried in order to authenticate online mode players.
+		// The next session server is tried on timeouts and server errors.
+		SessionServers []SessionServer `yaml:"sessionServers,omitempty"`
+		// HedgeDelay is the delay after which the next session server is also requested
+		// if the current one did not respond yet, 0 = disabled.
+		HedgeDelay     configutil.Duration `yaml:"hedgeDelay,omitempty"`
+		CircuitBreaker AuthCircuitBreaker  `yaml:"circuitBreaker,omitempty"`
+		Hybrid         AuthHybrid          `yaml:"hybrid,omitempty"`
+		OfflineUUID    AuthOfflineUUID     `yaml:"offlineUuid,omitempty"`
+	}
+	SessionServer struct {
+		URL     *configutil.URL     `yaml:"url"`               // Base hasJoined URL of the session server
+		Timeout configutil.Duration `yaml:"timeout,omitempty"` // Timeout of a single request, 0 = default http client timeout
+	}
+	// AuthCircuitBreaker skips session servers after consecutive failures.
+	AuthCircuitBreaker struct {
+		FailureThreshold int                 `yaml:"failureThreshold"` // Consecutive failures to skip a session server, 0 = disabled
+		BreakDuration    configutil.Duration `yaml:"breakDuration"`    // How long a failing session server is skipped
+	}
+	// AuthHybrid authenticates premium usernames online and lets cracked usernames in offline.
+	// Usernames once authenticated online always require online mode afterward.
+	AuthHybrid struct {
+		Enabled bool `yaml:"enabled"`
+		// ProfileAPIURL is the base URL usernames are looked up with.
+		// Defaults to https://api.mojang.com/users/profiles/minecraft/
+		ProfileAPIURL *configutil.URL     `yaml:"profileApiUrl,omitempty"`
+		Timeout       configutil.Duration `yaml:"timeout,omitempty"`  // Timeout of a lookup, 0 = no timeout
+		CacheTTL      configutil.Duration `yaml:"cacheTtl,omitempty"` // How long lookup results are cached, 0 = not cached
+		// Store is the path of the JSON file persisting the mode of seen usernames.
+		// If empty, modes are only kept in memory.
+		Store string `yaml:"store,omitempty"`
+	}
+	// AuthOfflineUUID configures how the UUIDs of offline mode players are resolved.
+	AuthOfflineUUID struct {
+		Strategy UUIDStrategy `yaml:"strategy,omitempty"`
+		// ProfileAPIURL is the base URL premium UUIDs are looked up with.
+		// Defaults to https://api.mojang.com/users/profiles/minecraft/
+		ProfileAPIURL *configutil.URL     `yaml:"profileApiUrl,omitempty"`
+		Timeout       configutil.Duration `yaml:"timeout,omitempty"`  // Timeout of a lookup, 0 = no timeout
+		CacheTTL      configutil.Duration `yaml:"cacheTtl,omitempty"` // How long looked up UUIDs are cached, 0 = not cached
+		// MappingFile is the path of the JSON file persisting the UUID of each username,
+		// keeping UUIDs stable across strategy changes and renames. If empty, nothing is persisted.
+		MappingFile string `yaml:"mappingFile,omitempty"`
 	}
 )
 
+// UUIDStrategy is a strategy to resolve the UUIDs of offline mode players.
+type UUIDStrategy string
+
+const (
+	// OfflineUUIDStrategy uses the Bukkit-style UUID hashed from "OfflinePlayer:<username>".
+	OfflineUUIDStrategy UUIDStrategy = "offline"
+	// PremiumUUIDStrategy uses the UUID of the premium account with the username
+	// and falls back to OfflineUUIDStrategy for usernames without one.
+	PremiumUUIDStrategy UUIDStrategy = "premium"
+)
+
+// FewestPlayersStrategy is a ServerGroup strategy that picks the
+// member server with the fewest players connected through this proxy.
+const FewestPlayersStrategy liteconfig.Strategy = "fewest-players"
+
+var serverGroupStrategies = []liteconfig.Strategy{
+	liteconfig.StrategySequential,
+	liteconfig.StrategyRandom,
+	liteconfig.StrategyRoundRobin,
+	liteconfig.StrategyLeastConnections,
+	liteconfig.StrategyLowestLatency,
+	FewestPlayersStrategy,
+}
+
+// Group returns the server group with the name, case-insensitive.
+func (g ServerGroups) Group(name string) (ServerGroup, bool) {
+	if group, ok := g[name]; ok {
+		return group, true
+	}
+	for n, group := range g {
+		if strings.EqualFold(n, name) {
+			return group, true
+		}
+	}
+	return ServerGroup{}, false
+}
+
+// UnmarshalYAML also accepts a list of server names as shorthand for the servers field.
+func (g *ServerGroup) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.SequenceNode {
+		*g = ServerGroup{}
+		return value.Decode(&g.Servers)
+	}
+	type plain ServerGroup
+	return value.Decode((*plain)(g))
+}
+
+// UnmarshalJSON also accepts a list of server names as shorthand for the servers field.
+func (g *ServerGroup) UnmarshalJSON(data []byte) error {
+	var servers []string
+	if err := json.Unmarshal(data, &servers); err == nil {
+		*g = ServerGroup{Servers: servers}
+		return nil
+	}
+	type plain ServerGroup
+	return json.Unmarshal(data, (*plain)(g))
+}
+
+// Enabled returns true if any discovery provider is enabled.
+func (d *Discovery) Enabled() bool {
+	return d.Directory != "" || len(d.DNS.Records) != 0
+}
+
+func (c *Config) isServerOrGroup(name string) bool {
+	if _, ok := c.Servers[name]; ok {
+		return true
+	}
+	_, ok := c.ServerGroups[name]
+	return ok
+}
+
 // ForwardingMode is a player info forwarding mode.
 type ForwardingMode string
 
@@ -183,6 +476,73 @@ const (
 	BungeeGuardForwardingMode ForwardingMode = "bungeeguard"
 )
 
+// PingPassthroughMode is the mode of passing through backend server ping responses.
+type PingPassthroughMode string
+
+const (
+	// DisabledPingPassthroughMode responds with the proxy's own ping response.
+	DisabledPingPassthroughMode PingPassthroughMode = "disabled"
+	// DescriptionPingPassthroughMode passes through the description and the mod list.
+	DescriptionPingPassthroughMode PingPassthroughMode = "description"
+	// ModsPingPassthroughMode passes through the Forge mod list.
+	ModsPingPassthroughMode PingPassthroughMode = "mods"
+	// PlayersPingPassthroughMode passes through the player counts and sample.
+	PlayersPingPassthroughMode PingPassthroughMode = "players"
+	// AllPingPassthroughMode passes through the entire ping response.
+	AllPingPassthroughMode PingPassthroughMode = "all"
+)
+
+// Enabled returns true if the mode passes through backend ping responses.
+func (m PingPassthroughMode) Enabled() bool {
+	return m != "" && m != DisabledPingPassthroughMode
+}
+
+// QueueDisplay is how players see their queue position.
+type QueueDisplay string
+
+const (
+	// ActionBarQueueDisplay shows the queue position in the action bar.
+	ActionBarQueueDisplay QueueDisplay = "actionbar"
+	// BossBarQueueDisplay shows the queue position in a boss bar.
+	BossBarQueueDisplay QueueDisplay = "bossbar"
+	// NoneQueueDisplay doesn't show the queue position.
+	NoneQueueDisplay QueueDisplay = "none"
+)
+
+// ResourcePackDeclineAction is the action when a player declines a resource pack.
+type ResourcePackDeclineAction string
+
+const (
+	// NoneResourcePackDeclineAction does nothing.
+	NoneResourcePackDeclineAction ResourcePackDeclineAction = "none"
+	// KickResourcePackDeclineAction disconnects the player.
+	KickResourcePackDeclineAction ResourcePackDeclineAction = "kick"
+	// RedirectResourcePackDeclineAction connects the player to another server.
+	RedirectResourcePackDeclineAction ResourcePackDeclineAction = "redirect"
+)
+
+// Packs returns the packs applied while connected to the server, the server name is case-insensitive.
+func (r *ResourcePacks) Packs(server string) []ResourcePack {
+	if packs, ok := r.Servers[server]; ok {
+		return packs
+	}
+	for name, packs := range r.Servers {
+		if strings.EqualFold(name, server) {
+			return packs
+		}
+	}
+	return nil
+}
+
+// GetPingPassthroughCacheTTL returns the configured ping passthrough cache TTL or a default duration if not set.
+func (s *Status) GetPingPassthroughCacheTTL() time.Duration {
+	const defaultTTL = time.Second * 10
+	if s.PingPassthroughCacheTTL == 0 {
+		return defaultTTL
+	}
+	return time.Duration(s.PingPassthroughCacheTTL)
+}
+
 // Validate validates Config.
 func (c *Config) Validate() (warns []error, errs []error) {
 	e := func(m string, args ...any) { errs = append(errs, fmt.Errorf(m, args...)) }
@@ -215,11 +575,121 @@ func (c *Config) Validate() (warns []error, errs []error) {
 		}
 	}
 
+	// RCON also runs in lite mode
+	if c.Rcon.Enabled {
+		if c.Rcon.Password == "" {
+			e("RCON is enabled but no password is set")
+		}
+		if err := validation.ValidHostPort(c.Rcon.Bind); err != nil {
+			e("Invalid RCON bind %q: %v", c.Rcon.Bind, err)
+		}
+	}
+
 	if c.Lite.Enabled {
-		return c.Lite.Validate()
+		liteWarns, liteErrs := c.Lite.Validate()
+		return append(warns, liteWarns...), append(errs, liteErrs...)
+	}
+
+	for i, server := range c.Auth.SessionServers {
+		if server.URL == nil {
+			e("Session server %d: url must be set", i)
+		}
+		if server.Timeout < 0 {
+			e("Session server %d: invalid timeout %s, must be >= 0", i, time.Duration(server.Timeout))
+		}
+	}
+	if c.Auth.HedgeDelay < 0 {
+		e("Invalid auth hedge delay %s, must be >= 0", time.Duration(c.Auth.HedgeDelay))
+	}
+	if cb := c.Auth.CircuitBreaker; cb.FailureThreshold < 0 {
+		e("Invalid auth circuit breaker failure threshold %d, use a number >= 0", cb.FailureThreshold)
+	} else if cb.FailureThreshold > 0 && cb.BreakDuration <= 0 {
+		e("Invalid auth circuit breaker break duration %s, must be > 0", time.Duration(cb.BreakDuration))
+	}
+	if c.Auth.SessionServerURL != nil && len(c.Auth.SessionServers) != 0 {
+		w("Auth sessionServerUrl is ignored because sessionServers is set")
+	}
+	if c.Auth.Hybrid.Enabled && c.Auth.Hybrid.Timeout < 0 {
+		e("Invalid auth hybrid timeout %s, must be >= 0", time.Duration(c.Auth.Hybrid.Timeout))
+	}
+	if c.Auth.Hybrid.Enabled && c.Auth.Hybrid.CacheTTL < 0 {
+		e("Invalid auth hybrid cache ttl %s, must be >= 0", time.Duration(c.Auth.Hybrid.CacheTTL))
+	}
+	switch c.Auth.OfflineUUID.Strategy {
+	case "", OfflineUUIDStrategy, PremiumUUIDStrategy:
+	default:
+		e("Unknown auth offline uuid strategy %q, must be one of offline,premium", c.Auth.OfflineUUID.Strategy)
+	}
+	if c.Auth.OfflineUUID.Timeout < 0 {
+		e("Invalid auth offline uuid timeout %s, must be >= 0", time.Duration(c.Auth.OfflineUUID.Timeout))
+	}
+	if c.Auth.OfflineUUID.CacheTTL < 0 {
+		e("Invalid auth offline uuid cache ttl %s, must be >= 0", time.Duration(c.Auth.OfflineUUID.CacheTTL))
+	}
+
+	switch c.Status.PingPassthrough {
+	case "", DisabledPingPassthroughMode, DescriptionPingPassthroughMode,
+		ModsPingPassthroughMode, PlayersPingPassthroughMode, AllPingPassthroughMode:
+	default:
+		e("Unknown ping passthrough mode %q, must be one of disabled,description,mods,players,all", c.Status.PingPassthrough)
+	}
+
+	if c.Query.Enabled {
+		if c.Query.Port < 1 || c.Query.Port > 65535 {
+			e("Invalid query port %d, use a number between 1 and 65535", c.Query.Port)
+		}
+	}
+
+	if c.Queue.Enabled {
+		if c.Queue.Interval <= 0 {
+			e("Invalid queue interval %s, must be > 0", time.Duration(c.Queue.Interval))
+		}
+		switch c.Queue.Display {
+		case "", ActionBarQueueDisplay, BossBarQueueDisplay, NoneQueueDisplay:
+		default:
+			e("Unknown queue display %q, must be one of actionbar,bossbar,none", c.Queue.Display)
+		}
+	}
+
+	if c.Limbo.Enabled && c.Limbo.RetryInterval <= 0 {
+		e("Invalid limbo retry interval %s, must be > 0", time.Duration(c.Limbo.RetryInterval))
+	}
+
+	if c.HealthCheck.Enabled {
+		if c.HealthCheck.Interval <= 0 {
+			e("Invalid health check interval %s, must be > 0", time.Duration(c.HealthCheck.Interval))
+		}
+		if c.HealthCheck.Timeout <= 0 {
+			e("Invalid health check timeout %s, must be > 0", time.Duration(c.HealthCheck.Timeout))
+		}
+		if c.HealthCheck.UnhealthyThreshold < 1 {
+			e("Invalid health check unhealthy threshold %d, use a number >= 1", c.HealthCheck.UnhealthyThreshold)
+		}
+		if c.HealthCheck.HealthyThreshold < 1 {
+			e("Invalid health check healthy threshold %d, use a number >= 1", c.HealthCheck.HealthyThreshold)
+		}
 	}
 
-	if !c.OnlineMode {
+	if len(c.Discovery.DNS.Records) != 0 && c.Discovery.DNS.Interval <= 0 {
+		e("Invalid DNS discovery interval %s, must be > 0", time.Duration(c.Discovery.DNS.Interval))
+	}
+	for i, record := range c.Discovery.DNS.Records {
+		if !validation.ValidServerName(record.Name) {
+			e("DNS discovery record %d: invalid name format %q: %s", i, record.Name, validation.QualifiedNameErrMsg)
+		}
+		if (record.SRV == "") == (record.Host == "") {
+			e("DNS discovery record %d: either srv or host must be set", i)
+		}
+		if record.Port < 0 || record.Port > 65535 {
+			e("DNS discovery record %d: invalid port %d, use a number between 1 and 65535", i, record.Port)
+		}
+	}
+
+	if c.Auth.Hybrid.Enabled {
+		if c.Auth.Hybrid.Store == "" {
+			w("Auth hybrid store is not set, usernames seen in online mode are forgotten on restart")
+		}
+	} else if !c.OnlineMode {
 		w("Proxy is running in offline mode!")
 	}
 
@@ -246,17 +716,101 @@ func (c *Config) Validate() (warns []error, errs []error) {
 		}
 	}
 
+	// Servers may not be known yet if they are discovered dynamically
+	unknownServer := e
+	if c.Discovery.Enabled() {
+		unknownServer = w
+	}
+
+	for name, group := range c.ServerGroups {
+		if !validation.ValidServerName(name) {
+			e("Invalid server group name format %q: %s and length be 1-%d", name,
+				validation.QualifiedNameErrMsg, validation.QualifiedNameMaxLength)
+		}
+		if _, ok := c.Servers[name]; ok {
+			e("Server group %q must not have the same name as a server", name)
+		}
+		if len(group.Servers) == 0 {
+			e("Server group %q has no servers", name)
+		}
+		for _, member := range group.Servers {
+			if _, ok := c.Servers[member]; !ok {
+				unknownServer("Server group %q server %q must be registered under servers", name, member)
+			}
+		}
+		if group.Strategy != "" && !slices.Contains(serverGroupStrategies, group.Strategy) {
+			e("Server group %q has invalid strategy %q, allowed: %v", name, group.Strategy, serverGroupStrategies)
+		}
+	}
+
 	for _, name := range c.Try {
-		if _, ok := c.Servers[name]; !ok {
-			e("Fallback/try server %q must be registered under servers", name)
+		if !c.isServerOrGroup(name) {
+			unknownServer("Fallback/try server %q must be registered under servers or serverGroups", name)
 		}
 	}
 
 	for host, servers := range c.ForcedHosts {
 		for _, name := range servers {
-			if _, ok := c.Servers[name]; !ok {
-				e("Forced host %q server %q must be registered under servers", host, name)
+			if !c.isServerOrGroup(name) {
+				unknownServer("Forced host %q server %q must be registered under servers or serverGroups", host, name)
+			}
+		}
+	}
+
+	validatePack := func(where string, i int, pack *ResourcePack) {
+		switch {
+		case (pack.URL == "") == (pack.Hosted == ""):
+			e("%s resource pack %d: either url or hosted must be set", where, i)
+		case pack.Hosted != "":
+			if !c.ResourcePacks.Host.Enabled {
+				e("%s resource pack %d: hosted pack %q requires the resource pack host to be enabled", where, i, pack.Hosted)
+			} else if pack.Hosted != filepath.Base(pack.Hosted) || filepath.Ext(pack.Hosted) != ".zip" {
+				e("%s resource pack %d: invalid hosted pack %q, must be the name of a .zip file in the host directory",
+					where, i, pack.Hosted)
+			}
+		default:
+			if u, err := url.Parse(pack.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+				e("%s resource pack %d: invalid url %q, must be a http or https URL", where, i, pack.URL)
+			}
+		}
+		if pack.Hash != "" {
+			if b, err := hex.DecodeString(pack.Hash); err != nil || len(b) != sha1.Size {
+				e("%s resource pack %d: invalid hash %q, must be a SHA-1 hash in hex", where, i, pack.Hash)
+			}
+		}
+		switch pack.OnDecline {
+		case "", NoneResourcePackDeclineAction, KickResourcePackDeclineAction:
+		case RedirectResourcePackDeclineAction:
+			if pack.RedirectServer == "" {
+				e("%s resource pack %d: redirectServer must be set for onDecline redirect", where, i)
+			} else if !c.isServerOrGroup(pack.RedirectServer) {
+				unknownServer("%s resource pack %d: redirect server %q must be registered under servers or serverGroups",
+					where, i, pack.RedirectServer)
 			}
+		default:
+			e("%s resource pack %d: unknown onDecline action %q, must be one of none,kick,redirect", where, i, pack.OnDecline)
+		}
+	}
+	if host := &c.ResourcePacks.Host; host.Enabled {
+		if err := validation.ValidHostPort(host.Bind); err != nil {
+			e("Invalid resource pack host bind %q: %v", host.Bind, err)
+		}
+		if host.Directory == "" {
+			e("Resource pack host directory must be set")
+		}
+		if u, err := url.Parse(host.PublicURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			e("Invalid resource pack host public url %q, must be a http or https URL players can reach", host.PublicURL)
+		}
+	}
+	for i := range c.ResourcePacks.Global {
+		validatePack("Global", i, &c.ResourcePacks.Global[i])
+	}
+	for server, packs := range c.ResourcePacks.Servers {
+		if _, ok := c.Servers[server]; !ok {
+			unknownServer("Resource packs server %q must be registered under servers", server)
+		}
+		for i := range packs {
+			validatePack(fmt.Sprintf("Server %q", server), i, &packs[i])
 		}
 	}
 
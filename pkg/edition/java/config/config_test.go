@@ -1,12 +1,16 @@
 package config
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
 
 	bconfig "go.minekube.com/gate/pkg/edition/bedrock/config"
+	liteconfig "go.minekube.com/gate/pkg/edition/java/lite/config"
+	"go.minekube.com/gate/pkg/util/configutil"
 )
 
 func Test_texts(t *testing.T) {
@@ -162,3 +166,190 @@ bedrock:
 		t.Errorf("Expected default GeyserListenAddr to be applied")
 	}
 }
+
+func TestServerGroups(t *testing.T) {
+	var parsed struct {
+		ServerGroups ServerGroups `yaml:"serverGroups"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(`
+serverGroups:
+  lobby: [lobby-1, lobby-2]
+  balanced:
+    servers: [lobby-1, lobby-2]
+    strategy: fewest-players
+`), &parsed))
+	require.Equal(t, ServerGroup{Servers: []string{"lobby-1", "lobby-2"}}, parsed.ServerGroups["lobby"])
+	require.Equal(t, FewestPlayersStrategy, parsed.ServerGroups["balanced"].Strategy)
+
+	group, ok := parsed.ServerGroups.Group("LOBBY")
+	require.True(t, ok)
+	require.Equal(t, []string{"lobby-1", "lobby-2"}, group.Servers)
+
+	cfg := DefaultConfig
+	cfg.Servers = map[string]string{"lobby-1": "localhost:25566", "lobby-2": "localhost:25567"}
+	cfg.ServerGroups = parsed.ServerGroups
+	cfg.Try = []string{"lobby"}
+	_, errs := cfg.Validate()
+	require.Empty(t, errs)
+
+	cfg.ServerGroups["broken"] = ServerGroup{Servers: []string{"unknown"}, Strategy: "fastest"}
+	_, errs = cfg.Validate()
+	require.Len(t, errs, 2)
+}
+
+func TestResourcePacks(t *testing.T) {
+	var parsed struct {
+		ResourcePacks ResourcePacks `yaml:"resourcePacks"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(`
+resourcePacks:
+  global:
+    - url: https://example.com/global.zip
+      hash: 2ef7bde608ce5404e97d5f042f95f89f1c232871
+      force: true
+  servers:
+    Minigames:
+      - url: https://example.com/minigames.zip
+        file: packs/minigames.zip
+        onDecline: redirect
+        redirectServer: lobby
+`), &parsed))
+	require.True(t, parsed.ResourcePacks.Global[0].Force)
+	packs := parsed.ResourcePacks.Packs("minigames")
+	require.Len(t, packs, 1)
+	require.Equal(t, RedirectResourcePackDeclineAction, packs[0].OnDecline)
+	require.Empty(t, parsed.ResourcePacks.Packs("lobby"))
+
+	cfg := DefaultConfig
+	cfg.Servers = map[string]string{"lobby": "localhost:25566", "Minigames": "localhost:25567"}
+	cfg.ResourcePacks = parsed.ResourcePacks
+	_, errs := cfg.Validate()
+	require.Empty(t, errs)
+
+	cfg.ResourcePacks.Global = append(cfg.ResourcePacks.Global, ResourcePack{
+		URL:       "ftp://example.com/pack.zip",
+		Hash:      "not-a-hash",
+		OnDecline: RedirectResourcePackDeclineAction,
+	})
+	_, errs = cfg.Validate()
+	require.Len(t, errs, 3)
+
+	cfg.ResourcePacks = ResourcePacks{Global: []ResourcePack{{Hosted: "global.zip"}}}
+	_, errs = cfg.Validate()
+	require.Len(t, errs, 1, "host is disabled")
+	cfg.ResourcePacks.Host = ResourcePackHost{
+		Enabled:   true,
+		Bind:      "0.0.0.0:25590",
+		Directory: "resourcepacks",
+		PublicURL: "http://example.com:25590",
+	}
+	_, errs = cfg.Validate()
+	require.Empty(t, errs)
+}
+
+func TestAuthSessionServers(t *testing.T) {
+	var parsed struct {
+		Auth Auth `yaml:"auth"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(`
+auth:
+  sessionServers:
+    - url: https://sessionserver.mojang.com/session/minecraft/hasJoined
+      timeout: 3s
+    - url: https://example.com/session/minecraft/hasJoined
+  hedgeDelay: 1s
+  circuitBreaker:
+    failureThreshold: 5
+    breakDuration: 1m
+`), &parsed))
+	require.Len(t, parsed.Auth.SessionServers, 2)
+	require.Equal(t, "example.com", parsed.Auth.SessionServers[1].URL.T().Host)
+	require.Equal(t, configutil.Duration(3*time.Second), parsed.Auth.SessionServers[0].Timeout)
+	require.Equal(t, configutil.Duration(time.Second), parsed.Auth.HedgeDelay)
+	require.Equal(t, 5, parsed.Auth.CircuitBreaker.FailureThreshold)
+
+	cfg := DefaultConfig
+	cfg.Servers = map[string]string{"lobby": "localhost:25566"}
+	cfg.Auth = parsed.Auth
+	_, errs := cfg.Validate()
+	require.Empty(t, errs)
+
+	cfg.Auth.SessionServers = append(cfg.Auth.SessionServers, SessionServer{})
+	cfg.Auth.CircuitBreaker.BreakDuration = 0
+	_, errs = cfg.Validate()
+	require.Len(t, errs, 2)
+}
+
+func TestAuthHybrid(t *testing.T) {
+	var parsed struct {
+		Auth Auth `yaml:"auth"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(`
+auth:
+  hybrid:
+    enabled: true
+    profileApiUrl: https://api.example.com/profiles/
+    timeout: 2s
+    cacheTtl: 10m
+    store: hybrid.json
+`), &parsed))
+	hybrid := parsed.Auth.Hybrid
+	require.True(t, hybrid.Enabled)
+	require.Equal(t, "api.example.com", hybrid.ProfileAPIURL.T().Host)
+	require.Equal(t, configutil.Duration(2*time.Second), hybrid.Timeout)
+	require.Equal(t, configutil.Duration(10*time.Minute), hybrid.CacheTTL)
+	require.Equal(t, "hybrid.json", hybrid.Store)
+
+	cfg := DefaultConfig
+	cfg.Servers = map[string]string{"lobby": "localhost:25566"}
+	cfg.OnlineMode = false
+	cfg.Auth.Hybrid = hybrid
+	warns, errs := cfg.Validate()
+	require.Empty(t, errs)
+	require.NotContains(t, fmt.Sprint(warns), "offline mode")
+
+	cfg.Auth.Hybrid.Timeout = -1
+	cfg.Auth.Hybrid.CacheTTL = -1
+	_, errs = cfg.Validate()
+	require.Len(t, errs, 2)
+}
+
+func TestAuthOfflineUUID(t *testing.T) {
+	var parsed struct {
+		Auth Auth `yaml:"auth"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(`
+auth:
+  offlineUuid:
+    strategy: premium
+    cacheTtl: 10m
+    mappingFile: offline-uuids.json
+`), &parsed))
+	offlineUUID := parsed.Auth.OfflineUUID
+	require.Equal(t, PremiumUUIDStrategy, offlineUUID.Strategy)
+	require.Equal(t, configutil.Duration(10*time.Minute), offlineUUID.CacheTTL)
+	require.Equal(t, "offline-uuids.json", offlineUUID.MappingFile)
+
+	cfg := DefaultConfig
+	cfg.Servers = map[string]string{"lobby": "localhost:25566"}
+	cfg.Auth.OfflineUUID = offlineUUID
+	_, errs := cfg.Validate()
+	require.Empty(t, errs)
+
+	cfg.Auth.OfflineUUID.Strategy = "floodgate"
+	_, errs = cfg.Validate()
+	require.Len(t, errs, 1)
+}
+
+func TestRcon_lite(t *testing.T) {
+	cfg := DefaultConfig
+	cfg.Lite.Enabled = true
+	cfg.Lite.Routes = []liteconfig.Route{{Host: []string{"example.com"}, Backend: []string{"localhost:25566"}}}
+	_, errs := cfg.Validate()
+	require.Empty(t, errs)
+
+	cfg.Rcon.Enabled = true
+	cfg.Rcon.Bind = "0.0.0.0:25575"
+	_, errs = cfg.Validate()
+	require.Len(t, errs, 1, "rcon is validated in lite mode")
+}
@@ -0,0 +1,135 @@
+package dialog
+
+import (
+	"errors"
+
+	"github.com/Tnze/go-mc/nbt"
+	"go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/key"
+
+	"go.minekube.com/gate/pkg/edition/java/proto/util"
+)
+
+// Button is a button of a dialog.
+type Button struct {
+	Label   component.Component
+	Tooltip component.Component // Shown when hovering the button if not nil
+	Width   int                 // Width in pixels from 1 to 1024, defaults to 150 if 0
+	// Action is run when the button is clicked.
+	// The button only closes the dialog if nil.
+	Action Action
+}
+
+func (b *Button) encode(e *encoder) map[string]any {
+	m := map[string]any{"label": e.component(b.Label)}
+	if b.Tooltip != nil {
+		m["tooltip"] = e.component(b.Tooltip)
+	}
+	if b.Width != 0 {
+		m["width"] = int32(b.Width)
+	}
+	if b.Action != nil {
+		m["action"] = b.Action.encode(e)
+	}
+	return m
+}
+
+// Action is the action of a Button.
+// Implementations are OpenURL, RunCommand, SuggestCommand, CopyToClipboard,
+// ShowDialog, Custom, Submit and SubmitCommand.
+type Action interface {
+	encode(e *encoder) map[string]any
+}
+
+// OpenURL opens the URL in the browser of the player after confirmation.
+type OpenURL struct {
+	URL string
+}
+
+func (a *OpenURL) encode(*encoder) map[string]any {
+	return map[string]any{"type": "open_url", "url": a.URL}
+}
+
+// RunCommand runs the command as the player.
+// Commands not starting with a slash are sent as chat messages.
+type RunCommand struct {
+	Command string
+}
+
+func (a *RunCommand) encode(*encoder) map[string]any {
+	return map[string]any{"type": "run_command", "command": a.Command}
+}
+
+// SuggestCommand opens the chat with the command.
+type SuggestCommand struct {
+	Command string
+}
+
+func (a *SuggestCommand) encode(*encoder) map[string]any {
+	return map[string]any{"type": "suggest_command", "command": a.Command}
+}
+
+// CopyToClipboard copies the value to the clipboard of the player.
+type CopyToClipboard struct {
+	Value string
+}
+
+func (a *CopyToClipboard) encode(*encoder) map[string]any {
+	return map[string]any{"type": "copy_to_clipboard", "value": a.Value}
+}
+
+// ShowDialog shows another dialog.
+type ShowDialog struct {
+	Dialog Dialog
+}
+
+func (a *ShowDialog) encode(e *encoder) map[string]any {
+	if a.Dialog == nil {
+		e.fail(errors.New("show dialog action has no dialog"))
+		return map[string]any{"type": "show_dialog"}
+	}
+	return map[string]any{"type": "show_dialog", "dialog": a.Dialog.encode(e)}
+}
+
+// Custom sends the ID and payload back to the proxy,
+// firing a proxy.CustomClickActionEvent.
+type Custom struct {
+	ID      key.Key
+	Payload util.BinaryTag // Optional
+}
+
+func (a *Custom) encode(e *encoder) map[string]any {
+	m := map[string]any{"type": "custom", "id": e.key(a.ID)}
+	if a.Payload.Type != nbt.TagEnd {
+		m["payload"] = a.Payload
+	}
+	return m
+}
+
+// Submit sends the values of the dialog inputs back to the proxy,
+// firing a proxy.CustomClickActionEvent with the ID.
+// Use ParseResponse to read the values from the payload of the event.
+type Submit struct {
+	ID key.Key
+	// Additions is an optional compound tag whose entries are
+	// sent together with the input values.
+	Additions util.CompoundBinaryTag
+}
+
+func (a *Submit) encode(e *encoder) map[string]any {
+	m := map[string]any{"type": "dynamic/custom", "id": e.key(a.ID)}
+	if a.Additions.Type != nbt.TagEnd {
+		m["additions"] = a.Additions
+	}
+	return m
+}
+
+// SubmitCommand runs a command built from the values of the dialog inputs as the player.
+// The template references input values by key, e.g. "/server $(server)".
+type SubmitCommand struct {
+	Template string
+}
+
+func (a *SubmitCommand) encode(*encoder) map[string]any {
+	return map[string]any{"type": "dynamic/run_command", "template": a.Template}
+}
@@ -0,0 +1,258 @@
+// Package dialog provides functionality for showing Minecraft dialogs to players.
+//
+// Dialogs are screens with a title, body, inputs and buttons supported by 1.21.6+ clients.
+// Buttons with a Custom or Submit action fire a proxy.CustomClickActionEvent
+// when clicked, use ParseResponse to read the submitted inputs.
+package dialog
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/Tnze/go-mc/nbt"
+	"go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/key"
+
+	"go.minekube.com/gate/pkg/edition/java/internal/methods"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet/chat"
+	"go.minekube.com/gate/pkg/edition/java/proto/state/states"
+	"go.minekube.com/gate/pkg/edition/java/proto/util"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+	"go.minekube.com/gate/pkg/gate/proto"
+)
+
+var (
+	ErrUnsupportedClientProtocol = errors.New("player version must be at least 1.21.6 to show dialogs")
+	ErrUnsupportedState          = fmt.Errorf("dialogs can only be shown in %s or %s state", states.ConfigState, states.PlayState)
+)
+
+// Viewer is the interface for a dialog viewer (e.g. a player).
+type Viewer interface {
+	proto.PacketWriter
+}
+
+// Show shows the dialog to the viewer, replacing the currently shown dialog.
+//
+// If the viewer's protocol is below 1.21.6, ErrUnsupportedClientProtocol is returned.
+// If the viewer's state is not states.ConfigState or states.PlayState, ErrUnsupportedState is returned.
+func Show(viewer Viewer, dialog Dialog) error {
+	s, err := isSupported(viewer)
+	if err != nil {
+		return err
+	}
+	protocol, _ := methods.Protocol(viewer)
+	tag, err := Encode(dialog, protocol)
+	if err != nil {
+		return err
+	}
+	return viewer.WritePacket(&packet.DialogShow{State: s, BinaryTag: tag})
+}
+
+// Clear closes the currently shown dialog of the viewer.
+//
+// If the viewer's protocol is below 1.21.6, ErrUnsupportedClientProtocol is returned.
+// If the viewer's state is not states.ConfigState or states.PlayState, ErrUnsupportedState is returned.
+func Clear(viewer Viewer) error {
+	if _, err := isSupported(viewer); err != nil {
+		return err
+	}
+	return viewer.WritePacket(&packet.DialogClear{})
+}
+
+func isSupported(viewer Viewer) (states.State, error) {
+	p, ok := methods.Protocol(viewer)
+	if !ok || p.Lower(version.Minecraft_1_21_6) {
+		return 0, fmt.Errorf("%w: client is on %s", ErrUnsupportedClientProtocol, p)
+	}
+	s, ok := methods.State(viewer)
+	if !ok {
+		// Assume play state for viewers not exposing their state
+		return states.PlayState, nil
+	}
+	if s != states.ConfigState && s != states.PlayState {
+		return 0, fmt.Errorf("%w: client is on %s", ErrUnsupportedState, s)
+	}
+	return s, nil
+}
+
+// Encode encodes the dialog to a compound binary tag for the protocol version.
+func Encode(dialog Dialog, protocol proto.Protocol) (util.CompoundBinaryTag, error) {
+	if dialog == nil {
+		return util.CompoundBinaryTag{}, errors.New("dialog is nil")
+	}
+	e := &encoder{protocol: protocol}
+	m := dialog.encode(e)
+	if e.err != nil {
+		return util.CompoundBinaryTag{}, e.err
+	}
+	buf := new(bytes.Buffer)
+	enc := nbt.NewEncoder(buf)
+	enc.NetworkFormat(true)
+	if err := enc.Encode(m, ""); err != nil {
+		return util.CompoundBinaryTag{}, fmt.Errorf("error encoding dialog: %w", err)
+	}
+	// strip the type byte
+	return util.CompoundBinaryTag{Type: nbt.TagCompound, Data: buf.Bytes()[1:]}, nil
+}
+
+// Dialog is a dialog that can be shown to players.
+// Implementations are Notice, Confirmation and MultiAction.
+type Dialog interface {
+	encode(e *encoder) map[string]any
+}
+
+// AfterAction is what the client does after a button of a dialog was clicked.
+type AfterAction string
+
+const (
+	CloseAfterAction           AfterAction = "close"             // Close the dialog (default)
+	NoneAfterAction            AfterAction = "none"              // Keep the dialog open
+	WaitForResponseAfterAction AfterAction = "wait_for_response" // Show a waiting screen until another dialog is shown or cleared
+)
+
+// Common are the fields shared by all dialogs.
+type Common struct {
+	Title component.Component
+	// ExternalTitle is the label of buttons opening this dialog,
+	// e.g. in the pause menu. Defaults to Title if nil.
+	ExternalTitle component.Component
+	Body          []Body  // From top to bottom
+	Inputs        []Input // Shown below the body
+	// DisableEscape prevents closing the dialog with the escape key.
+	DisableEscape bool
+	AfterAction   AfterAction // Defaults to CloseAfterAction
+}
+
+func (c *Common) encode(e *encoder, dialogType string) map[string]any {
+	m := map[string]any{
+		"type":  dialogType,
+		"title": e.component(c.Title),
+	}
+	if c.ExternalTitle != nil {
+		m["external_title"] = e.component(c.ExternalTitle)
+	}
+	if len(c.Body) != 0 {
+		body := make([]map[string]any, 0, len(c.Body))
+		for _, b := range c.Body {
+			body = append(body, b.encode(e))
+		}
+		m["body"] = body
+	}
+	if len(c.Inputs) != 0 {
+		inputs := make([]map[string]any, 0, len(c.Inputs))
+		for _, in := range c.Inputs {
+			inputs = append(inputs, in.encode(e))
+		}
+		m["inputs"] = inputs
+	}
+	if c.DisableEscape {
+		m["can_close_with_escape"] = false
+	}
+	if c.AfterAction != "" {
+		m["after_action"] = string(c.AfterAction)
+	}
+	return m
+}
+
+// Notice is a dialog with a single button at the bottom.
+type Notice struct {
+	Common
+	// Action is the button at the bottom.
+	// Defaults to a button labeled "Ok" closing the dialog if nil.
+	Action *Button
+}
+
+func (n *Notice) encode(e *encoder) map[string]any {
+	m := n.Common.encode(e, "minecraft:notice")
+	if n.Action != nil {
+		m["action"] = n.Action.encode(e)
+	}
+	return m
+}
+
+// Confirmation is a dialog with a yes and a no button at the bottom.
+type Confirmation struct {
+	Common
+	Yes, No Button
+}
+
+func (c *Confirmation) encode(e *encoder) map[string]any {
+	m := c.Common.encode(e, "minecraft:confirmation")
+	m["yes"] = c.Yes.encode(e)
+	m["no"] = c.No.encode(e)
+	return m
+}
+
+// MultiAction is a dialog with a grid of buttons at the bottom.
+type MultiAction struct {
+	Common
+	Actions []Button // Must not be empty
+	Columns int      // Defaults to 2 if 0
+	// ExitAction is the button at the very bottom and
+	// the action run when the dialog is closed with the escape key.
+	ExitAction *Button
+}
+
+func (d *MultiAction) encode(e *encoder) map[string]any {
+	m := d.Common.encode(e, "minecraft:multi_action")
+	if len(d.Actions) == 0 {
+		e.fail(errors.New("multi action dialog must have at least one action"))
+	}
+	actions := make([]map[string]any, 0, len(d.Actions))
+	for i := range d.Actions {
+		actions = append(actions, d.Actions[i].encode(e))
+	}
+	m["actions"] = actions
+	if d.Columns != 0 {
+		m["columns"] = int32(d.Columns)
+	}
+	if d.ExitAction != nil {
+		m["exit_action"] = d.ExitAction.encode(e)
+	}
+	return m
+}
+
+// NewForm returns a dialog with the inputs and a submit button.
+// The submitted inputs fire a proxy.CustomClickActionEvent with the id.
+func NewForm(title component.Component, id key.Key, inputs ...Input) *Notice {
+	return &Notice{
+		Common: Common{Title: title, Inputs: inputs},
+		Action: &Button{
+			Label:  &component.Translation{Key: "gui.done"},
+			Action: &Submit{ID: id},
+		},
+	}
+}
+
+// encoder encodes dialogs to nbt values and records the first error.
+type encoder struct {
+	protocol proto.Protocol
+	err      error
+}
+
+func (e *encoder) fail(err error) {
+	if e.err == nil {
+		e.err = err
+	}
+}
+
+func (e *encoder) component(c component.Component) util.BinaryTag {
+	if c == nil {
+		c = &component.Text{}
+	}
+	tag, err := chat.FromComponentProtocol(c, e.protocol).AsBinaryTag()
+	if err != nil {
+		e.fail(fmt.Errorf("error encoding component: %w", err))
+	}
+	return tag
+}
+
+func (e *encoder) key(k key.Key) string {
+	if k == nil {
+		e.fail(errors.New("action id is nil"))
+		return ""
+	}
+	return k.String()
+}
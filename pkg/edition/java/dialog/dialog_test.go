@@ -0,0 +1,162 @@
+package dialog
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/Tnze/go-mc/nbt"
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/key"
+
+	"go.minekube.com/gate/pkg/edition/java/proto/packet"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet/chat"
+	"go.minekube.com/gate/pkg/edition/java/proto/state/states"
+	"go.minekube.com/gate/pkg/edition/java/proto/util"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+	"go.minekube.com/gate/pkg/gate/proto"
+)
+
+type testViewer struct {
+	protocol proto.Protocol
+	packets  []proto.Packet
+}
+
+func (v *testViewer) Protocol() proto.Protocol { return v.protocol }
+func (v *testViewer) WritePacket(p proto.Packet) error {
+	v.packets = append(v.packets, p)
+	return nil
+}
+
+// decode decodes the binary tag to plain Go values.
+func decode(t *testing.T, tag util.BinaryTag) any {
+	var v any
+	require.NoError(t, tag.Unmarshal(&v))
+	return v
+}
+
+// text returns the decoded binary tag of the text component.
+func text(t *testing.T, content string) any {
+	tag, err := chat.FromComponentProtocol(&component.Text{Content: content}, version.Minecraft_1_21_6.Protocol).AsBinaryTag()
+	require.NoError(t, err)
+	return decode(t, tag)
+}
+
+func TestEncode(t *testing.T) {
+	initial := float32(50)
+	d := &MultiAction{
+		Common: Common{
+			Title:         &component.Text{Content: "Servers"},
+			Body:          []Body{&PlainMessage{Contents: &component.Text{Content: "Pick a server"}}},
+			DisableEscape: true,
+			Inputs: []Input{
+				&TextInput{Key: "name", Label: &component.Text{Content: "Name"}, MaxLength: 16},
+				&NumberRangeInput{Key: "volume", Label: &component.Text{Content: "Volume"}, End: 100, Initial: &initial},
+			},
+		},
+		Actions: []Button{
+			{Label: &component.Text{Content: "Lobby"}, Action: &RunCommand{Command: "/server lobby"}},
+			{Label: &component.Text{Content: "Save"}, Width: 100, Action: &Submit{ID: key.New("gate", "save")}},
+		},
+		Columns: 1,
+	}
+	tag, err := Encode(d, version.Minecraft_1_21_6.Protocol)
+	require.NoError(t, err)
+	require.Equal(t, byte(nbt.TagCompound), tag.Type)
+
+	require.Equal(t, map[string]any{
+		"type":                  "minecraft:multi_action",
+		"title":                 text(t, "Servers"),
+		"can_close_with_escape": int8(0),
+		"body": []any{map[string]any{
+			"type":     "minecraft:plain_message",
+			"contents": text(t, "Pick a server"),
+		}},
+		"inputs": []any{
+			map[string]any{
+				"type":       "minecraft:text",
+				"key":        "name",
+				"label":      text(t, "Name"),
+				"max_length": int32(16),
+			},
+			map[string]any{
+				"type":    "minecraft:number_range",
+				"key":     "volume",
+				"label":   text(t, "Volume"),
+				"start":   float32(0),
+				"end":     float32(100),
+				"initial": float32(50),
+			},
+		},
+		"actions": []any{
+			map[string]any{
+				"label":  text(t, "Lobby"),
+				"action": map[string]any{"type": "run_command", "command": "/server lobby"},
+			},
+			map[string]any{
+				"label":  text(t, "Save"),
+				"width":  int32(100),
+				"action": map[string]any{"type": "dynamic/custom", "id": "gate:save"},
+			},
+		},
+		"columns": int32(1),
+	}, decode(t, tag))
+}
+
+func TestEncodeInvalid(t *testing.T) {
+	_, err := Encode(&MultiAction{}, version.Minecraft_1_21_6.Protocol)
+	require.ErrorContains(t, err, "at least one action")
+
+	_, err = Encode(NewForm(nil, key.New("gate", "form"),
+		&BooleanInput{Key: "not-valid"},
+	), version.Minecraft_1_21_6.Protocol)
+	require.ErrorContains(t, err, "invalid input key")
+}
+
+func TestShow(t *testing.T) {
+	d := &Notice{Common: Common{Title: &component.Text{Content: "Rules"}}}
+
+	v := &testViewer{protocol: version.Minecraft_1_21_6.Protocol}
+	require.NoError(t, Show(v, d))
+	require.NoError(t, Clear(v))
+	require.Len(t, v.packets, 2)
+	show, ok := v.packets[0].(*packet.DialogShow)
+	require.True(t, ok)
+	require.Equal(t, states.PlayState, show.State)
+	require.Equal(t, text(t, "Rules"), decode(t, show.BinaryTag).(map[string]any)["title"])
+	require.Equal(t, &packet.DialogClear{}, v.packets[1])
+
+	old := &testViewer{protocol: version.Minecraft_1_21_5.Protocol}
+	require.ErrorIs(t, Show(old, d), ErrUnsupportedClientProtocol)
+	require.ErrorIs(t, Clear(old), ErrUnsupportedClientProtocol)
+	require.Empty(t, old.packets)
+}
+
+func TestParseResponse(t *testing.T) {
+	buf := new(bytes.Buffer)
+	enc := nbt.NewEncoder(buf)
+	enc.NetworkFormat(true)
+	require.NoError(t, enc.Encode(map[string]any{
+		"name":   "Steve",
+		"accept": true,
+		"volume": float32(0.5),
+	}, ""))
+	r, err := ParseResponse(util.BinaryTag{Type: nbt.TagCompound, Data: buf.Bytes()[1:]})
+	require.NoError(t, err)
+
+	name, ok := r.String("name")
+	require.True(t, ok)
+	require.Equal(t, "Steve", name)
+	accept, ok := r.Bool("accept")
+	require.True(t, ok)
+	require.True(t, accept)
+	volume, ok := r.Float("volume")
+	require.True(t, ok)
+	require.Equal(t, float32(0.5), volume)
+	_, ok = r.String("missing")
+	require.False(t, ok)
+
+	r, err = ParseResponse(util.BinaryTag{})
+	require.NoError(t, err)
+	require.Empty(t, r)
+}
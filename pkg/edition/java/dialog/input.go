@@ -0,0 +1,202 @@
+package dialog
+
+import (
+	"fmt"
+	"regexp"
+
+	"go.minekube.com/common/minecraft/component"
+)
+
+// Body is an element of the body of a dialog.
+// Implementations are PlainMessage.
+type Body interface {
+	encode(e *encoder) map[string]any
+}
+
+// PlainMessage is a multiline text body element.
+type PlainMessage struct {
+	Contents component.Component
+	Width    int // Width in pixels from 1 to 1024, defaults to 200 if 0
+}
+
+func (b *PlainMessage) encode(e *encoder) map[string]any {
+	m := map[string]any{
+		"type":     "minecraft:plain_message",
+		"contents": e.component(b.Contents),
+	}
+	if b.Width != 0 {
+		m["width"] = int32(b.Width)
+	}
+	return m
+}
+
+// Input is an input control of a dialog.
+// The value of an input is sent with Submit actions by its key.
+// Implementations are TextInput, BooleanInput, SingleOptionInput and NumberRangeInput.
+type Input interface {
+	InputKey() string
+	encode(e *encoder) map[string]any
+}
+
+// keyPattern is the pattern of valid input keys.
+var keyPattern = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+
+func encodeInput(e *encoder, inputType, key string) map[string]any {
+	if !keyPattern.MatchString(key) {
+		e.fail(fmt.Errorf("invalid input key %q: must only contain letters, digits and underscores", key))
+	}
+	return map[string]any{
+		"type": inputType,
+		"key":  key,
+	}
+}
+
+// TextInput is a text field.
+// The submitted value is a string.
+type TextInput struct {
+	Key         string
+	Label       component.Component
+	LabelHidden bool
+	Width       int    // Width in pixels from 1 to 1024, defaults to 200 if 0
+	Initial     string // Initial text
+	MaxLength   int    // Defaults to 32 if 0
+	// Multiline makes the text field multiline if not nil.
+	Multiline *Multiline
+}
+
+// Multiline are the options of a multiline TextInput.
+type Multiline struct {
+	MaxLines int // Unlimited if 0
+	Height   int // Height in pixels from 1 to 512, defaults to fit MaxLines if 0
+}
+
+func (in *TextInput) InputKey() string { return in.Key }
+
+func (in *TextInput) encode(e *encoder) map[string]any {
+	m := encodeInput(e, "minecraft:text", in.Key)
+	m["label"] = e.component(in.Label)
+	if in.LabelHidden {
+		m["label_visible"] = false
+	}
+	if in.Width != 0 {
+		m["width"] = int32(in.Width)
+	}
+	if in.Initial != "" {
+		m["initial"] = in.Initial
+	}
+	if in.MaxLength != 0 {
+		m["max_length"] = int32(in.MaxLength)
+	}
+	if in.Multiline != nil {
+		multiline := map[string]any{}
+		if in.Multiline.MaxLines != 0 {
+			multiline["max_lines"] = int32(in.Multiline.MaxLines)
+		}
+		if in.Multiline.Height != 0 {
+			multiline["height"] = int32(in.Multiline.Height)
+		}
+		m["multiline"] = multiline
+	}
+	return m
+}
+
+// BooleanInput is a checkbox.
+// The submitted value is a bool.
+type BooleanInput struct {
+	Key     string
+	Label   component.Component
+	Initial bool
+}
+
+func (in *BooleanInput) InputKey() string { return in.Key }
+
+func (in *BooleanInput) encode(e *encoder) map[string]any {
+	m := encodeInput(e, "minecraft:boolean", in.Key)
+	m["label"] = e.component(in.Label)
+	if in.Initial {
+		m["initial"] = true
+	}
+	return m
+}
+
+// SingleOptionInput is a button cycling through options.
+// The submitted value is the string ID of the selected option.
+type SingleOptionInput struct {
+	Key         string
+	Label       component.Component
+	LabelHidden bool
+	Width       int      // Width in pixels from 1 to 1024, defaults to 200 if 0
+	Options     []Option // Must not be empty
+}
+
+// Option is an option of a SingleOptionInput.
+type Option struct {
+	ID      string
+	Display component.Component // Defaults to ID if nil
+	Initial bool                // Whether the option is selected initially, defaults to the first option
+}
+
+func (in *SingleOptionInput) InputKey() string { return in.Key }
+
+func (in *SingleOptionInput) encode(e *encoder) map[string]any {
+	m := encodeInput(e, "minecraft:single_option", in.Key)
+	m["label"] = e.component(in.Label)
+	if in.LabelHidden {
+		m["label_visible"] = false
+	}
+	if in.Width != 0 {
+		m["width"] = int32(in.Width)
+	}
+	if len(in.Options) == 0 {
+		e.fail(fmt.Errorf("single option input %q must have at least one option", in.Key))
+	}
+	options := make([]map[string]any, 0, len(in.Options))
+	for _, o := range in.Options {
+		option := map[string]any{"id": o.ID}
+		if o.Display != nil {
+			option["display"] = e.component(o.Display)
+		}
+		if o.Initial {
+			option["initial"] = true
+		}
+		options = append(options, option)
+	}
+	m["options"] = options
+	return m
+}
+
+// NumberRangeInput is a slider.
+// The submitted value is a float.
+type NumberRangeInput struct {
+	Key   string
+	Label component.Component
+	// LabelFormat is the translation key formatting the label and value,
+	// defaults to "options.generic_value" if empty.
+	LabelFormat string
+	Width       int // Width in pixels from 1 to 1024, defaults to 200 if 0
+	Start, End  float32
+	Step        float32  // Any value in the range is allowed if 0
+	Initial     *float32 // Defaults to the middle of the range if nil
+}
+
+func (in *NumberRangeInput) InputKey() string { return in.Key }
+
+func (in *NumberRangeInput) encode(e *encoder) map[string]any {
+	m := encodeInput(e, "minecraft:number_range", in.Key)
+	m["label"] = e.component(in.Label)
+	if in.LabelFormat != "" {
+		m["label_format"] = in.LabelFormat
+	}
+	if in.Width != 0 {
+		m["width"] = int32(in.Width)
+	}
+	m["start"] = in.Start
+	m["end"] = in.End
+	if in.Step != 0 {
+		m["step"] = in.Step
+	}
+	if in.Initial != nil {
+		m["initial"] = *in.Initial
+	}
+	return m
+}
@@ -0,0 +1,57 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/Tnze/go-mc/nbt"
+
+	"go.minekube.com/gate/pkg/edition/java/proto/util"
+)
+
+// Response are the values of the inputs submitted with a Submit action by input key.
+type Response map[string]any
+
+// ParseResponse parses the payload of a proxy.CustomClickActionEvent fired by a Submit action.
+func ParseResponse(payload util.BinaryTag) (Response, error) {
+	r := Response{}
+	if payload.Type == nbt.TagEnd {
+		return r, nil
+	}
+	if payload.Type != nbt.TagCompound {
+		return nil, fmt.Errorf("expected payload to be a compound tag, got %v", payload.Type)
+	}
+	if err := payload.Unmarshal(&r); err != nil {
+		return nil, fmt.Errorf("error decoding payload: %w", err)
+	}
+	return r, nil
+}
+
+// String returns the value of a TextInput or the selected option of a SingleOptionInput.
+func (r Response) String(key string) (string, bool) {
+	s, ok := r[key].(string)
+	return s, ok
+}
+
+// Bool returns the value of a BooleanInput.
+func (r Response) Bool(key string) (bool, bool) {
+	switch v := r[key].(type) {
+	case int8:
+		return v != 0, true
+	case byte:
+		return v != 0, true
+	default:
+		return false, false
+	}
+}
+
+// Float returns the value of a NumberRangeInput.
+func (r Response) Float(key string) (float32, bool) {
+	switch v := r[key].(type) {
+	case float32:
+		return v, true
+	case float64:
+		return float32(v), true
+	default:
+		return 0, false
+	}
+}
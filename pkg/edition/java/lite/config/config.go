@@ -37,6 +37,25 @@ type (
 		TCPShieldRealIP   bool     `json:"tcpShieldRealIP,omitempty" yaml:"tcpShieldRealIP,omitempty"`
 		ModifyVirtualHost bool     `json:"modifyVirtualHost,omitempty" yaml:"modifyVirtualHost,omitempty"`
 		Strategy          Strategy `json:"strategy,omitempty" yaml:"strategy,omitempty"`
+		Quota             *Quota   `json:"quota,omitempty" yaml:"quota,omitempty"` // nil = only the global quotas apply
+		// MaxConnections is the maximum of concurrent connections per backend of the route, 0 = unlimited.
+		MaxConnections int   `json:"maxConnections,omitempty" yaml:"maxConnections,omitempty"`
+		Full           *Full `json:"full,omitempty" yaml:"full,omitempty"` // Used when all backends reached MaxConnections
+	}
+	// Quota is the rate limiting of a route, in addition to the global quotas.
+	Quota struct {
+		Connections *QuotaSettings `json:"connections,omitempty" yaml:"connections,omitempty"` // Limits new connections per second, per IP block, nil = disabled
+		Logins      *QuotaSettings `json:"logins,omitempty" yaml:"logins,omitempty"`           // Limits logins per second, per IP block, nil = disabled
+	}
+	QuotaSettings struct {
+		OPS        float32 `json:"ops" yaml:"ops"`                                   // Allowed operations/events per second, per IP block
+		Burst      int     `json:"burst" yaml:"burst"`                               // The maximum events per second, per block; the size of the token bucket
+		MaxEntries int     `json:"maxEntries,omitempty" yaml:"maxEntries,omitempty"` // Maximum number of IP blocks to keep track of in cache, 0 = default
+	}
+	// Full is the response of a route when all its backends reached the maximum of connections.
+	Full struct {
+		Status  *Status                   `json:"status,omitempty" yaml:"status,omitempty"`   // nil = status of the backends
+		Message *configutil.TextComponent `json:"message,omitempty" yaml:"message,omitempty"` // Disconnect message, nil = default
 	}
 	Status struct {
 		MOTD    *configutil.TextComponent `yaml:"motd,omitempty" json:"motd,omitempty"`
@@ -70,6 +89,15 @@ func (r *Route) GetCachePingTTL() time.Duration {
 // CachePingEnabled returns true if the route has a ping cache enabled.
 func (r *Route) CachePingEnabled() bool { return r.GetCachePingTTL() > 0 }
 
+// GetMaxEntries returns the configured max entries or a default if not set.
+func (q *QuotaSettings) GetMaxEntries() int {
+	const defaultMaxEntries = 1000
+	if q.MaxEntries == 0 {
+		return defaultMaxEntries
+	}
+	return q.MaxEntries
+}
+
 // GetTCPShieldRealIP returns the configured TCPShieldRealIP or deprecated RealIP value.
 func (r *Route) GetTCPShieldRealIP() bool { return r.TCPShieldRealIP || r.RealIP }
 
@@ -119,6 +147,32 @@ func (c Config) Validate() (warns []error, errs []error) {
 		if !slices.Contains(allowedStrategies, ep.Strategy) && ep.Strategy != "" {
 			e("Route %d: invalid strategy '%s', allowed: %v", i, ep.Strategy, allowedStrategies)
 		}
+		if ep.MaxConnections < 0 {
+			e("Route %d: invalid max connections %d, use a number >= 0", i, ep.MaxConnections)
+		}
+		if ep.Quota != nil {
+			for _, q := range []struct {
+				name  string
+				quota *QuotaSettings
+			}{
+				{"connections", ep.Quota.Connections},
+				{"logins", ep.Quota.Logins},
+			} {
+				name, quota := q.name, q.quota
+				if quota == nil {
+					continue
+				}
+				if quota.OPS <= 0 {
+					e("Route %d: invalid %s quota ops %v, use a number > 0", i, name, quota.OPS)
+				}
+				if quota.Burst < 1 {
+					e("Route %d: invalid %s quota burst %d, use a number >= 1", i, name, quota.Burst)
+				}
+				if quota.MaxEntries < 0 {
+					e("Route %d: invalid %s quota max entries %d, use a number >= 0", i, name, quota.MaxEntries)
+				}
+			}
+		}
 		for i, addr := range ep.Backend {
 			_, err := netutil.Parse(addr, "tcp")
 			if err != nil {
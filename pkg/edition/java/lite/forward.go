@@ -14,6 +14,8 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/jellydator/ttlcache/v3"
+	"go.minekube.com/common/minecraft/color"
+	"go.minekube.com/common/minecraft/component"
 	"go.minekube.com/gate/pkg/edition/java/internal/protoutil"
 	"go.minekube.com/gate/pkg/edition/java/lite/config"
 	"go.minekube.com/gate/pkg/edition/java/netmc"
@@ -22,8 +24,11 @@ import (
 	"go.minekube.com/gate/pkg/edition/java/proto/state"
 	"go.minekube.com/gate/pkg/edition/java/proto/util"
 	"go.minekube.com/gate/pkg/gate/proto"
+	"go.minekube.com/gate/pkg/internal/metrics"
 	"go.minekube.com/gate/pkg/util/errs"
 	"go.minekube.com/gate/pkg/util/netutil"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"golang.org/x/sync/singleflight"
 )
 
@@ -43,6 +48,7 @@ func Forward(
 	handshake *packet.Handshake,
 	pc *proto.PacketContext,
 	strategyManager *StrategyManager,
+	limiter *Limiter,
 ) {
 	defer func() { _ = client.Close() }()
 
@@ -52,14 +58,46 @@ func Forward(
 		return
 	}
 
+	if quota := limiter.Blocked(route, netutil.Host(src.RemoteAddr()), true); quota != "" {
+		recordRejection(route, quota)
+		log.Info("connection exceeded route rate limit, closed", "quota", quota)
+		disconnect(client, handshake, &component.Text{
+			Content: "You are logging in too fast, please calm down and retry.",
+			S:       component.Style{Color: color.Red},
+		})
+		return
+	}
+
 	// Find a backend to dial successfully.
+	var (
+		tried, full int    // number of tried backends and those that reached max connections
+		release     func() // releases the reserved backend connection
+	)
 	backendAddr, log, dst, err := tryBackends(nextBackend, func(log logr.Logger, backendAddr string) (logr.Logger, net.Conn, error) {
+		tried++
+		r, ok := limiter.Acquire(route, backendAddr)
+		if !ok {
+			full++
+			return log, nil, &errs.VerbosityError{Verbosity: 1, Err: errBackendFull}
+		}
 		conn, err := dialRoute(client.Context(), dialTimeout, src.RemoteAddr(), route, backendAddr, handshake, pc, false)
-		return log, conn, err
+		if err != nil {
+			r()
+			return log, nil, err
+		}
+		release = r
+		return log, conn, nil
 	})
 	if err != nil {
+		// Only tell the player the server is full if no backend was down
+		if full > 0 && full == tried {
+			recordRejection(route, "full")
+			log.Info("all backends reached max connections, closed", "maxConnections", route.MaxConnections)
+			disconnect(client, handshake, fullMessage(route))
+		}
 		return
 	}
+	defer release()
 	defer func() { _ = dst.Close() }()
 
 	if err = emptyReadBuff(client, dst); err != nil {
@@ -74,8 +112,33 @@ func Forward(
 		defer decrementConnection()
 	}
 
+	attrs := metric.WithAttributeSet(attribute.NewSet(
+		attribute.String("route", routeLabel(route)),
+		attribute.String("backend", backendAddr),
+	))
+	connectionsCounter.Add(context.Background(), 1, attrs)
+	activeConnectionsCounter.Add(context.Background(), 1, attrs)
+	defer activeConnectionsCounter.Add(context.Background(), -1, attrs)
+
 	log.Info("forwarding connection", "backendAddr", backendAddr)
-	pipe(log, src, dst)
+	pipe(log, src, dst, attrs)
+}
+
+// disconnect sends the disconnect reason to a client in the login state.
+func disconnect(client netmc.MinecraftConn, handshake *packet.Handshake, reason component.Component) {
+	_ = netmc.CloseWith(client, packet.NewDisconnect(reason,
+		proto.Protocol(handshake.ProtocolVersion), client.State().State))
+}
+
+// fullMessage returns the disconnect message of a route whose backends are all full.
+func fullMessage(route *config.Route) component.Component {
+	if route.Full != nil && route.Full.Message != nil {
+		return route.Full.Message.T()
+	}
+	return &component.Text{
+		Content: "The server is full, please try again later.",
+		S:       component.Style{Color: color.Red},
+	}
 }
 
 // errAllBackendsFailed is returned when all backends failed to dial.
@@ -116,19 +179,21 @@ func emptyReadBuff(src netmc.MinecraftConn, dst net.Conn) error {
 	return nil
 }
 
-func pipe(log logr.Logger, src, dst net.Conn) {
+// pipe copies data between src and dst until src is done and records
+// the transferred bytes to the metrics with the attributes as they are copied.
+func pipe(log logr.Logger, src, dst net.Conn, attrs metric.AddOption) {
 	// disable deadlines
 	var zero time.Time
 	_ = src.SetDeadline(zero)
 	_ = dst.SetDeadline(zero)
 
 	go func() {
-		i, err := io.Copy(src, dst)
+		i, err := io.Copy(metrics.CountWriter(src, sentBytesCounter, attrs), dst)
 		if log.Enabled() {
 			log.V(1).Info("done copying backend -> client", "bytes", i, "error", err)
 		}
 	}()
-	i, err := io.Copy(dst, src)
+	i, err := io.Copy(metrics.CountWriter(dst, receivedBytesCounter, attrs), src)
 	if log.Enabled() {
 		log.V(1).Info("done copying client -> backend", "bytes", i, "error", err)
 	}
@@ -315,12 +380,25 @@ func ResolveStatusResponse(
 	handshakeCtx *proto.PacketContext,
 	statusRequestCtx *proto.PacketContext,
 	strategyManager *StrategyManager,
+	limiter *Limiter,
 ) (logr.Logger, *packet.StatusResponse, error) {
 	log, src, route, nextBackend, err := findRoute(routes, log, client, handshake, strategyManager)
 	if err != nil {
 		return log, nil, err
 	}
 
+	if quota := limiter.Blocked(route, netutil.Host(src.RemoteAddr()), false); quota != "" {
+		recordRejection(route, quota)
+		return log.V(1), nil, fmt.Errorf("connection exceeded route rate limit %s", quota)
+	}
+
+	// Show the full status instead of the backend status
+	if route.Full != nil && route.Full.Status != nil && limiter.Full(route) {
+		if res := statusResponse(log, route.Full.Status, handshakeCtx.Protocol); res != nil {
+			return log, res, nil
+		}
+	}
+
 	_, log, res, err := tryBackends(nextBackend, func(log logr.Logger, backendAddr string) (logr.Logger, *packet.StatusResponse, error) {
 		// Measure status response time for latency tracking (better than dial time)
 		start := time.Now()
@@ -356,25 +434,28 @@ func handleFallbackResponse(log logr.Logger, route *config.Route, protocol proto
 	log.Info("failed to resolve status response, will use fallback status response", "error", backendErr)
 
 	// Fallback status response if configured
-	fallbackPong, err := route.Fallback.Response(protocol)
+	return statusResponse(log, route.Fallback, protocol), log
+}
+
+// statusResponse returns the status response packet of a configured status.
+func statusResponse(log logr.Logger, s *config.Status, protocol proto.Protocol) *packet.StatusResponse {
+	pong, err := s.Response(protocol)
 	if err != nil {
-		log.Info("failed to get fallback status response", "error", err)
-		return nil, log
+		log.Info("failed to get configured status response", "error", err)
+		return nil
 	}
-
-	if fallbackPong != nil {
-		status, err2 := json.Marshal(fallbackPong)
-		if err2 != nil {
-			log.Error(err2, "failed to marshal fallback status response")
-			return nil, log
-		}
-		if log.V(1).Enabled() {
-			log.V(1).Info("using fallback status response", "status", string(status))
-		}
-		return &packet.StatusResponse{Status: string(status)}, log
+	if pong == nil {
+		return nil
 	}
-
-	return nil, log
+	status, err := json.Marshal(pong)
+	if err != nil {
+		log.Error(err, "failed to marshal configured status response")
+		return nil
+	}
+	if log.V(1).Enabled() {
+		log.V(1).Info("using configured status response", "status", string(status))
+	}
+	return &packet.StatusResponse{Status: string(status)}
 }
 
 var (
@@ -488,6 +569,39 @@ func fetchStatus(
 	return decodeStatusResponse(dec)
 }
 
+// FetchStatus requests the server list status over an established backend connection.
+// It sends a status handshake with the given server address and protocol version
+// followed by a status request and returns the decoded status response.
+func FetchStatus(
+	log logr.Logger,
+	conn net.Conn,
+	serverAddress string,
+	port int,
+	protocol proto.Protocol,
+) (*packet.StatusResponse, error) {
+	enc := codec.NewEncoder(conn, proto.ServerBound, log.V(2))
+	enc.SetProtocol(protocol)
+	enc.SetState(state.Handshake)
+	if _, err := enc.WritePacket(&packet.Handshake{
+		ProtocolVersion: int(protocol),
+		ServerAddress:   serverAddress,
+		Port:            port,
+		NextStatus:      int(packet.StatusHandshakeIntent),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to write handshake packet to backend: %w", err)
+	}
+	enc.SetState(state.Status)
+	if _, err := enc.WritePacket(&packet.StatusRequest{}); err != nil {
+		return nil, fmt.Errorf("failed to write status request packet to backend: %w", err)
+	}
+
+	dec := codec.NewDecoder(conn, proto.ClientBound, log.V(2))
+	dec.SetProtocol(protocol)
+	dec.SetState(state.Status)
+
+	return decodeStatusResponse(dec)
+}
+
 // statusDecoder interface for decoding status responses (allows mocking in tests)
 type statusDecoder interface {
 	Decode() (*proto.PacketContext, error)
@@ -0,0 +1,145 @@
+package lite
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+
+	"go.minekube.com/gate/pkg/edition/java/lite/config"
+	"go.minekube.com/gate/pkg/internal/addrquota"
+)
+
+// errBackendFull is returned when a backend reached the maximum of connections of the route.
+var errBackendFull = errors.New("backend reached max connections")
+
+// Limiter enforces the quotas and backend connection caps of routes
+// for a single Gate instance.
+type Limiter struct {
+	mu     sync.Mutex
+	quotas map[string]*routeQuotas // by route label
+	conns  map[connKey]int         // active connections by route and backend
+}
+
+// connKey identifies the connections of a route to a backend,
+// since routes sharing a backend have their own max connections.
+type connKey struct {
+	route   string // route label
+	backend string // backend address
+}
+
+type routeQuotas struct {
+	cfg         config.Quota
+	connections *addrquota.Quota // nil if disabled
+	logins      *addrquota.Quota // nil if disabled
+}
+
+// NewLimiter creates a new Limiter.
+func NewLimiter() *Limiter {
+	return &Limiter{
+		quotas: map[string]*routeQuotas{},
+		conns:  map[connKey]int{},
+	}
+}
+
+// Blocked returns the name of the route quota blocking the client IP,
+// or an empty string if it is not blocked. Logins also count against the logins quota.
+func (l *Limiter) Blocked(route *config.Route, ip string, login bool) string {
+	q := l.routeQuotas(route)
+	if q == nil {
+		return ""
+	}
+	if q.connections != nil && q.connections.Blocked(ip) {
+		return "connections"
+	}
+	if login && q.logins != nil && q.logins.Blocked(ip) {
+		return "logins"
+	}
+	return ""
+}
+
+// routeQuotas returns the quotas of the route, recreating them if the route's quota config changed.
+func (l *Limiter) routeQuotas(route *config.Route) *routeQuotas {
+	if route.Quota == nil {
+		return nil
+	}
+	key := routeLabel(route)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	q, ok := l.quotas[key]
+	if ok && reflect.DeepEqual(q.cfg, *route.Quota) {
+		return q
+	}
+	q = &routeQuotas{cfg: *route.Quota}
+	if s := route.Quota.Connections; s != nil {
+		q.connections = addrquota.NewQuota(s.OPS, s.Burst, s.GetMaxEntries())
+	}
+	if s := route.Quota.Logins; s != nil {
+		q.logins = addrquota.NewQuota(s.OPS, s.Burst, s.GetMaxEntries())
+	}
+	l.quotas[key] = q
+	return q
+}
+
+// Prune removes the quotas of routes that are not in routes anymore.
+func (l *Limiter) Prune(routes []config.Route) {
+	keep := make(map[string]bool, len(routes))
+	for i := range routes {
+		if routes[i].Quota != nil {
+			keep[routeLabel(&routes[i])] = true
+		}
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for key := range l.quotas {
+		if !keep[key] {
+			delete(l.quotas, key)
+		}
+	}
+}
+
+// Acquire reserves a connection of the route to the backend if the route has
+// less than route.MaxConnections active connections to it.
+// The returned release func must be called when the connection is closed.
+// A MaxConnections of 0 means unlimited.
+func (l *Limiter) Acquire(route *config.Route, backendAddr string) (release func(), ok bool) {
+	key := connKey{route: routeLabel(route), backend: backendAddr}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if route.MaxConnections > 0 && l.conns[key] >= route.MaxConnections {
+		return nil, false
+	}
+	l.conns[key]++
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			if l.conns[key]--; l.conns[key] <= 0 {
+				delete(l.conns, key)
+			}
+		})
+	}, true
+}
+
+// Connections returns the number of active connections of the route to the backend.
+func (l *Limiter) Connections(route *config.Route, backendAddr string) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.conns[connKey{route: routeLabel(route), backend: backendAddr}]
+}
+
+// Full returns true if all backends of the route reached the route's max connections.
+func (l *Limiter) Full(route *config.Route) bool {
+	if route.MaxConnections <= 0 || len(route.Backend) == 0 {
+		return false
+	}
+	label := routeLabel(route)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, backendAddr := range route.Backend {
+		if l.conns[connKey{route: label, backend: backendAddr}] < route.MaxConnections {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,101 @@
+package lite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/edition/java/lite/config"
+)
+
+func TestLimiter_Acquire(t *testing.T) {
+	l := NewLimiter()
+	route := &config.Route{
+		Host:           []string{"play.example.com"},
+		Backend:        []string{"a:25565", "b:25565"},
+		MaxConnections: 2,
+	}
+
+	releaseA1, ok := l.Acquire(route, "a:25565")
+	require.True(t, ok)
+	_, ok = l.Acquire(route, "a:25565")
+	require.True(t, ok)
+	_, ok = l.Acquire(route, "a:25565")
+	require.False(t, ok, "backend a is full")
+	require.False(t, l.Full(route), "backend b has capacity")
+
+	_, ok = l.Acquire(route, "b:25565")
+	require.True(t, ok)
+	_, ok = l.Acquire(route, "b:25565")
+	require.True(t, ok)
+	require.True(t, l.Full(route))
+
+	// Routes sharing a backend have their own max connections
+	other := &config.Route{
+		Host:           []string{"lobby.example.com"},
+		Backend:        []string{"a:25565"},
+		MaxConnections: 1,
+	}
+	require.False(t, l.Full(other))
+	_, ok = l.Acquire(other, "a:25565")
+	require.True(t, ok)
+	require.True(t, l.Full(other))
+	require.Equal(t, 2, l.Connections(route, "a:25565"))
+
+	releaseA1()
+	releaseA1() // releasing twice has no effect
+	require.Equal(t, 1, l.Connections(route, "a:25565"))
+	require.False(t, l.Full(route))
+
+	// 0 = unlimited
+	unlimited := &config.Route{Backend: []string{"c:25565"}}
+	for range 10 {
+		_, ok = l.Acquire(unlimited, "c:25565")
+		require.True(t, ok)
+	}
+	require.False(t, l.Full(unlimited))
+}
+
+func TestLimiter_Blocked(t *testing.T) {
+	l := NewLimiter()
+	route := &config.Route{Host: []string{"play.example.com"}}
+	require.Empty(t, l.Blocked(route, "1.2.3.4", true), "no route quota")
+
+	route.Quota = &config.Quota{
+		Logins: &config.QuotaSettings{OPS: 0.001, Burst: 1},
+	}
+	require.Empty(t, l.Blocked(route, "1.2.3.4", true))
+	require.Empty(t, l.Blocked(route, "1.2.3.4", false), "pings are not logins")
+	require.Equal(t, "logins", l.Blocked(route, "1.2.3.5", true), "same IP block")
+	require.Empty(t, l.Blocked(route, "5.6.7.8", true), "other IP block")
+
+	// Other routes are not affected
+	other := &config.Route{Host: []string{"tournament.example.com"}, Quota: &config.Quota{
+		Logins: &config.QuotaSettings{OPS: 100, Burst: 100},
+	}}
+	require.Empty(t, l.Blocked(other, "1.2.3.4", true))
+
+	// Changed quotas are recreated
+	route.Quota = &config.Quota{
+		Connections: &config.QuotaSettings{OPS: 0.001, Burst: 1},
+	}
+	require.Empty(t, l.Blocked(route, "1.2.3.4", true))
+	require.Equal(t, "connections", l.Blocked(route, "1.2.3.4", false))
+}
+
+func TestLimiter_Prune(t *testing.T) {
+	l := NewLimiter()
+	quota := &config.Quota{Logins: &config.QuotaSettings{OPS: 0.001, Burst: 1}}
+	kept := config.Route{Host: []string{"play.example.com"}, Quota: quota}
+	removed := config.Route{Host: []string{"old.example.com"}, Quota: quota}
+	l.Blocked(&kept, "1.2.3.4", true)
+	l.Blocked(&removed, "1.2.3.4", true)
+	require.Len(t, l.quotas, 2)
+
+	l.Prune([]config.Route{kept})
+	require.Len(t, l.quotas, 1)
+	require.Equal(t, "logins", l.Blocked(&kept, "1.2.3.4", true), "kept quota state")
+
+	l.Prune(nil)
+	require.Empty(t, l.quotas)
+}
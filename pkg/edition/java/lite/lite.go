@@ -4,12 +4,14 @@ package lite
 // This provides a clean abstraction for lite mode features and avoids global state.
 type Lite struct {
 	strategyManager *StrategyManager
+	limiter         *Limiter
 }
 
 // NewLite creates a new Lite instance for a Gate proxy.
 func NewLite() *Lite {
 	return &Lite{
 		strategyManager: NewStrategyManager(),
+		limiter:         NewLimiter(),
 	}
 }
 
@@ -17,3 +19,8 @@ func NewLite() *Lite {
 func (l *Lite) StrategyManager() *StrategyManager {
 	return l.strategyManager
 }
+
+// Limiter returns the limiter of route quotas and backend connections.
+func (l *Lite) Limiter() *Limiter {
+	return l.limiter
+}
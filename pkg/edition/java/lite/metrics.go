@@ -0,0 +1,56 @@
+package lite
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"go.minekube.com/gate/pkg/edition/java/lite/config"
+	"go.minekube.com/gate/pkg/internal/metrics"
+)
+
+var meter = otel.Meter("java/lite")
+
+var (
+	activeConnectionsCounter = metrics.Must(meter.Int64UpDownCounter(
+		"gate.lite.active_connections",
+		metric.WithDescription("The current number of connections forwarded per route and backend"),
+		metric.WithUnit("1"),
+	))
+	connectionsCounter = metrics.Must(meter.Int64Counter(
+		"gate.lite.connections",
+		metric.WithDescription("The number of connections forwarded per route and backend"),
+		metric.WithUnit("1"),
+	))
+	receivedBytesCounter = metrics.Must(meter.Int64Counter(
+		"gate.lite.received",
+		metric.WithDescription("The bytes received from clients per route and backend"),
+		metric.WithUnit("By"),
+	))
+	sentBytesCounter = metrics.Must(meter.Int64Counter(
+		"gate.lite.sent",
+		metric.WithDescription("The bytes sent to clients per route and backend"),
+		metric.WithUnit("By"),
+	))
+	rejectionsCounter = metrics.Must(meter.Int64Counter(
+		"gate.lite.rejections",
+		metric.WithDescription("The number of connections rejected per route by a route quota or because all backends were full"),
+		metric.WithUnit("1"),
+	))
+)
+
+// routeLabel returns the route label value of the route's hosts.
+func routeLabel(route *config.Route) string {
+	return strings.Join(route.Host, ",")
+}
+
+// recordRejection records a connection rejected for the reason.
+func recordRejection(route *config.Route, reason string) {
+	rejectionsCounter.Add(context.Background(), 1, metric.WithAttributes(
+		attribute.String("route", routeLabel(route)),
+		attribute.String("reason", reason),
+	))
+}
@@ -0,0 +1,53 @@
+package lite
+
+import (
+	"net"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/gate/pkg/edition/java/proto/codec"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet"
+	"go.minekube.com/gate/pkg/edition/java/proto/state"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+	"go.minekube.com/gate/pkg/gate/proto"
+)
+
+func TestFetchStatus(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	const status = `{"version":{"name":"Test","protocol":767},"players":{"online":5,"max":20},"description":"Test Server"}`
+	protocol := version.Minecraft_1_21.Protocol
+
+	handshakes := make(chan *packet.Handshake, 1)
+	go func() {
+		dec := codec.NewDecoder(server, proto.ServerBound, logr.Discard())
+		dec.SetProtocol(protocol)
+		pc, err := dec.Decode()
+		if err != nil {
+			return
+		}
+		handshakes <- pc.Packet.(*packet.Handshake)
+
+		dec.SetState(state.Status)
+		if _, err = dec.Decode(); err != nil {
+			return
+		}
+		enc := codec.NewEncoder(server, proto.ClientBound, logr.Discard())
+		enc.SetProtocol(protocol)
+		enc.SetState(state.Status)
+		_, _ = enc.WritePacket(&packet.StatusResponse{Status: status})
+	}()
+
+	res, err := FetchStatus(logr.Discard(), client, "backend.example.com", 25566, protocol)
+	require.NoError(t, err)
+	require.Equal(t, status, res.Status)
+
+	h := <-handshakes
+	require.Equal(t, "backend.example.com", h.ServerAddress)
+	require.Equal(t, 25566, h.Port)
+	require.Equal(t, int(protocol), h.ProtocolVersion)
+	require.Equal(t, packet.StatusHandshakeIntent, h.Intent())
+}
@@ -74,6 +74,8 @@ type MinecraftConn interface { // TODO convert to exported struct as this interf
 	// SetAutoReading sets whether the connection should automatically read packets from the underlying connection.
 	// Default is true.
 	SetAutoReading(bool)
+	// SetPacketInterceptor sets the PacketInterceptor called for read packets. nil removes the interceptor.
+	SetPacketInterceptor(PacketInterceptor)
 
 	StateChanger
 	PacketWriter
@@ -133,6 +135,10 @@ type SessionHandler interface {
 	Deactivated() // Called when the connection is no longer managed by this SessionHandler.
 }
 
+// PacketInterceptor is called with every packet read from a connection before
+// it is passed to the active SessionHandler. Returning false drops the packet.
+type PacketInterceptor func(pc *proto.PacketContext) (handle bool)
+
 // NewMinecraftConn returns a new MinecraftConn and the func to start the blocking read-loop.
 func NewMinecraftConn(
 	ctx context.Context,
@@ -183,6 +189,7 @@ type minecraftConn struct {
 	wr Writer
 
 	autoReading *stateControl // Whether the connection should automatically read packets from the underlying connection.
+	interceptor atomic.Pointer[PacketInterceptor]
 
 	ctx             context.Context // is canceled when connection closed
 	cancelCtx       context.CancelFunc
@@ -239,11 +246,10 @@ func (c *minecraftConn) startReadLoop() {
 		}
 		bytesRead += int64(packetCtx.BytesRead)
 
-		// TODO wrap packetCtx into struct with source info
-		// (minecraftConn) and chain into packet interceptor to...
-		//  - packet interception
-		//  - statistics / count bytes
-		//  - in turn call session handler
+		// Let the interceptor inspect, modify or drop the packet
+		if intercept := c.interceptor.Load(); intercept != nil && !(*intercept)(packetCtx) {
+			return true
+		}
 
 		sessionHandler := c.ActiveSessionHandler()
 
@@ -291,6 +297,14 @@ func (c *minecraftConn) SetAutoReading(enabled bool) {
 	c.autoReading.SetState(enabled)
 }
 
+func (c *minecraftConn) SetPacketInterceptor(fn PacketInterceptor) {
+	if fn == nil {
+		c.interceptor.Store(nil)
+		return
+	}
+	c.interceptor.Store(&fn)
+}
+
 func (c *minecraftConn) Context() context.Context { return c.ctx }
 
 func (c *minecraftConn) Flush() error {
@@ -0,0 +1,121 @@
+package netmc
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"time"
+
+	"go.minekube.com/gate/pkg/edition/java/proto/packet"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+	"go.minekube.com/gate/pkg/gate/proto"
+	"go.minekube.com/gate/pkg/util/errs"
+)
+
+// legacyPingWait is how long to wait for the next byte of a legacy ping prefix.
+// Pre-1.6 clients send only part of the prefix and wait for the response,
+// while modern clients send the rest of their first packet right away.
+const legacyPingWait = 500 * time.Millisecond
+
+// readLegacyPacket reads a legacy (pre-1.7) ping or handshake if the client
+// connection starts with one. It returns nil if the connection starts with a
+// modern packet, which is left unread.
+//
+// Legacy pings are told apart by their prefix: Beta 1.8 to 1.3 clients send 0xFE,
+// 1.4 and 1.5 clients send 0xFE 0x01 and 1.6 clients follow it with the
+// "MC|PingHost" plugin message (0xFA). A modern packet can start with the same
+// bytes as its length VarInt, but is followed by more bytes right away and
+// the packet id of a modern handshake is 0x00, not 0xFA.
+// The read deadline of conn is reset to readTimeout when done.
+func readLegacyPacket(rd *bufio.Reader, conn net.Conn, readTimeout time.Duration) (*proto.PacketContext, error) {
+	defer func() { _ = conn.SetReadDeadline(time.Now().Add(readTimeout)) }()
+
+	buf, err := rd.Peek(1)
+	if err != nil {
+		return nil, &errs.SilentError{Err: err}
+	}
+	id := buf[0]
+	switch id {
+	case packet.LegacyPingID:
+	case packet.LegacyHandshakeID:
+		// A modern handshake is never only 2 bytes long, so this is a legacy
+		// handshake. We can only disconnect the client, so discard the rest.
+		n, _ := rd.Discard(rd.Buffered())
+		return legacyPacketContext(id, &packet.LegacyHandshake{}, n), nil
+	default:
+		return nil, nil
+	}
+
+	buf, err = peekLegacy(rd, conn, 2)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		_, _ = rd.Discard(1)
+		return legacyPacketContext(id, &packet.LegacyPing{Version: packet.LegacyPingVersion13}, 1), nil
+	}
+	if buf[1] != 0x01 {
+		return nil, nil
+	}
+
+	buf, err = peekLegacy(rd, conn, 3)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		_, _ = rd.Discard(2)
+		return legacyPacketContext(id, &packet.LegacyPing{Version: packet.LegacyPingVersion14}, 2), nil
+	}
+	if buf[2] != 0xFA {
+		return nil, nil
+	}
+
+	// The plugin message follows right away, read it with the regular timeout.
+	_ = conn.SetReadDeadline(time.Now().Add(readTimeout))
+	_, _ = rd.Discard(2)
+	ping := new(packet.LegacyPing)
+	cr := &countingReader{Reader: rd}
+	if err = ping.Decode(nil, cr); err != nil {
+		return nil, &errs.SilentError{Err: err}
+	}
+	return legacyPacketContext(id, ping, 2+cr.n), nil
+}
+
+// peekLegacy peeks the first n bytes of the connection, waiting at most
+// legacyPingWait for missing bytes. It returns nil if they did not arrive in time.
+func peekLegacy(rd *bufio.Reader, conn net.Conn, n int) ([]byte, error) {
+	if rd.Buffered() < n {
+		_ = conn.SetReadDeadline(time.Now().Add(legacyPingWait))
+	}
+	buf, err := rd.Peek(n)
+	if err != nil {
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			return nil, nil
+		}
+		return nil, &errs.SilentError{Err: err}
+	}
+	return buf, nil
+}
+
+func legacyPacketContext(id byte, pk proto.Packet, n int) *proto.PacketContext {
+	return &proto.PacketContext{
+		Direction: proto.ServerBound,
+		Protocol:  version.Legacy.Protocol,
+		PacketID:  proto.PacketID(id),
+		Packet:    pk,
+		BytesRead: n,
+	}
+}
+
+type countingReader struct {
+	io.Reader
+	n int
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.n += n
+	return n, err
+}
@@ -0,0 +1,85 @@
+package netmc
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/edition/java/proto/packet"
+)
+
+func TestReadLegacyPacket(t *testing.T) {
+	ping16 := new(bytes.Buffer)
+	require.NoError(t, (&packet.LegacyPing{
+		Version:  packet.LegacyPingVersion16,
+		Protocol: 78,
+		Host:     "localhost",
+		Port:     25565,
+	}).Encode(nil, ping16))
+
+	tests := []struct {
+		name string
+		data [][]byte // written in pieces
+		want any
+	}{
+		{"beta", [][]byte{{0xFE}}, &packet.LegacyPing{Version: packet.LegacyPingVersion13}},
+		{"1.4", [][]byte{{0xFE, 0x01}}, &packet.LegacyPing{Version: packet.LegacyPingVersion14}},
+		{"1.4 split", [][]byte{{0xFE}, {0x01}}, &packet.LegacyPing{Version: packet.LegacyPingVersion14}},
+		{"1.6", [][]byte{ping16.Bytes()}, &packet.LegacyPing{
+			Version:  packet.LegacyPingVersion16,
+			Protocol: 78,
+			Host:     "localhost",
+			Port:     25565,
+		}},
+		{"1.6 split", [][]byte{ping16.Bytes()[:1], ping16.Bytes()[1:3], ping16.Bytes()[3:]}, &packet.LegacyPing{
+			Version:  packet.LegacyPingVersion16,
+			Protocol: 78,
+			Host:     "localhost",
+			Port:     25565,
+		}},
+		{"legacy handshake", [][]byte{{0x02, 0x4E, 0x00, 0x01}}, &packet.LegacyHandshake{}},
+		// A modern handshake with a length of 254 has the same first two bytes as a 1.4 ping.
+		{"modern handshake", [][]byte{append([]byte{0xFE, 0x01, 0x00}, make([]byte, 253)...)}, nil},
+		{"modern handshake split", [][]byte{{0xFE}, {0x01}, append([]byte{0x00}, make([]byte, 253)...)}, nil},
+		{"modern", [][]byte{{0x10, 0x00, 0xFD, 0x05}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, server := net.Pipe()
+			defer client.Close()
+			defer server.Close()
+			var data []byte
+			for _, piece := range tt.data {
+				data = append(data, piece...)
+			}
+			go func() {
+				// Clients waiting for a response keep the connection open.
+				for _, piece := range tt.data {
+					_, _ = client.Write(piece)
+					time.Sleep(10 * time.Millisecond)
+				}
+			}()
+
+			rd := bufio.NewReader(server)
+			pc, err := readLegacyPacket(rd, server, time.Second)
+			require.NoError(t, err)
+			if tt.want == nil {
+				require.Nil(t, pc)
+				rest := make([]byte, len(data))
+				_, err = io.ReadFull(rd, rest)
+				require.NoError(t, err)
+				require.Equal(t, data, rest, "modern packet must be left unread")
+				return
+			}
+			require.NotNil(t, pc)
+			require.Equal(t, tt.want, pc.Packet)
+			require.Equal(t, len(data), pc.BytesRead)
+			require.Zero(t, rd.Buffered())
+		})
+	}
+}
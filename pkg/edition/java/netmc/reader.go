@@ -40,6 +40,7 @@ func NewReader(conn net.Conn, direction proto.Direction, readTimeout time.Durati
 		log:         log.WithName("reader"),
 		readBuf:     readBuf,
 		Decoder:     codec.NewDecoder(readBuf, direction, log.V(2)),
+		checkLegacy: direction == proto.ServerBound,
 	}
 }
 
@@ -48,6 +49,7 @@ type reader struct {
 	readTimeout time.Duration
 	c           net.Conn // underlying connection
 	readBuf     *bufio.Reader
+	checkLegacy bool // Whether the next packet may be a legacy ping or handshake
 	*codec.Decoder
 }
 
@@ -55,7 +57,7 @@ func (r *reader) ReadPacket() (*proto.PacketContext, error) {
 	// Set read timeout to wait for client to send a packet
 	_ = r.c.SetReadDeadline(time.Now().Add(r.readTimeout))
 
-	packetCtx, err := r.Decode()
+	packetCtx, err := r.readPacket()
 	if err != nil && !errors.Is(err, proto.ErrDecoderLeftBytes) { // Ignore this error.
 		if r.handleReadErr(err) {
 			r.log.V(1).Info("error reading packet, recovered", "error", err)
@@ -67,6 +69,17 @@ func (r *reader) ReadPacket() (*proto.PacketContext, error) {
 	return packetCtx, nil
 }
 
+func (r *reader) readPacket() (*proto.PacketContext, error) {
+	if r.checkLegacy {
+		// Only the first packet of a client connection can be a legacy packet.
+		r.checkLegacy = false
+		if packetCtx, err := readLegacyPacket(r.readBuf, r.c, r.readTimeout); packetCtx != nil || err != nil {
+			return packetCtx, err
+		}
+	}
+	return r.Decode()
+}
+
 // handles error when read the next packet
 func (r *reader) handleReadErr(err error) (recoverable bool) {
 	var silentErr *errs.SilentError
@@ -2,11 +2,13 @@ package netmc
 
 import (
 	"bufio"
+	"bytes"
 	"net"
 	"time"
 
 	"github.com/go-logr/logr"
 	"go.minekube.com/gate/pkg/edition/java/proto/codec"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet"
 	"go.minekube.com/gate/pkg/gate/proto"
 )
 
@@ -58,6 +60,23 @@ func (w *writer) Flush() (err error) {
 	return w.Encoder.Sync(w.writeBuf.Flush)
 }
 
+// WritePacket writes a packet to the connection's write buffer.
+// Legacy disconnects are not framed and written as is.
+func (w *writer) WritePacket(p proto.Packet) (n int, err error) {
+	if _, ok := p.(*packet.LegacyDisconnect); !ok {
+		return w.Encoder.WritePacket(p)
+	}
+	buf := new(bytes.Buffer)
+	if err = p.Encode(&proto.PacketContext{Direction: w.Direction(), Packet: p}, buf); err != nil {
+		return 0, err
+	}
+	err = w.Encoder.Sync(func() error {
+		n, err = w.writeBuf.Write(buf.Bytes())
+		return err
+	})
+	return n, err
+}
+
 func (w *writer) SetCompressionThreshold(threshold int) error {
 	return w.Encoder.SetCompression(threshold, w.compressionLevel)
 }
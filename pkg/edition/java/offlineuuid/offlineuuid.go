@@ -0,0 +1,221 @@
+// Package offlineuuid resolves the UUIDs of players joining in offline mode.
+package offlineuuid
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"golang.org/x/sync/singleflight"
+
+	"go.minekube.com/gate/pkg/edition/java/auth/hybrid"
+	"go.minekube.com/gate/pkg/util/fileutil"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+// Strategy derives the UUID of an offline mode player from the username.
+type Strategy interface {
+	UUID(ctx context.Context, username string) (uuid.UUID, error)
+}
+
+// StrategyFunc is a function implementing Strategy.
+type StrategyFunc func(ctx context.Context, username string) (uuid.UUID, error)
+
+// UUID implements Strategy.
+func (fn StrategyFunc) UUID(ctx context.Context, username string) (uuid.UUID, error) {
+	return fn(ctx, username)
+}
+
+// Offline is the Bukkit-style Strategy hashing "OfflinePlayer:<username>",
+// matching the UUIDs of offline mode servers.
+var Offline Strategy = StrategyFunc(func(_ context.Context, username string) (uuid.UUID, error) {
+	return uuid.OfflinePlayerUUID(username), nil
+})
+
+// Premium is a Strategy using the UUID of the premium account with the username,
+// so that players keep their data when the network switches to online mode.
+// Usernames without a premium account get the Offline UUID.
+//
+// If a lookup fails, the last looked up UUID of the username is returned
+// even if its cache entry expired, so that players keep their UUID while the API is down.
+type Premium struct {
+	API      *hybrid.ProfileAPI // Looks up premium UUIDs
+	CacheTTL time.Duration      // How long looked up UUIDs are cached, 0 = not cached
+
+	mu    sync.Mutex
+	cache map[string]cachedUUID // by lowercase username
+	group singleflight.Group    // concurrent lookups by lowercase username
+}
+
+type cachedUUID struct {
+	id      uuid.UUID
+	expires time.Time
+}
+
+// staleTTL is how long expired cache entries are kept
+// as fallback for failed lookups.
+const staleTTL = 24 * time.Hour
+
+// defaultLookupTimeout is the timeout of lookups if the API has none,
+// since lookups are not canceled by the context of the caller.
+const defaultLookupTimeout = 10 * time.Second
+
+var _ Strategy = (*Premium)(nil)
+
+// UUID implements Strategy.
+func (p *Premium) UUID(ctx context.Context, username string) (uuid.UUID, error) {
+	name := strings.ToLower(username)
+	p.mu.Lock()
+	c, cached := p.cache[name]
+	p.mu.Unlock()
+	if cached && time.Now().Before(c.expires) {
+		return c.id, nil
+	}
+
+	// Lookups are shared by concurrent logins with the same username
+	// and must not be canceled if the first of them disconnects.
+	v, err, _ := p.group.Do(name, func() (any, error) {
+		return p.lookup(context.WithoutCancel(ctx), username)
+	})
+	if err != nil {
+		if cached {
+			return c.id, nil
+		}
+		return uuid.Nil, err
+	}
+	return v.(uuid.UUID), nil
+}
+
+func (p *Premium) lookup(ctx context.Context, username string) (uuid.UUID, error) {
+	if p.API.Timeout <= 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultLookupTimeout)
+		defer cancel()
+	}
+	id, premium, err := p.API.Lookup(ctx, username)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if !premium {
+		id = uuid.OfflinePlayerUUID(username)
+	}
+	if p.CacheTTL > 0 {
+		now := time.Now()
+		p.mu.Lock()
+		if p.cache == nil {
+			p.cache = map[string]cachedUUID{}
+		}
+		for n, e := range p.cache {
+			if now.Sub(e.expires) > staleTTL {
+				delete(p.cache, n)
+			}
+		}
+		p.cache[strings.ToLower(username)] = cachedUUID{id: id, expires: now.Add(p.CacheTTL)}
+		p.mu.Unlock()
+	}
+	return id, nil
+}
+
+// Resolver resolves the UUIDs of offline mode players with a Strategy.
+// If a Mapping is set, the UUIDs of players that logged in are persisted and reused
+// for the username so that they stay stable, even if the Strategy would return
+// another UUID later on, e.g. when a premium username moved to another account.
+type Resolver struct {
+	strategy Strategy
+	mapping  *Mapping
+}
+
+// NewResolver returns a new Resolver.
+// If strategy is nil, Offline is used. The mapping is optional.
+func NewResolver(strategy Strategy, mapping *Mapping) *Resolver {
+	if strategy == nil {
+		strategy = Offline
+	}
+	return &Resolver{strategy: strategy, mapping: mapping}
+}
+
+// Resolve returns the UUID of the offline mode player with the username.
+// If the Strategy fails, an error is returned instead of falling back to another UUID,
+// since players would lose their data when joining with another UUID.
+// Call LoggedIn once the player logged in with the UUID.
+func (r *Resolver) Resolve(ctx context.Context, username string) (uuid.UUID, error) {
+	if r.mapping != nil {
+		if id, ok := r.mapping.Get(username); ok {
+			return id, nil
+		}
+	}
+	id, err := r.strategy.UUID(ctx, username)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("error resolving uuid: %w", err)
+	}
+	return id, nil
+}
+
+// LoggedIn maps the username to the UUID resolved for it once the player logged in,
+// so that usernames disconnecting during login are not persisted.
+// Usernames already mapped are left unchanged.
+func (r *Resolver) LoggedIn(ctx context.Context, username string, id uuid.UUID) {
+	if r.mapping == nil {
+		return
+	}
+	if _, ok := r.mapping.Get(username); ok {
+		return
+	}
+	if err := r.mapping.Put(username, id); err != nil {
+		logr.FromContextOrDiscard(ctx).WithName("offlineuuid").
+			Error(err, "error storing uuid mapping", "username", username)
+	}
+}
+
+// Mapping is a JSON file mapping lowercase usernames to UUIDs.
+// It can be edited while the proxy is stopped to migrate
+// UUIDs from other proxies or to keep the UUID of renamed players.
+type Mapping struct {
+	path string
+
+	mu  sync.RWMutex
+	ids map[string]uuid.UUID // by lowercase username
+}
+
+// NewMapping returns a Mapping loading the file at path.
+// The file is created on the first Put if it does not exist.
+func NewMapping(path string) (*Mapping, error) {
+	m := &Mapping{path: path, ids: map[string]uuid.UUID{}}
+	var ids map[string]uuid.UUID
+	if err := fileutil.ReadJSON(path, &ids); err != nil {
+		return nil, fmt.Errorf("error loading uuid mapping: %w", err)
+	}
+	for name, id := range ids {
+		m.ids[strings.ToLower(name)] = id
+	}
+	return m, nil
+}
+
+// Get returns the UUID mapped to the username.
+func (m *Mapping) Get(username string) (uuid.UUID, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	id, ok := m.ids[strings.ToLower(username)]
+	return id, ok
+}
+
+// Put maps the username to the UUID and rewrites the file.
+func (m *Mapping) Put(username string, id uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	name := strings.ToLower(username)
+	prev, existed := m.ids[name]
+	m.ids[name] = id
+	if err := fileutil.WriteJSON(m.path, m.ids); err != nil {
+		if existed {
+			m.ids[name] = prev
+		} else {
+			delete(m.ids, name)
+		}
+		return fmt.Errorf("error writing uuid mapping: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package offlineuuid
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/edition/java/auth/hybrid"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+func TestPremium(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Path == "/Notch" {
+			_, _ = w.Write([]byte(`{"id":"069a79f444e94726a5befca90e38aaf5","name":"Notch"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	p := &Premium{API: &hybrid.ProfileAPI{URL: u, Client: srv.Client()}, CacheTTL: time.Hour}
+
+	ctx := context.Background()
+	id, err := p.UUID(ctx, "Notch")
+	require.NoError(t, err)
+	require.Equal(t, "069a79f4-44e9-4726-a5be-fca90e38aaf5", id.String())
+	id, err = p.UUID(ctx, "notch")
+	require.NoError(t, err)
+	require.Equal(t, "069a79f4-44e9-4726-a5be-fca90e38aaf5", id.String())
+	require.Equal(t, int32(1), requests.Load(), "cached")
+
+	id, err = p.UUID(ctx, "Cracked")
+	require.NoError(t, err)
+	require.Equal(t, uuid.OfflinePlayerUUID("Cracked"), id)
+}
+
+func TestResolver(t *testing.T) {
+	ctx := context.Background()
+	id, err := NewResolver(nil, nil).Resolve(ctx, "Steve")
+	require.NoError(t, err)
+	require.Equal(t, uuid.OfflinePlayerUUID("Steve"), id)
+
+	path := filepath.Join(t.TempDir(), "uuids.json")
+	m, err := NewMapping(path)
+	require.NoError(t, err)
+	first := uuid.New()
+	r := NewResolver(StrategyFunc(func(context.Context, string) (uuid.UUID, error) {
+		return first, nil
+	}), m)
+	id, err = r.Resolve(ctx, "Steve")
+	require.NoError(t, err)
+	require.Equal(t, first, id)
+	_, ok := m.Get("Steve")
+	require.False(t, ok, "not mapped before login")
+	r.LoggedIn(ctx, "Steve", id)
+
+	// The mapped UUID stays stable across restarts and strategies
+	m, err = NewMapping(path)
+	require.NoError(t, err)
+	r = NewResolver(StrategyFunc(func(context.Context, string) (uuid.UUID, error) {
+		return uuid.New(), nil
+	}), m)
+	id, err = r.Resolve(ctx, "steve")
+	require.NoError(t, err)
+	require.Equal(t, first, id)
+
+	// Failed lookups do not fall back to another UUID
+	r = NewResolver(StrategyFunc(func(context.Context, string) (uuid.UUID, error) {
+		return uuid.Nil, errors.New("down")
+	}), m)
+	_, err = r.Resolve(ctx, "Alex")
+	require.Error(t, err)
+	_, ok = m.Get("Alex")
+	require.False(t, ok)
+}
+
+func TestPremiumFallback(t *testing.T) {
+	var down atomic.Bool
+	var requests atomic.Int32
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		if down.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"069a79f444e94726a5befca90e38aaf5","name":"Notch"}`))
+	}))
+	defer srv.Close()
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	p := &Premium{API: &hybrid.ProfileAPI{URL: u, Client: srv.Client()}, CacheTTL: time.Nanosecond}
+
+	// Concurrent lookups of the same username share a request
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id, err := p.UUID(ctx, "Notch")
+			require.NoError(t, err)
+			require.Equal(t, "069a79f4-44e9-4726-a5be-fca90e38aaf5", id.String())
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	require.Equal(t, int32(1), requests.Load())
+
+	// The expired UUID is kept while the API is down
+	down.Store(true)
+	id, err := p.UUID(ctx, "Notch")
+	require.NoError(t, err)
+	require.Equal(t, "069a79f4-44e9-4726-a5be-fca90e38aaf5", id.String())
+
+	_, err = p.UUID(ctx, "Unknown")
+	require.Error(t, err)
+}
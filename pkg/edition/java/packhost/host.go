@@ -0,0 +1,303 @@
+// Package packhost serves resource pack files from a local directory over HTTP
+// with per-player tokenized download URLs.
+package packhost
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/go-logr/logr"
+
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+// watchDebounce is how long to wait for more changes in the directory before hashing packs again.
+const watchDebounce = 500 * time.Millisecond
+
+// ErrNotFound is returned when a pack does not exist in the directory.
+var ErrNotFound = errors.New("resource pack not found")
+
+// Pack is a resource pack in the directory of a Host.
+type Pack struct {
+	Name    string    // File name in the directory, e.g. "lobby.zip"
+	Hash    []byte    // SHA-1 hash of the file
+	Size    int64     // Size of the file in bytes
+	ModTime time.Time // Modification time of the file
+}
+
+// Host serves the resource packs (.zip files) in a directory.
+//
+// Packs are downloaded from per-player URLs containing a token derived from a secret
+// that is generated when creating the Host, so URLs can't be guessed and are only
+// valid for the player they were created for.
+type Host struct {
+	dir     string
+	baseURL string
+	secret  []byte
+	allowed func(player uuid.UUID) bool
+
+	mu    sync.RWMutex
+	packs map[string]Pack // by file name
+}
+
+// New returns a new Host serving the packs in the directory.
+// The base URL is the public URL players reach the Host at, e.g. "http://example.com:25590".
+// If allowed is not nil, only players it returns true for can download packs, e.g. online players.
+// The packs are hashed before New returns.
+func New(dir, baseURL string, allowed func(player uuid.UUID) bool) (*Host, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base url %q: %w", baseURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid base url %q: must be a http or https URL", baseURL)
+	}
+	secret := make([]byte, 32)
+	if _, err = rand.Read(secret); err != nil {
+		return nil, err
+	}
+	h := &Host{
+		dir:     dir,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		secret:  secret,
+		allowed: allowed,
+		packs:   map[string]Pack{},
+	}
+	if _, err = h.Scan(); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// Pack returns the pack with the file name.
+func (h *Host) Pack(name string) (Pack, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	p, ok := h.packs[name]
+	return p, ok
+}
+
+// Packs returns all packs in the directory.
+func (h *Host) Packs() []Pack {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	packs := make([]Pack, 0, len(h.packs))
+	for _, p := range h.packs {
+		packs = append(packs, p)
+	}
+	return packs
+}
+
+// URL returns the download URL of the pack for the player.
+func (h *Host) URL(player uuid.UUID, name string) (string, error) {
+	if _, ok := h.Pack(name); !ok {
+		return "", fmt.Errorf("%w: %q", ErrNotFound, name)
+	}
+	return fmt.Sprintf("%s/%s/%s/%s", h.baseURL, player.Undashed(),
+		h.token(player, name), url.PathEscape(name)), nil
+}
+
+func (h *Host) token(player uuid.UUID, name string) string {
+	mac := hmac.New(sha256.New, h.secret)
+	mac.Write(player[:])
+	mac.Write([]byte(name))
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
+
+// Scan hashes the packs in the directory again and returns the packs that were added or modified.
+func (h *Host) Scan() (updated []Pack, err error) {
+	entries, err := os.ReadDir(h.dir)
+	if err != nil {
+		return nil, err
+	}
+	h.mu.RLock()
+	old := h.packs
+	h.mu.RUnlock()
+
+	packs := make(map[string]Pack, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !packFile(entry.Name()) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue // removed in the meantime
+			}
+			return nil, err
+		}
+		name := entry.Name()
+		if p, ok := old[name]; ok && p.Size == info.Size() && p.ModTime.Equal(info.ModTime()) {
+			packs[name] = p
+			continue
+		}
+		hash, err := hashFile(filepath.Join(h.dir, name))
+		if err != nil {
+			return nil, err
+		}
+		p := Pack{Name: name, Hash: hash, Size: info.Size(), ModTime: info.ModTime()}
+		packs[name] = p
+		if prev, ok := old[name]; !ok || !bytes.Equal(prev.Hash, hash) {
+			updated = append(updated, p)
+		}
+	}
+
+	h.mu.Lock()
+	h.packs = packs
+	h.mu.Unlock()
+	return updated, nil
+}
+
+// Watch watches the directory and hashes the packs again when files change
+// until the context is canceled. The update func is called with the packs
+// that were modified, but not with packs that were added.
+func (h *Host) Watch(ctx context.Context, update func(modified []Pack)) error {
+	log := logr.FromContextOrDiscard(ctx).WithValues("directory", h.dir)
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("error creating directory watcher: %w", err)
+	}
+	defer func() { _ = watcher.Close() }()
+	if err = watcher.Add(h.dir); err != nil {
+		return fmt.Errorf("error watching directory %q: %w", h.dir, err)
+	}
+
+	debounce := time.NewTimer(watchDebounce)
+	debounce.Stop()
+	defer debounce.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			log.Info("error watching resource packs", "error", err)
+		case e, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if packFile(e.Name) {
+				debounce.Reset(watchDebounce)
+			}
+		case <-debounce.C:
+			known := map[string]bool{}
+			for _, p := range h.Packs() {
+				known[p.Name] = true
+			}
+			updated, err := h.Scan()
+			if err != nil {
+				log.Info("failed to hash resource packs", "error", err)
+				continue
+			}
+			var modified []Pack
+			for _, p := range updated {
+				log.Info("resource pack updated", "pack", p.Name, "hash", hex.EncodeToString(p.Hash))
+				if known[p.Name] {
+					modified = append(modified, p)
+				}
+			}
+			if len(modified) != 0 && update != nil {
+				update(modified)
+			}
+		}
+	}
+}
+
+// ServeHTTP serves pack downloads at "/<player>/<token>/<name>".
+func (h *Host) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if len(parts) != 3 {
+		http.NotFound(w, r)
+		return
+	}
+	player, err := uuid.Parse(parts[0])
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	name := parts[2]
+	if !hmac.Equal([]byte(parts[1]), []byte(h.token(player, name))) {
+		http.NotFound(w, r)
+		return
+	}
+	if h.allowed != nil && !h.allowed(player) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+	if _, ok := h.Pack(name); !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	f, err := os.Open(filepath.Join(h.dir, name))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer func() { _ = f.Close() }()
+	info, err := f.Stat()
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/zip")
+	http.ServeContent(w, r, name, info.ModTime(), f)
+}
+
+// ListenAndServe listens on the TCP address and serves pack
+// downloads until the context is canceled.
+func (h *Host) ListenAndServe(ctx context.Context, addr string) error {
+	var lc net.ListenConfig
+	ln, err := lc.Listen(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	srv := &http.Server{Handler: h, ReadHeaderTimeout: 10 * time.Second}
+	go func() { <-ctx.Done(); _ = srv.Close() }()
+	if err = srv.Serve(ln); errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+func hashFile(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	s := sha1.New()
+	if _, err = io.Copy(s, f); err != nil {
+		return nil, fmt.Errorf("error hashing %q: %w", path, err)
+	}
+	return s.Sum(nil), nil
+}
+
+func packFile(name string) bool {
+	base := filepath.Base(name)
+	return !strings.HasPrefix(base, ".") && strings.EqualFold(filepath.Ext(base), ".zip")
+}
@@ -0,0 +1,101 @@
+package packhost
+
+import (
+	"context"
+	"crypto/sha1"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+func TestHost(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "lobby.zip"), []byte("lobby"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("ignored"), 0o644))
+
+	online := uuid.New()
+	offline := uuid.New()
+	h, err := New(dir, "http://example.com:25590/", func(player uuid.UUID) bool { return player == online })
+	require.NoError(t, err)
+
+	require.Len(t, h.Packs(), 1)
+	pack, ok := h.Pack("lobby.zip")
+	require.True(t, ok)
+	want := sha1.Sum([]byte("lobby"))
+	require.Equal(t, want[:], pack.Hash)
+
+	_, err = h.URL(online, "notes.txt")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+	get := func(player uuid.UUID, name string) *http.Response {
+		u, err := h.URL(player, name)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(u, "http://example.com:25590/"+player.Undashed()+"/"))
+		res, err := http.Get(srv.URL + strings.TrimPrefix(u, "http://example.com:25590"))
+		require.NoError(t, err)
+		return res
+	}
+
+	res := get(online, "lobby.zip")
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	_ = res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, "lobby", string(body))
+	require.Equal(t, "application/zip", res.Header.Get("Content-Type"))
+
+	res = get(offline, "lobby.zip")
+	_ = res.Body.Close()
+	require.Equal(t, http.StatusForbidden, res.StatusCode)
+
+	// URLs of another player are rejected
+	u, err := h.URL(online, "lobby.zip")
+	require.NoError(t, err)
+	res, err = http.Get(srv.URL + strings.Replace(strings.TrimPrefix(u, "http://example.com:25590"),
+		online.Undashed(), offline.Undashed(), 1))
+	require.NoError(t, err)
+	_ = res.Body.Close()
+	require.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func TestHost_Watch(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "lobby.zip")
+	require.NoError(t, os.WriteFile(path, []byte("v1"), 0o644))
+	h, err := New(dir, "https://example.com", nil)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates := make(chan []Pack, 1)
+	go func() { _ = h.Watch(ctx, func(modified []Pack) { updates <- modified }) }()
+	time.Sleep(100 * time.Millisecond) // let the watcher start
+
+	// Added packs are hashed but not reported
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "new.zip"), []byte("new"), 0o644))
+	require.Eventually(t, func() bool {
+		_, ok := h.Pack("new.zip")
+		return ok
+	}, 5*time.Second, 50*time.Millisecond)
+
+	require.NoError(t, os.WriteFile(path, []byte("version 2"), 0o644))
+	select {
+	case modified := <-updates:
+		require.Len(t, modified, 1)
+		want := sha1.Sum([]byte("version 2"))
+		require.Equal(t, want[:], modified[0].Hash)
+	case <-time.After(5 * time.Second):
+		t.Fatal("pack update not reported")
+	}
+}
@@ -0,0 +1,54 @@
+package packet
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/Tnze/go-mc/nbt"
+	"go.minekube.com/common/minecraft/key"
+
+	"go.minekube.com/gate/pkg/edition/java/proto/util"
+	"go.minekube.com/gate/pkg/gate/proto"
+)
+
+// MaxCustomClickActionPayloadSize is the max size of the payload of a CustomClickAction.
+const MaxCustomClickActionPayloadSize = 65536
+
+// CustomClickAction is sent by 1.21.6+ clients when clicking a custom action,
+// e.g. of a dialog or chat component.
+type CustomClickAction struct {
+	ID      key.Key
+	Payload util.BinaryTag // Type is nbt.TagEnd if absent
+}
+
+var _ proto.Packet = (*CustomClickAction)(nil)
+
+func (c *CustomClickAction) Encode(ctx *proto.PacketContext, wr io.Writer) error {
+	if err := util.WriteKey(wr, c.ID); err != nil {
+		return err
+	}
+	payload := new(bytes.Buffer)
+	if c.Payload.Type == nbt.TagEnd {
+		payload.WriteByte(nbt.TagEnd)
+	} else if err := util.WriteBinaryTag(payload, ctx.Protocol, c.Payload); err != nil {
+		return err
+	}
+	return util.WriteBytes(wr, payload.Bytes())
+}
+
+func (c *CustomClickAction) Decode(ctx *proto.PacketContext, rd io.Reader) (err error) {
+	c.ID, err = util.ReadKey(rd)
+	if err != nil {
+		return err
+	}
+	payload, err := util.ReadBytesLen(rd, MaxCustomClickActionPayloadSize)
+	if err != nil {
+		return err
+	}
+	if len(payload) == 0 || payload[0] == nbt.TagEnd {
+		c.Payload = util.BinaryTag{}
+		return nil
+	}
+	c.Payload, err = util.ReadBinaryTag(bytes.NewReader(payload), ctx.Protocol)
+	return err
+}
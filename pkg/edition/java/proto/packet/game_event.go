@@ -0,0 +1,35 @@
+package packet
+
+import (
+	"io"
+
+	"go.minekube.com/gate/pkg/edition/java/proto/util"
+	"go.minekube.com/gate/pkg/gate/proto"
+)
+
+// GameEventLevelChunksLoadStart tells 1.20.3+ clients to wait for the chunks
+// around the player before leaving the loading terrain screen.
+const GameEventLevelChunksLoadStart uint8 = 13
+
+// GameEvent notifies the client about a change of the game state.
+// Only registered since 1.20.3, where the proxy needs it to spawn players in limbo.
+type GameEvent struct {
+	Event uint8
+	Value float32
+}
+
+func (g *GameEvent) Encode(c *proto.PacketContext, wr io.Writer) error {
+	w := util.PanicWriter(wr)
+	w.Byte(g.Event)
+	w.Float32(g.Value)
+	return nil
+}
+
+func (g *GameEvent) Decode(c *proto.PacketContext, rd io.Reader) error {
+	r := util.PanicReader(rd)
+	r.Uint8(&g.Event)
+	r.Float32(&g.Value)
+	return nil
+}
+
+var _ proto.Packet = (*GameEvent)(nil)
@@ -0,0 +1,188 @@
+package packet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"unicode/utf16"
+
+	"go.minekube.com/gate/pkg/gate/proto"
+)
+
+// Legacy (pre-1.7) packets are not framed like modern packets.
+// They are detected and written by the connection directly.
+const (
+	LegacyHandshakeID  = 0x02
+	LegacyPingID       = 0xFE
+	LegacyDisconnectID = 0xFF
+
+	legacyPingHostChannel = "MC|PingHost"
+)
+
+// LegacyPingVersion is the format of a legacy server list ping.
+type LegacyPingVersion int
+
+const (
+	// LegacyPingVersion13 is sent by Minecraft Beta 1.8 to 1.3 clients (0xFE).
+	LegacyPingVersion13 LegacyPingVersion = iota
+	// LegacyPingVersion14 is sent by Minecraft 1.4 and 1.5 clients (0xFE 0x01).
+	LegacyPingVersion14
+	// LegacyPingVersion16 is sent by Minecraft 1.6 clients (0xFE 0x01 0xFA "MC|PingHost").
+	LegacyPingVersion16
+)
+
+func (v LegacyPingVersion) String() string {
+	switch v {
+	case LegacyPingVersion13:
+		return "1.3"
+	case LegacyPingVersion14:
+		return "1.4"
+	case LegacyPingVersion16:
+		return "1.6"
+	}
+	return fmt.Sprintf("LegacyPingVersion(%d)", int(v))
+}
+
+// LegacyPing is a server list ping sent by a pre-1.7 client.
+type LegacyPing struct {
+	Version LegacyPingVersion
+	// The following fields are only sent by 1.6 clients.
+	Protocol int    // The client's protocol version.
+	Host     string // The hostname the client connected to.
+	Port     int    // The port the client connected to.
+}
+
+// Encode writes the complete legacy ping including the leading packet id.
+func (p *LegacyPing) Encode(_ *proto.PacketContext, wr io.Writer) error {
+	switch p.Version {
+	case LegacyPingVersion13:
+		_, err := wr.Write([]byte{LegacyPingID})
+		return err
+	case LegacyPingVersion14:
+		_, err := wr.Write([]byte{LegacyPingID, 0x01})
+		return err
+	}
+	if _, err := wr.Write([]byte{LegacyPingID, 0x01, 0xFA}); err != nil {
+		return err
+	}
+	if err := writeLegacyString(wr, legacyPingHostChannel); err != nil {
+		return err
+	}
+	// protocol byte + host string + port int
+	dataLen := 1 + 2 + 2*len(utf16.Encode([]rune(p.Host))) + 4
+	if err := binary.Write(wr, binary.BigEndian, uint16(dataLen)); err != nil {
+		return err
+	}
+	if _, err := wr.Write([]byte{byte(p.Protocol)}); err != nil {
+		return err
+	}
+	if err := writeLegacyString(wr, p.Host); err != nil {
+		return err
+	}
+	return binary.Write(wr, binary.BigEndian, int32(p.Port))
+}
+
+// Decode reads the "MC|PingHost" plugin message of a 1.6 ping
+// following the leading 0xFE 0x01 bytes.
+func (p *LegacyPing) Decode(_ *proto.PacketContext, rd io.Reader) error {
+	p.Version = LegacyPingVersion16
+	var id [1]byte
+	if _, err := io.ReadFull(rd, id[:]); err != nil {
+		return err
+	}
+	if id[0] != 0xFA {
+		return fmt.Errorf("expected plugin message 0xFA in legacy ping, got 0x%02X", id[0])
+	}
+	channel, err := readLegacyString(rd)
+	if err != nil {
+		return err
+	}
+	if channel != legacyPingHostChannel {
+		return fmt.Errorf("unexpected legacy ping channel %q", channel)
+	}
+	var dataLen uint16
+	if err = binary.Read(rd, binary.BigEndian, &dataLen); err != nil {
+		return err
+	}
+	if _, err = io.ReadFull(rd, id[:]); err != nil {
+		return err
+	}
+	p.Protocol = int(id[0])
+	if p.Host, err = readLegacyString(rd); err != nil {
+		return err
+	}
+	var port int32
+	if err = binary.Read(rd, binary.BigEndian, &port); err != nil {
+		return err
+	}
+	p.Port = int(port)
+	return nil
+}
+
+// LegacyHandshake is the login handshake of a pre-1.7 client.
+// The proxy can only respond to it with a LegacyDisconnect.
+type LegacyHandshake struct{}
+
+func (LegacyHandshake) Encode(_ *proto.PacketContext, _ io.Writer) error {
+	return errors.New("legacy handshake encoding is not supported")
+}
+func (LegacyHandshake) Decode(_ *proto.PacketContext, _ io.Reader) error {
+	return nil // the remaining data is discarded by the reader
+}
+
+// LegacyDisconnect is the response to a legacy ping or handshake.
+type LegacyDisconnect struct {
+	Reason string
+}
+
+// Encode writes the complete legacy disconnect including the leading packet id.
+func (d *LegacyDisconnect) Encode(_ *proto.PacketContext, wr io.Writer) error {
+	if _, err := wr.Write([]byte{LegacyDisconnectID}); err != nil {
+		return err
+	}
+	return writeLegacyString(wr, d.Reason)
+}
+
+// Decode reads the legacy disconnect reason following the leading packet id.
+func (d *LegacyDisconnect) Decode(_ *proto.PacketContext, rd io.Reader) (err error) {
+	d.Reason, err = readLegacyString(rd)
+	return
+}
+
+// legacyStringMaxLength is the maximum number of UTF-16 code units we accept.
+const legacyStringMaxLength = 32767
+
+// writeLegacyString writes a string prefixed with its length as UTF-16BE.
+func writeLegacyString(wr io.Writer, s string) error {
+	units := utf16.Encode([]rune(s))
+	if len(units) > legacyStringMaxLength {
+		return fmt.Errorf("legacy string too long (%d > %d)", len(units), legacyStringMaxLength)
+	}
+	if err := binary.Write(wr, binary.BigEndian, uint16(len(units))); err != nil {
+		return err
+	}
+	return binary.Write(wr, binary.BigEndian, units)
+}
+
+// readLegacyString reads a string prefixed with its length as UTF-16BE.
+func readLegacyString(rd io.Reader) (string, error) {
+	var length uint16
+	if err := binary.Read(rd, binary.BigEndian, &length); err != nil {
+		return "", err
+	}
+	if length > legacyStringMaxLength {
+		return "", fmt.Errorf("legacy string too long (%d > %d)", length, legacyStringMaxLength)
+	}
+	units := make([]uint16, length)
+	if err := binary.Read(rd, binary.BigEndian, units); err != nil {
+		return "", err
+	}
+	return string(utf16.Decode(units)), nil
+}
+
+var (
+	_ proto.Packet = (*LegacyPing)(nil)
+	_ proto.Packet = (*LegacyHandshake)(nil)
+	_ proto.Packet = (*LegacyDisconnect)(nil)
+)
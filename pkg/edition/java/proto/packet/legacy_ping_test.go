@@ -0,0 +1,49 @@
+package packet
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLegacyPing16(t *testing.T) {
+	// Ping of a 1.6.4 client (protocol 78) connecting to localhost:25565.
+	raw := []byte{
+		0xFE, 0x01, 0xFA,
+		0x00, 0x0B, 0x00, 'M', 0x00, 'C', 0x00, '|', 0x00, 'P', 0x00, 'i', 0x00, 'n', 0x00, 'g',
+		0x00, 'H', 0x00, 'o', 0x00, 's', 0x00, 't',
+		0x00, 0x19, 0x4E,
+		0x00, 0x09, 0x00, 'l', 0x00, 'o', 0x00, 'c', 0x00, 'a', 0x00, 'l', 0x00, 'h', 0x00, 'o', 0x00, 's', 0x00, 't',
+		0x00, 0x00, 0x63, 0xDD,
+	}
+
+	p := new(LegacyPing)
+	rd := bytes.NewReader(raw[2:])
+	require.NoError(t, p.Decode(nil, rd))
+	require.Zero(t, rd.Len())
+	require.Equal(t, LegacyPing{
+		Version:  LegacyPingVersion16,
+		Protocol: 78,
+		Host:     "localhost",
+		Port:     25565,
+	}, *p)
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, p.Encode(nil, buf))
+	require.Equal(t, raw, buf.Bytes())
+}
+
+func TestLegacyDisconnect(t *testing.T) {
+	d := &LegacyDisconnect{Reason: "§1\x0078\x001.6.4\x00A Gate Proxy\x000\x0020"}
+	buf := new(bytes.Buffer)
+	require.NoError(t, d.Encode(nil, buf))
+	require.Equal(t, byte(LegacyDisconnectID), buf.Bytes()[0])
+	require.Equal(t, []byte{0x00, 0x1D, 0x00, 0xA7}, buf.Bytes()[1:5])
+
+	buf.Next(1)
+	decoded := new(LegacyDisconnect)
+	require.NoError(t, decoded.Decode(nil, buf))
+	require.Equal(t, d, decoded)
+	require.Zero(t, buf.Len())
+}
@@ -15,6 +15,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Tnze/go-mc/nbt"
 	"go.minekube.com/common/minecraft/key"
 	"go.minekube.com/gate/pkg/edition/java/proto/nbtconv"
 	"go.minekube.com/gate/pkg/edition/java/proto/packet/config"
@@ -31,6 +32,7 @@ import (
 	"go.minekube.com/gate/pkg/edition/java/proto/packet/bossbar"
 	"go.minekube.com/gate/pkg/edition/java/proto/packet/chat"
 	"go.minekube.com/gate/pkg/edition/java/proto/packet/plugin"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet/scoreboard"
 	"go.minekube.com/gate/pkg/edition/java/proto/packet/tablist/legacytablist"
 	"go.minekube.com/gate/pkg/edition/java/proto/packet/tablist/playerinfo"
 	"go.minekube.com/gate/pkg/edition/java/proto/packet/title"
@@ -99,6 +101,8 @@ var packets = []proto.Packet{
 	},
 	&Handshake{},
 	&KeepAlive{},
+	&PlayerPosition{},
+	&GameEvent{},
 	&ServerLogin{
 		Username:  "Foo",
 		PlayerKey: generatePlayerKey(),
@@ -252,6 +256,37 @@ var packets = []proto.Packet{
 		Overlay: bossbar.Notched10Overlay,
 		Flags:   bossbar.ConvertFlags(bossbar.DarkenScreenFlag, bossbar.PlayBossMusicFlag),
 	},
+	&scoreboard.DisplayObjective{Slot: scoreboard.SidebarDisplaySlot, Objective: "sidebar"},
+	&scoreboard.Objective{
+		Name:         "sidebar",
+		Mode:         scoreboard.CreateObjectiveMode,
+		DisplayName:  chat.FromComponent(&component.Text{Content: "Sidebar"}),
+		RenderType:   scoreboard.HeartsRenderType,
+		NumberFormat: &scoreboard.NumberFormat{Type: scoreboard.BlankNumberFormat},
+	},
+	&scoreboard.Score{
+		Entity:    "Steve",
+		Objective: "sidebar",
+		Value:     42,
+		NumberFormat: &scoreboard.NumberFormat{
+			Type:  scoreboard.FixedNumberFormat,
+			Fixed: chat.FromComponent(&component.Text{Content: "fixed"}),
+		},
+	},
+	&scoreboard.ResetScore{Entity: "Steve", Objective: "sidebar"},
+	&scoreboard.Team{
+		Name:              "red",
+		Mode:              scoreboard.CreateTeamMode,
+		DisplayName:       chat.FromComponent(&component.Text{Content: "Red"}),
+		Prefix:            chat.FromComponent(&component.Text{Content: "[Red] "}),
+		Suffix:            chat.FromComponent(&component.Text{Content: "!"}),
+		FriendlyFlags:     scoreboard.AllowFriendlyFireFlag,
+		NameTagVisibility: scoreboard.HideForOtherTeamsNameTagVisibility,
+		CollisionRule:     scoreboard.PushOwnTeamCollisionRule,
+		Color:             scoreboard.ResetTeamColor,
+		Entities:          []string{"Steve", "Alex"},
+	},
+	&scoreboard.Team{Name: "red", Mode: scoreboard.RemoveEntitiesTeamMode, Entities: []string{"Alex"}},
 	&playerinfo.Upsert{
 		ActionSet: []playerinfo.UpsertAction{
 			playerinfo.AddPlayerAction,
@@ -277,6 +312,11 @@ var packets = []proto.Packet{
 	&cookie.CookieStore{Key: key.New("minecraft", "test"), Payload: []byte("payload")},
 	&DialogClear{},
 	&DialogShow{},
+	&CustomClickAction{ID: key.New("gate", "test")},
+	&CustomClickAction{
+		ID:      key.New("gate", "test"),
+		Payload: util.BinaryTag{Type: nbt.TagString, Data: []byte{0, 2, 'h', 'i'}},
+	},
 }
 
 func generatePlayerKey() crypto.IdentifiedKey {
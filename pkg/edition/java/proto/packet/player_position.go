@@ -0,0 +1,94 @@
+package packet
+
+import (
+	"io"
+
+	"go.minekube.com/gate/pkg/edition/java/proto/util"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+	"go.minekube.com/gate/pkg/gate/proto"
+)
+
+// PlayerPosition sets the position and rotation of the player on the client.
+// The client leaves the loading terrain screen when receiving it after a JoinGame.
+type PlayerPosition struct {
+	TeleportID int // 1.9+
+	X, Y, Z    float64
+	// Velocity of the player, 1.21.2+
+	VelocityX, VelocityY, VelocityZ float64
+	Yaw, Pitch                      float32
+	// Flags marks fields as relative to the current position or rotation, 1.8+
+	Flags           int32
+	OnGround        bool // removed in 1.8
+	DismountVehicle bool // 1.17-1.19.3
+}
+
+func (p *PlayerPosition) Encode(c *proto.PacketContext, wr io.Writer) error {
+	w := util.PanicWriter(wr)
+	if c.Protocol.GreaterEqual(version.Minecraft_1_21_2) {
+		w.VarInt(p.TeleportID)
+		w.Float64(p.X)
+		w.Float64(p.Y)
+		w.Float64(p.Z)
+		w.Float64(p.VelocityX)
+		w.Float64(p.VelocityY)
+		w.Float64(p.VelocityZ)
+		w.Float32(p.Yaw)
+		w.Float32(p.Pitch)
+		return util.WriteInt32(wr, p.Flags)
+	}
+	w.Float64(p.X)
+	w.Float64(p.Y)
+	w.Float64(p.Z)
+	w.Float32(p.Yaw)
+	w.Float32(p.Pitch)
+	if c.Protocol.Lower(version.Minecraft_1_8) {
+		w.Bool(p.OnGround)
+		return nil
+	}
+	w.Byte(byte(p.Flags))
+	if c.Protocol.GreaterEqual(version.Minecraft_1_9) {
+		w.VarInt(p.TeleportID)
+	}
+	if c.Protocol.GreaterEqual(version.Minecraft_1_17) && c.Protocol.Lower(version.Minecraft_1_19_4) {
+		w.Bool(p.DismountVehicle)
+	}
+	return nil
+}
+
+func (p *PlayerPosition) Decode(c *proto.PacketContext, rd io.Reader) (err error) {
+	r := util.PanicReader(rd)
+	if c.Protocol.GreaterEqual(version.Minecraft_1_21_2) {
+		r.VarInt(&p.TeleportID)
+		r.Float64(&p.X)
+		r.Float64(&p.Y)
+		r.Float64(&p.Z)
+		r.Float64(&p.VelocityX)
+		r.Float64(&p.VelocityY)
+		r.Float64(&p.VelocityZ)
+		r.Float32(&p.Yaw)
+		r.Float32(&p.Pitch)
+		p.Flags, err = util.ReadInt32(rd)
+		return err
+	}
+	r.Float64(&p.X)
+	r.Float64(&p.Y)
+	r.Float64(&p.Z)
+	r.Float32(&p.Yaw)
+	r.Float32(&p.Pitch)
+	if c.Protocol.Lower(version.Minecraft_1_8) {
+		r.Bool(&p.OnGround)
+		return nil
+	}
+	var flags byte
+	r.Byte(&flags)
+	p.Flags = int32(flags)
+	if c.Protocol.GreaterEqual(version.Minecraft_1_9) {
+		r.VarInt(&p.TeleportID)
+	}
+	if c.Protocol.GreaterEqual(version.Minecraft_1_17) && c.Protocol.Lower(version.Minecraft_1_19_4) {
+		r.Bool(&p.DismountVehicle)
+	}
+	return nil
+}
+
+var _ proto.Packet = (*PlayerPosition)(nil)
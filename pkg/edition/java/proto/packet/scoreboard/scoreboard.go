@@ -0,0 +1,373 @@
+// Package scoreboard contains the scoreboard packets for objectives, scores and teams.
+package scoreboard
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/component/codec/legacy"
+
+	"go.minekube.com/gate/pkg/edition/java/proto/packet/chat"
+	"go.minekube.com/gate/pkg/edition/java/proto/util"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+	"go.minekube.com/gate/pkg/gate/proto"
+)
+
+// DisplaySlot is a slot an objective can be displayed in.
+type DisplaySlot int
+
+// Available display slots.
+// Since 1.8 the team sidebar slots follow with 3 + TeamColor.
+const (
+	ListDisplaySlot DisplaySlot = iota
+	SidebarDisplaySlot
+	BelowNameDisplaySlot
+)
+
+// DisplayObjective shows the objective in a display slot.
+// An empty objective name clears the slot.
+type DisplayObjective struct {
+	Slot      DisplaySlot
+	Objective string
+}
+
+func (d *DisplayObjective) Encode(c *proto.PacketContext, wr io.Writer) error {
+	w := util.PanicWriter(wr)
+	if c.Protocol.GreaterEqual(version.Minecraft_1_20_2) {
+		w.VarInt(int(d.Slot))
+	} else {
+		w.Byte(byte(d.Slot))
+	}
+	w.String(d.Objective)
+	return nil
+}
+
+func (d *DisplayObjective) Decode(c *proto.PacketContext, rd io.Reader) error {
+	r := util.PanicReader(rd)
+	if c.Protocol.GreaterEqual(version.Minecraft_1_20_2) {
+		var slot int
+		r.VarInt(&slot)
+		d.Slot = DisplaySlot(slot)
+	} else {
+		d.Slot = DisplaySlot(util.PReadByteVal(rd))
+	}
+	r.String(&d.Objective)
+	return nil
+}
+
+// ObjectiveMode is the action of an Objective packet.
+type ObjectiveMode byte
+
+// Available objective modes.
+const (
+	CreateObjectiveMode ObjectiveMode = iota
+	RemoveObjectiveMode
+	UpdateObjectiveMode
+)
+
+// RenderType is how the scores of an objective are rendered.
+type RenderType int
+
+// Available render types.
+const (
+	IntegerRenderType RenderType = iota
+	HeartsRenderType
+)
+
+func (t RenderType) String() string {
+	if t == HeartsRenderType {
+		return "hearts"
+	}
+	return "integer"
+}
+
+// Objective creates, removes or updates a scoreboard objective.
+type Objective struct {
+	Name         string
+	Mode         ObjectiveMode
+	DisplayName  *chat.ComponentHolder
+	RenderType   RenderType
+	NumberFormat *NumberFormat // Since 1.20.3, nil uses the default format
+}
+
+func (o *Objective) Encode(c *proto.PacketContext, wr io.Writer) error {
+	w := util.PanicWriter(wr)
+	w.String(o.Name)
+	if c.Protocol.Lower(version.Minecraft_1_8) {
+		w.String(legacyText(o.DisplayName, maxLegacyDisplayName))
+		w.Byte(byte(o.Mode))
+		return nil
+	}
+	w.Byte(byte(o.Mode))
+	if o.Mode != CreateObjectiveMode && o.Mode != UpdateObjectiveMode {
+		return nil
+	}
+	if c.Protocol.Lower(version.Minecraft_1_13) {
+		w.String(legacyText(o.DisplayName, maxLegacyDisplayName))
+		w.String(o.RenderType.String())
+		return nil
+	}
+	if err := writeComponent(wr, c.Protocol, o.DisplayName); err != nil {
+		return err
+	}
+	w.VarInt(int(o.RenderType))
+	if c.Protocol.GreaterEqual(version.Minecraft_1_20_3) {
+		return writeOptionalNumberFormat(wr, c.Protocol, o.NumberFormat)
+	}
+	return nil
+}
+
+func (o *Objective) Decode(c *proto.PacketContext, rd io.Reader) (err error) {
+	r := util.PanicReader(rd)
+	r.String(&o.Name)
+	if c.Protocol.Lower(version.Minecraft_1_8) {
+		o.DisplayName = fromLegacyText(util.PReadStringVal(rd))
+		o.Mode = ObjectiveMode(util.PReadByteVal(rd))
+		return nil
+	}
+	o.Mode = ObjectiveMode(util.PReadByteVal(rd))
+	if o.Mode != CreateObjectiveMode && o.Mode != UpdateObjectiveMode {
+		return nil
+	}
+	if c.Protocol.Lower(version.Minecraft_1_13) {
+		o.DisplayName = fromLegacyText(util.PReadStringVal(rd))
+		if util.PReadStringVal(rd) == HeartsRenderType.String() {
+			o.RenderType = HeartsRenderType
+		} else {
+			o.RenderType = IntegerRenderType
+		}
+		return nil
+	}
+	o.DisplayName, err = chat.ReadComponentHolder(rd, c.Protocol)
+	if err != nil {
+		return err
+	}
+	var renderType int
+	r.VarInt(&renderType)
+	o.RenderType = RenderType(renderType)
+	if c.Protocol.GreaterEqual(version.Minecraft_1_20_3) {
+		o.NumberFormat, err = readOptionalNumberFormat(rd, c.Protocol)
+	}
+	return err
+}
+
+// ScoreAction is the action of a Score packet.
+type ScoreAction byte
+
+// Available score actions.
+// Since 1.20.3 scores are removed with the ResetScore packet.
+const (
+	UpdateScoreAction ScoreAction = iota
+	RemoveScoreAction
+)
+
+// Score sets or removes the score of an entity (e.g. a player name) in an objective.
+type Score struct {
+	Entity       string
+	Action       ScoreAction // Before 1.20.3
+	Objective    string
+	Value        int
+	DisplayName  *chat.ComponentHolder // Since 1.20.3, nil uses the entity name
+	NumberFormat *NumberFormat         // Since 1.20.3, nil uses the objective's format
+}
+
+var errRemoveScore = errors.New("scores are removed with the reset score packet since 1.20.3")
+
+func (s *Score) Encode(c *proto.PacketContext, wr io.Writer) error {
+	w := util.PanicWriter(wr)
+	w.String(s.Entity)
+	if c.Protocol.GreaterEqual(version.Minecraft_1_20_3) {
+		if s.Action == RemoveScoreAction {
+			return errRemoveScore
+		}
+		w.String(s.Objective)
+		w.VarInt(s.Value)
+		w.Bool(s.DisplayName != nil)
+		if s.DisplayName != nil {
+			if err := s.DisplayName.Write(wr, c.Protocol); err != nil {
+				return err
+			}
+		}
+		return writeOptionalNumberFormat(wr, c.Protocol, s.NumberFormat)
+	}
+	w.Byte(byte(s.Action))
+	if c.Protocol.Lower(version.Minecraft_1_8) {
+		if s.Action != RemoveScoreAction {
+			w.String(s.Objective)
+			return util.WriteInt32(wr, int32(s.Value))
+		}
+		return nil
+	}
+	w.String(s.Objective)
+	if s.Action != RemoveScoreAction {
+		w.VarInt(s.Value)
+	}
+	return nil
+}
+
+func (s *Score) Decode(c *proto.PacketContext, rd io.Reader) (err error) {
+	r := util.PanicReader(rd)
+	r.String(&s.Entity)
+	if c.Protocol.GreaterEqual(version.Minecraft_1_20_3) {
+		r.String(&s.Objective)
+		r.VarInt(&s.Value)
+		if util.PReadBoolVal(rd) {
+			s.DisplayName, err = chat.ReadComponentHolder(rd, c.Protocol)
+			if err != nil {
+				return err
+			}
+		}
+		s.NumberFormat, err = readOptionalNumberFormat(rd, c.Protocol)
+		return err
+	}
+	s.Action = ScoreAction(util.PReadByteVal(rd))
+	if c.Protocol.Lower(version.Minecraft_1_8) {
+		if s.Action != RemoveScoreAction {
+			r.String(&s.Objective)
+			value, err := util.ReadInt32(rd)
+			if err != nil {
+				return err
+			}
+			s.Value = int(value)
+		}
+		return nil
+	}
+	r.String(&s.Objective)
+	if s.Action != RemoveScoreAction {
+		r.VarInt(&s.Value)
+	}
+	return nil
+}
+
+// ResetScore removes the score of an entity since 1.20.3.
+// An empty objective removes the entity's scores of all objectives.
+type ResetScore struct {
+	Entity    string
+	Objective string
+}
+
+func (s *ResetScore) Encode(_ *proto.PacketContext, wr io.Writer) error {
+	w := util.PanicWriter(wr)
+	w.String(s.Entity)
+	w.Bool(s.Objective != "")
+	if s.Objective != "" {
+		w.String(s.Objective)
+	}
+	return nil
+}
+
+func (s *ResetScore) Decode(_ *proto.PacketContext, rd io.Reader) error {
+	r := util.PanicReader(rd)
+	r.String(&s.Entity)
+	s.Objective = ""
+	if r.Ok() {
+		r.String(&s.Objective)
+	}
+	return nil
+}
+
+// NumberFormatType is the type of a NumberFormat.
+type NumberFormatType int
+
+// Available number format types.
+const (
+	BlankNumberFormat  NumberFormatType = iota // Hides the score
+	StyledNumberFormat                         // Shows the score with a style
+	FixedNumberFormat                          // Shows a fixed text instead of the score
+)
+
+// NumberFormat is how scores are displayed since 1.20.3.
+type NumberFormat struct {
+	Type  NumberFormatType
+	Style util.CompoundBinaryTag // The style for StyledNumberFormat
+	Fixed *chat.ComponentHolder  // The text for FixedNumberFormat
+}
+
+func writeOptionalNumberFormat(wr io.Writer, protocol proto.Protocol, f *NumberFormat) error {
+	util.PWriteBool(wr, f != nil)
+	if f == nil {
+		return nil
+	}
+	util.PWriteVarInt(wr, int(f.Type))
+	switch f.Type {
+	case BlankNumberFormat:
+		return nil
+	case StyledNumberFormat:
+		style := f.Style
+		if style.Type == 0 {
+			style = emptyCompound
+		}
+		return util.WriteBinaryTag(wr, protocol, style)
+	case FixedNumberFormat:
+		return writeComponent(wr, protocol, f.Fixed)
+	default:
+		return fmt.Errorf("unknown number format type %d", f.Type)
+	}
+}
+
+func readOptionalNumberFormat(rd io.Reader, protocol proto.Protocol) (f *NumberFormat, err error) {
+	if !util.PReadBoolVal(rd) {
+		return nil, nil
+	}
+	f = new(NumberFormat)
+	var typ int
+	util.PVarInt(rd, &typ)
+	f.Type = NumberFormatType(typ)
+	switch f.Type {
+	case BlankNumberFormat:
+	case StyledNumberFormat:
+		f.Style, err = util.ReadCompoundTag(rd, protocol)
+	case FixedNumberFormat:
+		f.Fixed, err = chat.ReadComponentHolder(rd, protocol)
+	default:
+		err = fmt.Errorf("unknown number format type %d", f.Type)
+	}
+	return f, err
+}
+
+// Max lengths of legacy text before 1.13.
+const (
+	maxLegacyDisplayName = 32
+	maxLegacyAffix       = 16
+)
+
+// emptyCompound is an empty NBT compound tag.
+var emptyCompound = util.CompoundBinaryTag{Type: 10, Data: []byte{0}}
+
+// writeComponent writes the component or an empty text if nil.
+func writeComponent(wr io.Writer, protocol proto.Protocol, c *chat.ComponentHolder) error {
+	if c == nil {
+		c = chat.FromComponent(&component.Text{})
+	}
+	return c.Write(wr, protocol)
+}
+
+// legacyText returns the component as legacy text for clients older than 1.13
+// cut to the max length the client accepts.
+func legacyText(c *chat.ComponentHolder, maxLen int) string {
+	comp := c.AsComponentOrNil()
+	if comp == nil {
+		return ""
+	}
+	b := new(strings.Builder)
+	if err := (&legacy.Legacy{}).Marshal(b, comp); err != nil {
+		return ""
+	}
+	s := []rune(b.String())
+	if len(s) <= maxLen {
+		return string(s)
+	}
+	s = s[:maxLen]
+	// Don't end with a dangling color code
+	if s[maxLen-1] == legacy.DefaultChar {
+		s = s[:maxLen-1]
+	}
+	return string(s)
+}
+
+func fromLegacyText(s string) *chat.ComponentHolder {
+	return chat.FromComponent(&component.Text{Content: s})
+}
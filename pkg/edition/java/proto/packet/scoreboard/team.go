@@ -0,0 +1,301 @@
+package scoreboard
+
+import (
+	"fmt"
+	"io"
+
+	"go.minekube.com/gate/pkg/edition/java/proto/packet/chat"
+	"go.minekube.com/gate/pkg/edition/java/proto/util"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+	"go.minekube.com/gate/pkg/gate/proto"
+)
+
+// TeamMode is the action of a Team packet.
+type TeamMode byte
+
+// Available team modes.
+const (
+	CreateTeamMode TeamMode = iota
+	RemoveTeamMode
+	UpdateTeamMode
+	AddEntitiesTeamMode
+	RemoveEntitiesTeamMode
+)
+
+// Team friendly flags.
+const (
+	AllowFriendlyFireFlag     byte = 0x01
+	SeeFriendlyInvisiblesFlag byte = 0x02
+)
+
+const (
+	maxTeamEntities           = 1 << 16
+	legacyResetTeamColor byte = 0xFF // -1 before 1.13
+)
+
+// NameTagVisibility is for whom the name tags of team members are visible.
+type NameTagVisibility string
+
+// Available name tag visibilities.
+const (
+	AlwaysNameTagVisibility            NameTagVisibility = "always"
+	NeverNameTagVisibility             NameTagVisibility = "never"
+	HideForOtherTeamsNameTagVisibility NameTagVisibility = "hideForOtherTeams"
+	HideForOwnTeamNameTagVisibility    NameTagVisibility = "hideForOwnTeam"
+)
+
+var nameTagVisibilities = []NameTagVisibility{
+	AlwaysNameTagVisibility,
+	NeverNameTagVisibility,
+	HideForOtherTeamsNameTagVisibility,
+	HideForOwnTeamNameTagVisibility,
+}
+
+// CollisionRule is with whom team members collide since 1.9.
+type CollisionRule string
+
+// Available collision rules.
+const (
+	AlwaysCollisionRule         CollisionRule = "always"
+	NeverCollisionRule          CollisionRule = "never"
+	PushOtherTeamsCollisionRule CollisionRule = "pushOtherTeams"
+	PushOwnTeamCollisionRule    CollisionRule = "pushOwnTeam"
+)
+
+var collisionRules = []CollisionRule{
+	AlwaysCollisionRule,
+	NeverCollisionRule,
+	PushOtherTeamsCollisionRule,
+	PushOwnTeamCollisionRule,
+}
+
+// TeamColor is the color of a team's member names, one of the 16 chat colors.
+type TeamColor int
+
+// Available team colors.
+const (
+	BlackTeamColor TeamColor = iota
+	DarkBlueTeamColor
+	DarkGreenTeamColor
+	DarkAquaTeamColor
+	DarkRedTeamColor
+	DarkPurpleTeamColor
+	GoldTeamColor
+	GrayTeamColor
+	DarkGrayTeamColor
+	BlueTeamColor
+	GreenTeamColor
+	AquaTeamColor
+	RedTeamColor
+	LightPurpleTeamColor
+	YellowTeamColor
+	WhiteTeamColor
+	// ResetTeamColor is no team color.
+	ResetTeamColor TeamColor = 21
+)
+
+// Team creates, removes or updates a team or adds and removes its entities (e.g. player names).
+type Team struct {
+	Name string
+	Mode TeamMode
+
+	// Team info for CreateTeamMode and UpdateTeamMode.
+	DisplayName       *chat.ComponentHolder
+	Prefix            *chat.ComponentHolder
+	Suffix            *chat.ComponentHolder
+	FriendlyFlags     byte
+	NameTagVisibility NameTagVisibility // Since 1.8, empty is AlwaysNameTagVisibility
+	CollisionRule     CollisionRule     // Since 1.9, empty is AlwaysCollisionRule
+	Color             TeamColor         // Since 1.8
+
+	// Entities for CreateTeamMode, AddEntitiesTeamMode and RemoveEntitiesTeamMode.
+	Entities []string
+}
+
+func (t *Team) hasInfo() bool {
+	return t.Mode == CreateTeamMode || t.Mode == UpdateTeamMode
+}
+
+func (t *Team) hasEntities() bool {
+	return t.Mode == CreateTeamMode || t.Mode == AddEntitiesTeamMode || t.Mode == RemoveEntitiesTeamMode
+}
+
+func (t *Team) Encode(c *proto.PacketContext, wr io.Writer) error {
+	w := util.PanicWriter(wr)
+	w.String(t.Name)
+	w.Byte(byte(t.Mode))
+	if t.hasInfo() {
+		if err := t.encodeInfo(c, wr); err != nil {
+			return err
+		}
+	}
+	if !t.hasEntities() {
+		return nil
+	}
+	if c.Protocol.Lower(version.Minecraft_1_8) {
+		if err := util.WriteInt16(wr, int16(len(t.Entities))); err != nil {
+			return err
+		}
+	} else {
+		w.VarInt(len(t.Entities))
+	}
+	for _, entity := range t.Entities {
+		w.String(entity)
+	}
+	return nil
+}
+
+func (t *Team) encodeInfo(c *proto.PacketContext, wr io.Writer) error {
+	w := util.PanicWriter(wr)
+	if c.Protocol.Lower(version.Minecraft_1_13) {
+		w.String(legacyText(t.DisplayName, maxLegacyDisplayName))
+		w.String(legacyText(t.Prefix, maxLegacyAffix))
+		w.String(legacyText(t.Suffix, maxLegacyAffix))
+		w.Byte(t.FriendlyFlags)
+		if c.Protocol.Lower(version.Minecraft_1_8) {
+			return nil
+		}
+		w.String(string(orDefault(t.NameTagVisibility, AlwaysNameTagVisibility)))
+		if c.Protocol.GreaterEqual(version.Minecraft_1_9) {
+			w.String(string(orDefault(t.CollisionRule, AlwaysCollisionRule)))
+		}
+		if t.Color == ResetTeamColor {
+			w.Byte(legacyResetTeamColor)
+		} else {
+			w.Byte(byte(t.Color))
+		}
+		return nil
+	}
+
+	if err := writeComponent(wr, c.Protocol, t.DisplayName); err != nil {
+		return err
+	}
+	w.Byte(t.FriendlyFlags)
+	if c.Protocol.GreaterEqual(version.Minecraft_1_21_5) {
+		nameTag, err := enumIndex(nameTagVisibilities, orDefault(t.NameTagVisibility, AlwaysNameTagVisibility))
+		if err != nil {
+			return err
+		}
+		collision, err := enumIndex(collisionRules, orDefault(t.CollisionRule, AlwaysCollisionRule))
+		if err != nil {
+			return err
+		}
+		w.VarInt(nameTag)
+		w.VarInt(collision)
+	} else {
+		w.String(string(orDefault(t.NameTagVisibility, AlwaysNameTagVisibility)))
+		w.String(string(orDefault(t.CollisionRule, AlwaysCollisionRule)))
+	}
+	w.VarInt(int(t.Color))
+	if err := writeComponent(wr, c.Protocol, t.Prefix); err != nil {
+		return err
+	}
+	return writeComponent(wr, c.Protocol, t.Suffix)
+}
+
+func (t *Team) Decode(c *proto.PacketContext, rd io.Reader) (err error) {
+	r := util.PanicReader(rd)
+	r.String(&t.Name)
+	t.Mode = TeamMode(util.PReadByteVal(rd))
+	if t.hasInfo() {
+		if err = t.decodeInfo(c, rd); err != nil {
+			return err
+		}
+	}
+	if !t.hasEntities() {
+		return nil
+	}
+	var count int
+	if c.Protocol.Lower(version.Minecraft_1_8) {
+		n, err := util.ReadInt16(rd)
+		if err != nil {
+			return err
+		}
+		count = int(n)
+	} else {
+		r.VarInt(&count)
+	}
+	if count < 0 || count > maxTeamEntities {
+		return fmt.Errorf("invalid team entity count %d", count)
+	}
+	t.Entities = make([]string, count)
+	for i := range t.Entities {
+		r.String(&t.Entities[i])
+	}
+	return nil
+}
+
+func (t *Team) decodeInfo(c *proto.PacketContext, rd io.Reader) (err error) {
+	r := util.PanicReader(rd)
+	if c.Protocol.Lower(version.Minecraft_1_13) {
+		t.DisplayName = fromLegacyText(util.PReadStringVal(rd))
+		t.Prefix = fromLegacyText(util.PReadStringVal(rd))
+		t.Suffix = fromLegacyText(util.PReadStringVal(rd))
+		r.Byte(&t.FriendlyFlags)
+		if c.Protocol.Lower(version.Minecraft_1_8) {
+			return nil
+		}
+		t.NameTagVisibility = NameTagVisibility(util.PReadStringVal(rd))
+		if c.Protocol.GreaterEqual(version.Minecraft_1_9) {
+			t.CollisionRule = CollisionRule(util.PReadStringVal(rd))
+		}
+		if color := util.PReadByteVal(rd); color == legacyResetTeamColor {
+			t.Color = ResetTeamColor
+		} else {
+			t.Color = TeamColor(color)
+		}
+		return nil
+	}
+
+	t.DisplayName, err = chat.ReadComponentHolder(rd, c.Protocol)
+	if err != nil {
+		return err
+	}
+	r.Byte(&t.FriendlyFlags)
+	if c.Protocol.GreaterEqual(version.Minecraft_1_21_5) {
+		var nameTag, collision int
+		r.VarInt(&nameTag)
+		r.VarInt(&collision)
+		if t.NameTagVisibility, err = enumValue(nameTagVisibilities, nameTag); err != nil {
+			return err
+		}
+		if t.CollisionRule, err = enumValue(collisionRules, collision); err != nil {
+			return err
+		}
+	} else {
+		t.NameTagVisibility = NameTagVisibility(util.PReadStringVal(rd))
+		t.CollisionRule = CollisionRule(util.PReadStringVal(rd))
+	}
+	var color int
+	r.VarInt(&color)
+	t.Color = TeamColor(color)
+	if t.Prefix, err = chat.ReadComponentHolder(rd, c.Protocol); err != nil {
+		return err
+	}
+	t.Suffix, err = chat.ReadComponentHolder(rd, c.Protocol)
+	return err
+}
+
+func orDefault[T ~string](v, def T) T {
+	if v == "" {
+		return def
+	}
+	return v
+}
+
+func enumIndex[T ~string](values []T, v T) (int, error) {
+	for i, value := range values {
+		if value == v {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown value %q", v)
+}
+
+func enumValue[T ~string](values []T, i int) (T, error) {
+	if i < 0 || i >= len(values) {
+		var zero T
+		return zero, fmt.Errorf("unknown value %d", i)
+	}
+	return values[i], nil
+}
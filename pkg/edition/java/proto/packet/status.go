@@ -14,7 +14,6 @@ type (
 	StatusPing struct {
 		RandomID int64
 	}
-	// TODO LegacyPing
 )
 
 func (s *StatusPing) Encode(_ *proto.PacketContext, wr io.Writer) error {
@@ -7,6 +7,7 @@ import (
 	"go.minekube.com/gate/pkg/edition/java/proto/packet/config"
 	"go.minekube.com/gate/pkg/edition/java/proto/packet/cookie"
 	"go.minekube.com/gate/pkg/edition/java/proto/packet/plugin"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet/scoreboard"
 	"go.minekube.com/gate/pkg/edition/java/proto/packet/tablist/legacytablist"
 	"go.minekube.com/gate/pkg/edition/java/proto/packet/tablist/playerinfo"
 	"go.minekube.com/gate/pkg/edition/java/proto/packet/title"
@@ -65,6 +66,9 @@ func init() {
 	Config.ServerBound.Register(&cookie.CookieResponse{},
 		m(0x01, version.Minecraft_1_20_5),
 	)
+	Config.ServerBound.Register(&p.CustomClickAction{},
+		m(0x08, version.Minecraft_1_21_6),
+	)
 
 	Config.ClientBound.Register(&plugin.Message{},
 		m(0x00, version.Minecraft_1_20_2),
@@ -295,6 +299,9 @@ func init() {
 		m(0x13, version.Minecraft_1_21_2),
 		m(0x14, version.Minecraft_1_21_6),
 	)
+	Play.ServerBound.Register(&p.CustomClickAction{},
+		m(0x41, version.Minecraft_1_21_6),
+	)
 
 	Play.ClientBound.Register(&p.KeepAlive{},
 		m(0x00, version.Minecraft_1_7_2),
@@ -353,6 +360,31 @@ func init() {
 		m(0x4C, version.Minecraft_1_21_2),
 		m(0x4B, version.Minecraft_1_21_5),
 	)
+	Play.ClientBound.Register(&p.PlayerPosition{},
+		m(0x08, version.Minecraft_1_7_2),
+		m(0x2E, version.Minecraft_1_9),
+		m(0x2F, version.Minecraft_1_12_1),
+		m(0x32, version.Minecraft_1_13),
+		m(0x35, version.Minecraft_1_14),
+		m(0x36, version.Minecraft_1_15),
+		m(0x35, version.Minecraft_1_16),
+		m(0x34, version.Minecraft_1_16_2),
+		m(0x38, version.Minecraft_1_17),
+		m(0x36, version.Minecraft_1_19),
+		m(0x39, version.Minecraft_1_19_1),
+		m(0x38, version.Minecraft_1_19_3),
+		m(0x3C, version.Minecraft_1_19_4),
+		m(0x3E, version.Minecraft_1_20_2),
+		m(0x40, version.Minecraft_1_20_5),
+		m(0x42, version.Minecraft_1_21_2),
+		m(0x41, version.Minecraft_1_21_5),
+	)
+	Play.ClientBound.Register(&p.GameEvent{},
+		m(0x20, version.Minecraft_1_20_3),
+		m(0x22, version.Minecraft_1_20_5),
+		m(0x23, version.Minecraft_1_21_2),
+		m(0x22, version.Minecraft_1_21_5),
+	)
 	Play.ClientBound.Register(&p.Disconnect{},
 		m(0x40, version.Minecraft_1_7_2),
 		m(0x1A, version.Minecraft_1_9),
@@ -380,6 +412,84 @@ func init() {
 		m(0x0A, version.Minecraft_1_20_2),
 		m(0x09, version.Minecraft_1_21_5),
 	)
+	Play.ClientBound.Register(&scoreboard.DisplayObjective{},
+		m(0x3D, version.Minecraft_1_7_2),
+		m(0x38, version.Minecraft_1_9),
+		m(0x3A, version.Minecraft_1_12),
+		m(0x3B, version.Minecraft_1_12_1),
+		m(0x3E, version.Minecraft_1_13),
+		m(0x42, version.Minecraft_1_14),
+		m(0x43, version.Minecraft_1_15),
+		m(0x4C, version.Minecraft_1_17),
+		m(0x4F, version.Minecraft_1_19_1),
+		m(0x4D, version.Minecraft_1_19_3),
+		m(0x51, version.Minecraft_1_19_4),
+		m(0x53, version.Minecraft_1_20_2),
+		m(0x55, version.Minecraft_1_20_3),
+		m(0x57, version.Minecraft_1_20_5),
+		m(0x5C, version.Minecraft_1_21_2),
+		m(0x5B, version.Minecraft_1_21_5),
+	)
+	Play.ClientBound.Register(&scoreboard.Objective{},
+		m(0x3B, version.Minecraft_1_7_2),
+		m(0x3F, version.Minecraft_1_9),
+		m(0x41, version.Minecraft_1_12),
+		m(0x42, version.Minecraft_1_12_1),
+		m(0x45, version.Minecraft_1_13),
+		m(0x49, version.Minecraft_1_14),
+		m(0x4A, version.Minecraft_1_15),
+		m(0x53, version.Minecraft_1_17),
+		m(0x56, version.Minecraft_1_19_1),
+		m(0x54, version.Minecraft_1_19_3),
+		m(0x58, version.Minecraft_1_19_4),
+		m(0x5A, version.Minecraft_1_20_2),
+		m(0x5C, version.Minecraft_1_20_3),
+		m(0x5E, version.Minecraft_1_20_5),
+		m(0x64, version.Minecraft_1_21_2),
+		m(0x63, version.Minecraft_1_21_5),
+	)
+	Play.ClientBound.Register(&scoreboard.Team{},
+		m(0x3E, version.Minecraft_1_7_2),
+		m(0x41, version.Minecraft_1_9),
+		m(0x43, version.Minecraft_1_12),
+		m(0x44, version.Minecraft_1_12_1),
+		m(0x47, version.Minecraft_1_13),
+		m(0x4B, version.Minecraft_1_14),
+		m(0x4C, version.Minecraft_1_15),
+		m(0x55, version.Minecraft_1_17),
+		m(0x58, version.Minecraft_1_19_1),
+		m(0x56, version.Minecraft_1_19_3),
+		m(0x5A, version.Minecraft_1_19_4),
+		m(0x5C, version.Minecraft_1_20_2),
+		m(0x5E, version.Minecraft_1_20_3),
+		m(0x60, version.Minecraft_1_20_5),
+		m(0x67, version.Minecraft_1_21_2),
+		m(0x66, version.Minecraft_1_21_5),
+	)
+	Play.ClientBound.Register(&scoreboard.Score{},
+		m(0x3C, version.Minecraft_1_7_2),
+		m(0x42, version.Minecraft_1_9),
+		m(0x44, version.Minecraft_1_12),
+		m(0x45, version.Minecraft_1_12_1),
+		m(0x48, version.Minecraft_1_13),
+		m(0x4C, version.Minecraft_1_14),
+		m(0x4D, version.Minecraft_1_15),
+		m(0x56, version.Minecraft_1_17),
+		m(0x59, version.Minecraft_1_19_1),
+		m(0x57, version.Minecraft_1_19_3),
+		m(0x5B, version.Minecraft_1_19_4),
+		m(0x5D, version.Minecraft_1_20_2),
+		m(0x5F, version.Minecraft_1_20_3),
+		m(0x61, version.Minecraft_1_20_5),
+		m(0x68, version.Minecraft_1_21_2),
+		m(0x67, version.Minecraft_1_21_5),
+	)
+	Play.ClientBound.Register(&scoreboard.ResetScore{},
+		m(0x42, version.Minecraft_1_20_3),
+		m(0x44, version.Minecraft_1_20_5),
+		m(0x49, version.Minecraft_1_21_2),
+		m(0x48, version.Minecraft_1_21_5),
+	)
 	Play.ClientBound.Register(&chat.LegacyChat{},
 		m(0x02, version.Minecraft_1_7_2),
 		m(0x0F, version.Minecraft_1_9),
@@ -638,4 +748,10 @@ func init() {
 		m(0x72, version.Minecraft_1_21_2),
 		m(0x71, version.Minecraft_1_21_5),
 	)
+	Play.ClientBound.Register(&p.DialogClear{},
+		m(0x84, version.Minecraft_1_21_6),
+	)
+	Play.ClientBound.Register(&p.DialogShow{},
+		m(0x85, version.Minecraft_1_21_6),
+	)
 }
@@ -55,6 +55,30 @@ func (r *PReader) Byte(b *byte) {
 	PReadByte(r.r, b)
 }
 
+func (r *PReader) Float32(f *float32) {
+	PReadFloat32(r.r, f)
+}
+
+func (r *PReader) Float64(f *float64) {
+	PReadFloat64(r.r, f)
+}
+
+func PReadFloat32(rd io.Reader, f *float32) {
+	v, err := ReadFloat32(rd)
+	if err != nil {
+		panic(err)
+	}
+	*f = v
+}
+
+func PReadFloat64(rd io.Reader, f *float64) {
+	v, err := ReadFloat64(rd)
+	if err != nil {
+		panic(err)
+	}
+	*f = v
+}
+
 func PReadStrings(r io.Reader, i *[]string) {
 	v, err := ReadStringArray(r)
 	if err != nil {
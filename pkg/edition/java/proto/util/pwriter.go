@@ -76,6 +76,26 @@ func (w *PWriter) Byte(b byte) {
 func (w *PWriter) Strings(s []string) {
 	PWriteStrings(w.w, s)
 }
+
+func (w *PWriter) Float32(f float32) {
+	PWriteFloat32(w.w, f)
+}
+
+func (w *PWriter) Float64(f float64) {
+	PWriteFloat64(w.w, f)
+}
+
+func PWriteFloat32(w io.Writer, f float32) {
+	if err := WriteFloat32(w, f); err != nil {
+		panic(err)
+	}
+}
+
+func PWriteFloat64(w io.Writer, f float64) {
+	if err := WriteFloat64(w, f); err != nil {
+		panic(err)
+	}
+}
 func (w *PWriter) CompoundBinaryTag(cbt CompoundBinaryTag, protocol proto.Protocol) {
 	PWriteCompoundBinaryTag(w.w, protocol, cbt)
 }
@@ -0,0 +1,109 @@
+package proxy
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"time"
+
+	. "go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/gate/proto"
+	"go.minekube.com/gate/pkg/util/access"
+	"go.minekube.com/gate/pkg/util/componentutil"
+	"go.minekube.com/gate/pkg/util/netutil"
+)
+
+// ErrAccessDisabled is returned when managing bans or
+// the allowlist while they are disabled in the config.
+var ErrAccessDisabled = errors.New("bans and allowlist are disabled")
+
+// Access returns the bans and the allowlist.
+// Returns nil if they are disabled in the config.
+func (p *Proxy) Access() *access.Store {
+	return p.access.Load()
+}
+
+// Ban adds the ban and disconnects online players it matches.
+func (p *Proxy) Ban(b access.Ban) (access.Ban, error) {
+	s := p.Access()
+	if s == nil {
+		return access.Ban{}, ErrAccessDisabled
+	}
+	b, err := s.Ban(b)
+	if err != nil {
+		return access.Ban{}, err
+	}
+	for _, player := range p.Players() {
+		if ban, ok := s.Banned(player.ID(), player.Username(), remoteIP(player)); ok {
+			player.Disconnect(banReason(player.Protocol(), &ban))
+		}
+	}
+	return b, nil
+}
+
+// setupAccess loads the bans and the allowlist, removing them if disabled.
+// If loading fails the previously loaded store is kept.
+func (p *Proxy) setupAccess(cfg *config.Access) {
+	if !cfg.Enabled {
+		p.access.Store(nil)
+		return
+	}
+	s, err := access.NewStore(cfg.File)
+	if err != nil {
+		p.log.Error(err, "error loading access file", "path", cfg.File)
+		return
+	}
+	p.access.Store(s)
+	p.log.Info("loaded bans and allowlist", "path", cfg.File,
+		"bans", len(s.Bans()), "allowlist", s.AllowlistEnabled())
+}
+
+// checkAccess denies the login of banned players and
+// players not on the allowlist if it is enabled.
+func (p *Proxy) checkAccess(e *LoginEvent) {
+	s := p.Access()
+	if s == nil || !e.Allowed() {
+		return
+	}
+	player := e.Player()
+	if b, ok := s.Banned(player.ID(), player.Username(), remoteIP(player)); ok {
+		e.Deny(banReason(player.Protocol(), &b))
+		return
+	}
+	if !s.Allowed(player.ID(), player.Username()) {
+		var reason Component = notAllowlisted
+		if msg := p.config().Access.AllowlistMessage; msg != nil {
+			reason = msg.T()
+		}
+		e.Deny(reason)
+	}
+}
+
+var notAllowlisted = &Text{Content: "You are not allowlisted on this server!", S: Style{Color: Red}}
+
+func remoteIP(player Player) netip.Addr {
+	addr, _ := netip.ParseAddr(netutil.Host(player.RemoteAddr()))
+	return addr
+}
+
+// banReason renders the disconnect reason of a banned player.
+func banReason(protocol proto.Protocol, b *access.Ban) Component {
+	msg := &Text{Content: "You are banned from this server!", S: Style{Color: Red}}
+	if b.Reason != "" {
+		var reason Component = &Text{Content: b.Reason}
+		if t, err := componentutil.ParseTextComponent(protocol, b.Reason); err == nil {
+			reason = t
+		}
+		msg.Extra = append(msg.Extra, &Text{Content: "\n\nReason: ", S: Style{Color: Gray}}, reason)
+	}
+	if !b.Permanent() {
+		msg.Extra = append(msg.Extra, &Text{
+			Content: fmt.Sprintf("\n\nExpires in %s", time.Until(b.Expires).Round(time.Second)),
+			S:       Style{Color: Gray},
+		})
+	}
+	return msg
+}
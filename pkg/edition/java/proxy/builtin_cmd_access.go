@@ -0,0 +1,240 @@
+package proxy
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.minekube.com/brigodier"
+	. "go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+	"go.minekube.com/gate/pkg/command"
+	"go.minekube.com/gate/pkg/command/suggest"
+	"go.minekube.com/gate/pkg/util/access"
+)
+
+const (
+	banCmdPermission       = "gate.command.ban"
+	unbanCmdPermission     = "gate.command.unban"
+	allowlistCmdPermission = "gate.command.allowlist"
+)
+
+// command to ban players by UUID, username, IP address or CIDR range
+func newBanCmd(proxy *Proxy) brigodier.LiteralNodeBuilder {
+	const banTargetArg = "target"
+	const banReasonArg = "reason"
+	return brigodier.Literal("ban").
+		Requires(requireCmdPerm(banCmdPermission)).
+		Then(brigodier.Argument(banTargetArg, brigodier.String).
+			Suggests(playerSuggestionProvider(proxy)).
+			Executes(command.Command(func(c *command.Context) error {
+				return ban(proxy, c, c.String(banTargetArg), "")
+			})).
+			// Optional duration followed by the reason, e.g. "7d Griefing"
+			Then(brigodier.Argument(banReasonArg, brigodier.StringPhrase).
+				Executes(command.Command(func(c *command.Context) error {
+					return ban(proxy, c, c.String(banTargetArg), c.String(banReasonArg))
+				})),
+			),
+		)
+}
+
+func ban(proxy *Proxy, c *command.Context, target, args string) error {
+	b := access.Ban{Target: target, Source: sourceName(c.Source)}
+	first, rest, _ := strings.Cut(strings.TrimSpace(args), " ")
+	d, ok := parseBanDuration(first)
+	if ok {
+		b.Expires = time.Now().Add(d)
+		b.Reason = strings.TrimSpace(rest)
+	} else {
+		b.Reason = strings.TrimSpace(args)
+	}
+
+	b, err := proxy.Ban(b)
+	if err != nil {
+		return c.SendMessage(accessErrMsg(err))
+	}
+	msg := fmt.Sprintf("Banned %s %q.", b.Kind, b.Target)
+	if ok {
+		msg = fmt.Sprintf("Banned %s %q for %s.", b.Kind, b.Target, d)
+	}
+	return c.SendMessage(&Text{S: Style{Color: Green}, Content: msg})
+}
+
+// command to remove bans
+func newUnbanCmd(proxy *Proxy) brigodier.LiteralNodeBuilder {
+	const unbanTargetArg = "target"
+	return brigodier.Literal("unban").
+		Requires(requireCmdPerm(unbanCmdPermission)).
+		Then(brigodier.Argument(unbanTargetArg, brigodier.String).
+			Suggests(command.SuggestFunc(func(
+				_ *command.Context,
+				b *brigodier.SuggestionsBuilder,
+			) *brigodier.Suggestions {
+				var candidates []string
+				if s := proxy.Access(); s != nil {
+					for _, banned := range s.Bans() {
+						candidates = append(candidates, banned.Target)
+					}
+				}
+				return suggest.Similar(b, candidates).Build()
+			})).
+			Executes(command.Command(func(c *command.Context) error {
+				s := proxy.Access()
+				if s == nil {
+					return c.SendMessage(accessErrMsg(ErrAccessDisabled))
+				}
+				target := c.String(unbanTargetArg)
+				removed, err := s.Unban(target)
+				if err != nil {
+					return c.SendMessage(accessErrMsg(err))
+				}
+				if !removed {
+					return c.SendMessage(&Text{S: Style{Color: Yellow},
+						Content: fmt.Sprintf("%q is not banned.", target)})
+				}
+				return c.SendMessage(&Text{S: Style{Color: Green},
+					Content: fmt.Sprintf("Unbanned %q.", target)})
+			})),
+		)
+}
+
+// command to view and change the allowlist
+func newAllowlistCmd(proxy *Proxy) brigodier.LiteralNodeBuilder {
+	const allowlistEntryArg = "entry"
+	withStore := func(fn func(c *command.Context, s *access.Store) error) brigodier.Command {
+		return command.Command(func(c *command.Context) error {
+			s := proxy.Access()
+			if s == nil {
+				return c.SendMessage(accessErrMsg(ErrAccessDisabled))
+			}
+			return fn(c, s)
+		})
+	}
+	setEnabled := func(enabled bool) brigodier.Command {
+		return withStore(func(c *command.Context, s *access.Store) error {
+			if err := s.SetAllowlistEnabled(enabled); err != nil {
+				return c.SendMessage(accessErrMsg(err))
+			}
+			return c.SendMessage(allowlistStatusMsg(s))
+		})
+	}
+	return brigodier.Literal("allowlist").
+		Requires(requireCmdPerm(allowlistCmdPermission)).
+		Executes(withStore(func(c *command.Context, s *access.Store) error {
+			return c.SendMessage(allowlistStatusMsg(s))
+		})).
+		Then(brigodier.Literal("on").Executes(setEnabled(true))).
+		Then(brigodier.Literal("off").Executes(setEnabled(false))).
+		Then(brigodier.Literal("list").
+			Executes(withStore(func(c *command.Context, s *access.Store) error {
+				entries := s.Allowlist()
+				return c.SendMessage(&Text{Extra: []Component{
+					&Text{Content: fmt.Sprintf("Allowlist (%d): ", len(entries)), S: Style{Color: Aqua}},
+					&Text{Content: strings.Join(entries, ", ")},
+				}})
+			})),
+		).
+		Then(brigodier.Literal("add").
+			Then(brigodier.Argument(allowlistEntryArg, brigodier.String).
+				Suggests(playerSuggestionProvider(proxy)).
+				Executes(withStore(func(c *command.Context, s *access.Store) error {
+					entry := c.String(allowlistEntryArg)
+					added, err := s.Allow(entry)
+					if err != nil {
+						return c.SendMessage(accessErrMsg(err))
+					}
+					if !added {
+						return c.SendMessage(&Text{S: Style{Color: Yellow},
+							Content: fmt.Sprintf("%q is already allowlisted.", entry)})
+					}
+					return c.SendMessage(&Text{S: Style{Color: Green},
+						Content: fmt.Sprintf("Added %q to the allowlist.", entry)})
+				})),
+			),
+		).
+		Then(brigodier.Literal("remove").
+			Then(brigodier.Argument(allowlistEntryArg, brigodier.String).
+				Suggests(command.SuggestFunc(func(
+					_ *command.Context,
+					b *brigodier.SuggestionsBuilder,
+				) *brigodier.Suggestions {
+					var candidates []string
+					if s := proxy.Access(); s != nil {
+						candidates = s.Allowlist()
+					}
+					return suggest.Similar(b, candidates).Build()
+				})).
+				Executes(withStore(func(c *command.Context, s *access.Store) error {
+					entry := c.String(allowlistEntryArg)
+					removed, err := s.Disallow(entry)
+					if err != nil {
+						return c.SendMessage(accessErrMsg(err))
+					}
+					if !removed {
+						return c.SendMessage(&Text{S: Style{Color: Yellow},
+							Content: fmt.Sprintf("%q is not allowlisted.", entry)})
+					}
+					return c.SendMessage(&Text{S: Style{Color: Green},
+						Content: fmt.Sprintf("Removed %q from the allowlist.", entry)})
+				})),
+			),
+		)
+}
+
+func allowlistStatusMsg(s *access.Store) Component {
+	status := &Text{Content: "off", S: Style{Color: Red}}
+	if s.AllowlistEnabled() {
+		status = &Text{Content: "on", S: Style{Color: Green}}
+	}
+	return &Text{S: Style{Color: Yellow}, Content: "The allowlist is ", Extra: []Component{
+		status,
+		&Text{Content: fmt.Sprintf(" (%d allowlisted).", len(s.Allowlist()))},
+	}}
+}
+
+func accessErrMsg(err error) Component {
+	if errors.Is(err, ErrAccessDisabled) {
+		return &Text{S: Style{Color: Red}, Content: "Bans and allowlist are disabled in the config."}
+	}
+	return &Text{S: Style{Color: Red}, Content: err.Error()}
+}
+
+// sourceName returns the username of player sources and "Console" otherwise.
+func sourceName(s command.Source) string {
+	if player, ok := s.(Player); ok {
+		return player.Username()
+	}
+	return "Console"
+}
+
+var banDurationRegex = regexp.MustCompile(`^(?:(\d+)w)?(?:(\d+)d)?((?:\d+[hms])*)$`)
+
+// parseBanDuration parses a positive duration of weeks (w), days (d), hours (h),
+// minutes (m) and seconds (s) in this order, e.g. "7d" or "1w2d12h".
+func parseBanDuration(s string) (time.Duration, bool) {
+	m := banDurationRegex.FindStringSubmatch(s)
+	if m == nil || s == "" {
+		return 0, false
+	}
+	var d time.Duration
+	if m[1] != "" {
+		w, _ := strconv.Atoi(m[1])
+		d += time.Duration(w) * 7 * 24 * time.Hour
+	}
+	if m[2] != "" {
+		days, _ := strconv.Atoi(m[2])
+		d += time.Duration(days) * 24 * time.Hour
+	}
+	if m[3] != "" {
+		rest, err := time.ParseDuration(m[3])
+		if err != nil {
+			return 0, false
+		}
+		d += rest
+	}
+	return d, d > 0
+}
@@ -0,0 +1,26 @@
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseBanDuration(t *testing.T) {
+	const day = 24 * time.Hour
+	for s, want := range map[string]time.Duration{
+		"7d":      7 * day,
+		"1w2d12h": 9*day + 12*time.Hour,
+		"30m":     30 * time.Minute,
+		"1h30m":   90 * time.Minute,
+	} {
+		d, ok := parseBanDuration(s)
+		require.True(t, ok, s)
+		require.Equal(t, want, d, s)
+	}
+	for _, s := range []string{"", "0d", "Griefing", "7", "2d1w"} {
+		_, ok := parseBanDuration(s)
+		require.False(t, ok, s)
+	}
+}
@@ -0,0 +1,83 @@
+package proxy
+
+import (
+	"fmt"
+	"strings"
+
+	"go.minekube.com/brigodier"
+	. "go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+	"go.minekube.com/gate/pkg/command"
+)
+
+const queueCmdPermission = "gate.command.queue"
+
+// command to view and leave the server queue
+func newQueueCmd(proxy *Proxy) brigodier.LiteralNodeBuilder {
+	const queueServerArg = "server"
+	// The command is always registered so that disabling the queue on reload takes effect.
+	// While disabled, players' commands are forwarded to their server that may have its own queue.
+	enabled := func(fn func(c *command.Context) error) brigodier.Command {
+		return command.Command(func(c *command.Context) error {
+			if !proxy.Queue().Enabled() {
+				if _, ok := c.Source.(Player); ok {
+					return command.ErrForward
+				}
+				return c.SendMessage(&Text{S: Style{Color: Red}, Content: "The queue is disabled in the config."})
+			}
+			return fn(c)
+		})
+	}
+	return brigodier.Literal("queue").
+		Requires(hasCmdPerm(proxy, queueCmdPermission)).
+		// Show own position
+		Executes(enabled(func(c *command.Context) error {
+			player, ok := c.Source.(Player)
+			if !ok {
+				return c.Source.SendMessage(&Text{S: Style{Color: Red},
+					Content: "Only players can be queued!"})
+			}
+			server, position := proxy.Queue().Position(player)
+			if server == nil {
+				return c.SendMessage(&Text{S: Style{Color: Yellow}, Content: "You are not in a queue."})
+			}
+			return c.SendMessage(queuePositionMsg(server, position, len(proxy.Queue().Players(server))))
+		})).
+		Then(brigodier.Literal("leave").
+			Executes(enabled(func(c *command.Context) error {
+				player, ok := c.Source.(Player)
+				if !ok || !proxy.Queue().Remove(player) {
+					return c.SendMessage(&Text{S: Style{Color: Yellow}, Content: "You are not in a queue."})
+				}
+				return c.SendMessage(&Text{S: Style{Color: Green}, Content: "You left the queue."})
+			})),
+		).
+		// List queued players of a server
+		Then(brigodier.Literal("list").
+			Then(brigodier.Argument(queueServerArg, brigodier.String).
+				Suggests(serverSuggestionProvider(proxy)).
+				Executes(enabled(func(c *command.Context) error {
+					serverName := c.String(queueServerArg)
+					server := proxy.Server(serverName)
+					if server == nil {
+						return c.SendMessage(&Text{S: Style{Color: Red},
+							Content: fmt.Sprintf("Server %q doesn't exist.", serverName)})
+					}
+					return c.SendMessage(queueListMsg(server, proxy.Queue().Players(server)))
+				})),
+			),
+		)
+}
+
+func queueListMsg(server RegisteredServer, players []Player) Component {
+	names := make([]string, len(players))
+	for i, p := range players {
+		names[i] = p.Username()
+	}
+	return &Text{Extra: []Component{
+		&Text{Content: fmt.Sprintf("[%s] ", server.ServerInfo().Name()), S: Style{Color: Aqua}},
+		&Text{Content: fmt.Sprintf("(%d queued)", len(players)), S: Style{Color: Gray}},
+		&Text{Content: ": "},
+		&Text{Content: strings.Join(names, ", ")},
+	}}
+}
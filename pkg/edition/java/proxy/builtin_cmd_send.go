@@ -20,7 +20,7 @@ func newSendCmd(proxy *Proxy) brigodier.LiteralNodeBuilder {
 		Then(brigodier.Argument(sendPlayerArg, brigodier.String).
 			Suggests(playerSuggestionProvider(proxy, "all", "current")).
 			Then(brigodier.Argument(sendServerArg, brigodier.String).
-				Suggests(serverSuggestionProvider(proxy)).
+				Suggests(serverOrGroupSuggestionProvider(proxy)).
 				Executes(command.Command(func(c *command.Context) error {
 					return sendToServer(proxy, c, c.String(sendPlayerArg), c.String(sendServerArg))
 				})),
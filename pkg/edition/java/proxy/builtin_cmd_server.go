@@ -27,7 +27,7 @@ func newServerCmd(proxy *Proxy) brigodier.LiteralNodeBuilder {
 		})).
 		// Switch server
 		Then(brigodier.Argument(serverNameArg, brigodier.String).
-			Suggests(serverSuggestionProvider(proxy)).
+			Suggests(serverOrGroupSuggestionProvider(proxy)).
 			Executes(command.Command(func(c *command.Context) error {
 				player, ok := c.Source.(Player)
 				if !ok {
@@ -42,24 +42,30 @@ func newServerCmd(proxy *Proxy) brigodier.LiteralNodeBuilder {
 }
 
 func connectPlayersToServer(c *command.Context, proxy *Proxy, serverName string, players ...Player) error {
-	server := proxy.Server(serverName)
-	if server == nil {
+	if proxy.Server(serverName) == nil && proxy.ServerGroup(serverName) == nil {
 		return c.Source.SendMessage(&Text{S: Style{Color: Red},
 			Content: fmt.Sprintf("Server %q doesn't exist.", serverName)})
 	}
+	// Pick a server for each player in case the name is a server group
+	servers, release := proxy.pickServers(serverName, len(players))
+	if servers == nil {
+		return c.Source.SendMessage(&Text{S: Style{Color: Red},
+			Content: fmt.Sprintf("No server of group %q is available.", serverName)})
+	}
 
 	go func() {
+		defer release()
 		ctx, cancel := context.WithTimeout(context.Background(),
 			time.Millisecond*time.Duration(proxy.cfg.ConnectionTimeout))
 		defer cancel()
 
 		wg := new(sync.WaitGroup)
 		wg.Add(len(players))
-		for _, player := range players {
-			go func(player Player) {
+		for i, player := range players {
+			go func(player Player, server RegisteredServer) {
 				defer wg.Done()
 				player.CreateConnectionRequest(server).ConnectWithIndication(ctx)
-			}(player)
+			}(player, servers[i])
 		}
 		wg.Wait()
 	}()
@@ -160,6 +166,20 @@ func serverSuggestionProvider(p *Proxy, additionalServers ...string) brigodier.S
 	})
 }
 
+// serverOrGroupSuggestionProvider also suggests the server group names.
+func serverOrGroupSuggestionProvider(p *Proxy, additionalServers ...string) brigodier.SuggestionProvider {
+	return command.SuggestFunc(func(
+		_ *command.Context,
+		b *brigodier.SuggestionsBuilder,
+	) *brigodier.Suggestions {
+		candidates := append(serverNames(p), additionalServers...)
+		for name := range p.config().ServerGroups {
+			candidates = append(candidates, name)
+		}
+		return suggest.Similar(b, candidates).Build()
+	})
+}
+
 func serverNames(p *Proxy) []string {
 	servers := p.Servers()
 	n := make([]string, len(servers))
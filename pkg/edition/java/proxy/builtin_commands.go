@@ -6,11 +6,16 @@ import (
 )
 
 func (p *Proxy) registerBuiltinCommands() []string {
-	return []string{
+	names := []string{
 		p.command.Register(newServerCmd(p)).Name(),
 		p.command.Register(newGlistCmd(p)).Name(),
 		p.command.Register(newSendCmd(p)).Name(),
+		p.command.Register(newQueueCmd(p)).Name(),
+		p.command.Register(newBanCmd(p)).Name(),
+		p.command.Register(newUnbanCmd(p)).Name(),
+		p.command.Register(newAllowlistCmd(p)).Name(),
 	}
+	return names
 }
 
 func hasCmdPerm(proxy *Proxy, perm string) brigodier.RequireFn {
@@ -18,3 +23,11 @@ func hasCmdPerm(proxy *Proxy, perm string) brigodier.RequireFn {
 		return !proxy.cfg.RequireBuiltinCommandPermissions || c.Source.HasPermission(perm)
 	})
 }
+
+// requireCmdPerm requires the permission even if RequireBuiltinCommandPermissions
+// is disabled, for commands that must not be available to all players.
+func requireCmdPerm(perm string) brigodier.RequireFn {
+	return command.Requires(func(c *command.RequiresContext) bool {
+		return c.Source.HasPermission(perm)
+	})
+}
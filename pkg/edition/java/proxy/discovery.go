@@ -0,0 +1,177 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"go.minekube.com/common/minecraft/color"
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/proxy/discovery"
+	"go.minekube.com/gate/pkg/util/netutil"
+)
+
+// Names of the built-in discovery providers.
+const (
+	directoryDiscoveryProvider = "directory"
+	dnsDiscoveryProvider       = "dns"
+)
+
+// discoveryProviders returns the enabled discovery providers by name.
+func discoveryProviders(cfg *config.Discovery) map[string]discovery.Provider {
+	providers := map[string]discovery.Provider{}
+	if cfg.Directory != "" {
+		providers[directoryDiscoveryProvider] = discovery.Directory(cfg.Directory)
+	}
+	if len(cfg.DNS.Records) != 0 {
+		records := make([]discovery.DNSRecord, len(cfg.DNS.Records))
+		for i, r := range cfg.DNS.Records {
+			records[i] = discovery.DNSRecord{Name: r.Name, SRV: r.SRV, Host: r.Host, Port: r.Port}
+		}
+		providers[dnsDiscoveryProvider] = discovery.DNS(nil, time.Duration(cfg.DNS.Interval), records)
+	}
+	return providers
+}
+
+// runDiscovery runs the enabled discovery providers until the context is canceled
+// and keeps the registered servers in sync with the discovered servers.
+func (p *Proxy) runDiscovery(ctx context.Context, cfg config.Discovery) {
+	var wg sync.WaitGroup
+	for name, provider := range discoveryProviders(&cfg) {
+		log := p.log.WithName("discovery").WithValues("provider", name)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := provider.Run(logr.NewContext(ctx, log), func(servers discovery.Servers) {
+				p.syncDiscoveredServers(ctx, log, name, servers)
+			})
+			if err != nil {
+				log.Error(err, "server discovery failed")
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// syncDiscoveredServers registers the servers discovered by the provider
+// and unregisters the servers it no longer discovers.
+// Servers registered by the config, the API or another provider are left untouched.
+func (p *Proxy) syncDiscoveredServers(ctx context.Context, log logr.Logger, provider string, servers discovery.Servers) {
+	p.discoveryMu.Lock()
+	defer p.discoveryMu.Unlock()
+	if ctx.Err() != nil {
+		return // provider was stopped
+	}
+
+	expected := make(map[string]bool, len(servers))
+	for name, addr := range servers {
+		pAddr, err := netutil.Parse(addr, "tcp")
+		if err != nil {
+			log.Info("ignoring discovered server with invalid address", "name", name, "addr", addr, "error", err)
+			continue
+		}
+		info := NewServerInfo(name, pAddr)
+		key := strings.ToLower(name)
+		expected[key] = true
+
+		if rs := p.server(key); rs != nil {
+			p.muS.RLock()
+			owner, ok := p.discovered[key]
+			p.muS.RUnlock()
+			if !ok || owner != provider {
+				continue // not ours
+			}
+			if ServerInfoEqual(rs.ServerInfo(), info) {
+				continue
+			}
+			// Address changed
+			p.unregisterDiscovered(rs)
+		}
+
+		if _, err = p.Register(info); err != nil {
+			if !errors.Is(err, ErrServerAlreadyExists) {
+				log.Info("could not register discovered server", "name", name, "addr", addr, "error", err)
+			}
+			continue
+		}
+		p.muS.Lock()
+		p.discovered[key] = provider
+		p.muS.Unlock()
+	}
+
+	p.removeDiscoveredServers(provider, func(name string) bool { return !expected[name] })
+}
+
+// forgetDiscoveryProviders unregisters the servers of providers that are disabled in the config.
+func (p *Proxy) forgetDiscoveryProviders(cfg *config.Discovery) {
+	p.discoveryMu.Lock()
+	defer p.discoveryMu.Unlock()
+	enabled := discoveryProviders(cfg)
+	p.muS.RLock()
+	providers := map[string]bool{}
+	for _, provider := range p.discovered {
+		providers[provider] = true
+	}
+	p.muS.RUnlock()
+	for provider := range providers {
+		if _, ok := enabled[provider]; !ok {
+			p.removeDiscoveredServers(provider, func(string) bool { return true })
+		}
+	}
+}
+
+// removeDiscoveredServers unregisters the servers of the provider that remove returns true for.
+func (p *Proxy) removeDiscoveredServers(provider string, remove func(name string) bool) {
+	var removed []*registeredServer
+	p.muS.RLock()
+	for name, owner := range p.discovered {
+		if owner == provider && remove(name) {
+			if rs := p.servers[name]; rs != nil {
+				removed = append(removed, rs)
+			}
+		}
+	}
+	p.muS.RUnlock()
+	for _, rs := range removed {
+		p.unregisterDiscovered(rs)
+	}
+}
+
+// unregisterDiscovered unregisters the server and moves its players to another server.
+func (p *Proxy) unregisterDiscovered(rs *registeredServer) {
+	if !p.Unregister(rs.ServerInfo()) {
+		return
+	}
+	p.log.Info("unregistered server no longer discovered", "name", rs.ServerInfo().Name())
+	rs.players.Range(func(player Player) bool {
+		go p.moveFromRemovedServer(player.(*connectedPlayer), rs)
+		return true
+	})
+}
+
+// serverRemoved is the disconnect reason of players on a removed server without another server to connect to.
+var serverRemoved = &component.Text{
+	Content: "The server you were on was removed and no other server is available.",
+	S:       component.Style{Color: color.Red},
+}
+
+// moveFromRemovedServer connects the player to the next try server.
+// If there is none, the player is disconnected instead of staying
+// connected to a server that is not registered anymore.
+func (p *Proxy) moveFromRemovedServer(player *connectedPlayer, removed RegisteredServer) {
+	next := player.nextServerToTry(removed)
+	if next == nil {
+		p.log.Info("disconnecting player from removed server, no other server to connect to",
+			"player", player.Username(), "server", removed.ServerInfo().Name())
+		player.Disconnect(serverRemoved)
+		return
+	}
+	ctx, cancel := withConnectionTimeout(context.Background(), p.config())
+	defer cancel()
+	player.CreateConnectionRequest(next).ConnectWithIndication(ctx)
+}
@@ -0,0 +1,131 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/go-logr/logr"
+	"gopkg.in/yaml.v3"
+)
+
+// directoryDebounce is how long to wait for more changes in the directory before reading it again.
+const directoryDebounce = 100 * time.Millisecond
+
+// Directory returns a Provider that discovers servers from the server definition files
+// in the directory and reads them again when files change.
+//
+// Each YAML (.yml, .yaml) or JSON (.json) file maps server names to addresses, e.g.
+//
+//	lobby-1: localhost:25566
+//	lobby-2: localhost:25567
+//
+// Files are read in alphabetical order, later files override servers with the same name.
+func Directory(dir string) Provider {
+	return &directory{dir: dir}
+}
+
+type directory struct {
+	dir string
+}
+
+func (d *directory) Run(ctx context.Context, update func(Servers)) error {
+	log := logr.FromContextOrDiscard(ctx).WithValues("directory", d.dir)
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("error creating directory watcher: %w", err)
+	}
+	defer func() { _ = watcher.Close() }()
+	if err = watcher.Add(d.dir); err != nil {
+		return fmt.Errorf("error watching directory %q: %w", d.dir, err)
+	}
+
+	var last Servers
+	read := func() {
+		servers, err := ReadDirectory(d.dir)
+		if err != nil {
+			log.Info("failed to read server definitions", "error", err)
+			return
+		}
+		if last != nil && servers.Equal(last) {
+			return
+		}
+		last = servers
+		update(servers)
+	}
+	read()
+
+	debounce := time.NewTimer(directoryDebounce)
+	debounce.Stop()
+	defer debounce.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			log.Info("error watching server definitions", "error", err)
+		case e, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if serverFile(e.Name) {
+				debounce.Reset(directoryDebounce)
+			}
+		case <-debounce.C:
+			read()
+		}
+	}
+}
+
+// ReadDirectory reads the servers from all server definition files in the directory.
+// See Directory for the file format.
+func ReadDirectory(dir string) (Servers, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(entries, func(a, b os.DirEntry) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+
+	servers := Servers{}
+	for _, entry := range entries {
+		if entry.IsDir() || !serverFile(entry.Name()) {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var file Servers
+		// JSON is valid YAML
+		if err = yaml.Unmarshal(b, &file); err != nil {
+			return nil, fmt.Errorf("error parsing %q: %w", path, err)
+		}
+		for name, addr := range file {
+			servers[name] = addr
+		}
+	}
+	return servers, nil
+}
+
+func serverFile(name string) bool {
+	if strings.HasPrefix(filepath.Base(name), ".") {
+		return false // hidden and temporary files
+	}
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yml", ".yaml", ".json":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,23 @@
+// Package discovery provides dynamic discovery of backend servers
+// to keep the server registry of the proxy in sync.
+package discovery
+
+import (
+	"context"
+	"maps"
+)
+
+// Servers are discovered servers, server name to address.
+type Servers map[string]string
+
+// Equal returns true if both contain the same servers.
+func (s Servers) Equal(other Servers) bool {
+	return maps.Equal(s, other)
+}
+
+// Provider discovers backend servers.
+type Provider interface {
+	// Run discovers servers and calls update with all servers of the provider
+	// whenever they change, until the context is canceled.
+	Run(ctx context.Context, update func(Servers)) error
+}
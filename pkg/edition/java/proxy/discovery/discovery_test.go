@@ -0,0 +1,86 @@
+package discovery
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadDirectory(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+	write("a.yml", "lobby-1: localhost:25566\nlobby-2: localhost:25567\n")
+	write("b.json", `{"lobby-2": "localhost:25568", "survival": "localhost:25569"}`)
+	write("notes.txt", "ignored: localhost:1")
+	write(".hidden.yml", "ignored: localhost:1")
+
+	servers, err := ReadDirectory(dir)
+	require.NoError(t, err)
+	require.Equal(t, Servers{
+		"lobby-1":  "localhost:25566",
+		"lobby-2":  "localhost:25568",
+		"survival": "localhost:25569",
+	}, servers)
+
+	write("c.yml", "invalid: [")
+	_, err = ReadDirectory(dir)
+	require.Error(t, err)
+}
+
+func TestDirectory(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "lobby.yml"), []byte("lobby: localhost:25566"), 0o644))
+
+	updates := make(chan Servers, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = Directory(dir).Run(ctx, func(s Servers) { updates <- s }) }()
+
+	require.Equal(t, Servers{"lobby": "localhost:25566"}, <-updates)
+
+	require.NoError(t, os.Remove(filepath.Join(dir, "lobby.yml")))
+	select {
+	case s := <-updates:
+		require.Empty(t, s)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no update after removing a file")
+	}
+}
+
+func TestDNS(t *testing.T) {
+	r := new(LocalResolver)
+	r.SetSRV("_minecraft._tcp.lobby.example.com",
+		&net.SRV{Target: "node-a.example.com.", Port: 25566},
+		&net.SRV{Target: "node-b.example.com.", Port: 25567},
+	)
+	r.SetHost("survival.example.com", "10.0.0.1")
+
+	updates := make(chan Servers, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p := DNS(r, 10*time.Millisecond, []DNSRecord{
+		{Name: "lobby", SRV: "_minecraft._tcp.lobby.example.com"},
+		{Name: "survival", Host: "survival.example.com"},
+	})
+	go func() { _ = p.Run(ctx, func(s Servers) { updates <- s }) }()
+
+	require.Equal(t, Servers{
+		"lobby-node-a":      "node-a.example.com:25566",
+		"lobby-node-b":      "node-b.example.com:25567",
+		"survival-10-0-0-1": "10.0.0.1:25565",
+	}, <-updates)
+
+	r.SetSRV("_minecraft._tcp.lobby.example.com")
+	r.SetHost("survival.example.com", "10.0.0.1", "10.0.0.2")
+	require.Equal(t, Servers{
+		"survival-10-0-0-1": "10.0.0.1:25565",
+		"survival-10-0-0-2": "10.0.0.2:25565",
+	}, <-updates)
+}
@@ -0,0 +1,194 @@
+package discovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// DefaultPort is the port used for DNS A/AAAA records if none is set.
+const DefaultPort = 25565
+
+// Resolver resolves DNS records. It is implemented by *net.Resolver.
+type Resolver interface {
+	LookupSRV(ctx context.Context, service, proto, name string) (cname string, addrs []*net.SRV, err error)
+	LookupHost(ctx context.Context, host string) (addrs []string, err error)
+}
+
+var _ Resolver = (*net.Resolver)(nil)
+
+// DNSRecord is a DNS record to discover servers from.
+// Either SRV or Host must be set.
+type DNSRecord struct {
+	// Name is the name of the discovered servers, suffixed with the
+	// first label of the SRV target or the resolved IP address.
+	Name string
+	// SRV is the full name of an SRV record, e.g. "_minecraft._tcp.lobby.example.com".
+	// Each target is discovered as a server named "<Name>-<first target label>".
+	SRV string
+	// Host is a host name with A/AAAA records.
+	// Each address is discovered as a server named "<Name>-<IP with '.' and ':' replaced by '-'>".
+	Host string
+	// Port is the server port used with Host, DefaultPort if 0.
+	Port int
+}
+
+// DNS returns a Provider that resolves the records every interval.
+// A nil resolver uses net.DefaultResolver.
+//
+// If a record can't be resolved, its previously discovered servers are kept so that
+// temporary DNS failures don't remove servers. Records that don't exist discover no servers.
+func DNS(resolver Resolver, interval time.Duration, records []DNSRecord) Provider {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	return &dns{resolver: resolver, interval: interval, records: records}
+}
+
+type dns struct {
+	resolver Resolver
+	interval time.Duration
+	records  []DNSRecord
+}
+
+func (d *dns) Run(ctx context.Context, update func(Servers)) error {
+	log := logr.FromContextOrDiscard(ctx)
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	var last Servers
+	resolved := make([]Servers, len(d.records)) // last result by record
+	for {
+		servers := Servers{}
+		for i, record := range d.records {
+			s, err := d.resolve(ctx, record)
+			if ctx.Err() != nil {
+				return nil
+			}
+			var dnsErr *net.DNSError
+			if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+				s, err = Servers{}, nil // all servers were removed
+			}
+			if err != nil {
+				log.Info("failed to resolve servers from DNS", "record", record.Name, "error", err)
+			} else {
+				resolved[i] = s
+			}
+			maps.Copy(servers, resolved[i])
+		}
+		if last == nil || !servers.Equal(last) {
+			last = servers
+			update(servers)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *dns) resolve(ctx context.Context, record DNSRecord) (Servers, error) {
+	servers := Servers{}
+	if record.SRV != "" {
+		_, srvs, err := d.resolver.LookupSRV(ctx, "", "", record.SRV)
+		if err != nil {
+			return nil, err
+		}
+		for _, srv := range srvs {
+			target := strings.TrimSuffix(srv.Target, ".")
+			label, _, _ := strings.Cut(target, ".")
+			servers[record.Name+"-"+label] = net.JoinHostPort(target, strconv.Itoa(int(srv.Port)))
+		}
+		return servers, nil
+	}
+	if record.Host == "" {
+		return nil, fmt.Errorf("record %q has neither srv nor host", record.Name)
+	}
+	addrs, err := d.resolver.LookupHost(ctx, record.Host)
+	if err != nil {
+		return nil, err
+	}
+	port := record.Port
+	if port == 0 {
+		port = DefaultPort
+	}
+	for _, addr := range addrs {
+		name := record.Name + "-" + strings.NewReplacer(".", "-", ":", "-").Replace(addr)
+		servers[name] = net.JoinHostPort(addr, strconv.Itoa(port))
+	}
+	return servers, nil
+}
+
+// LocalResolver is an in-memory Resolver, e.g. for tests.
+// The zero value is ready to use.
+type LocalResolver struct {
+	mu    sync.RWMutex
+	srv   map[string][]*net.SRV
+	hosts map[string][]string
+}
+
+var _ Resolver = (*LocalResolver)(nil)
+
+// SetSRV sets the targets of the SRV record name. No targets removes the record.
+func (r *LocalResolver) SetSRV(name string, targets ...*net.SRV) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.srv == nil {
+		r.srv = map[string][]*net.SRV{}
+	}
+	if len(targets) == 0 {
+		delete(r.srv, name)
+		return
+	}
+	r.srv[name] = targets
+}
+
+// SetHost sets the addresses of the host. No addresses removes the host.
+func (r *LocalResolver) SetHost(host string, addrs ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.hosts == nil {
+		r.hosts = map[string][]string{}
+	}
+	if len(addrs) == 0 {
+		delete(r.hosts, host)
+		return
+	}
+	r.hosts[host] = addrs
+}
+
+// LookupSRV returns the targets set by SetSRV for the name.
+// The service and proto are used to build the name like net.Resolver does if both are set.
+func (r *LocalResolver) LookupSRV(_ context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	if service != "" || proto != "" {
+		name = "_" + service + "._" + proto + "." + name
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	srvs, ok := r.srv[name]
+	if !ok {
+		return "", nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+	return name, srvs, nil
+}
+
+// LookupHost returns the addresses set by SetHost for the host.
+func (r *LocalResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	addrs, ok := r.hosts[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	return addrs, nil
+}
@@ -0,0 +1,51 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/proxy/discovery"
+)
+
+func TestSyncDiscoveredServers(t *testing.T) {
+	p := createTestProxy(t, map[string]string{"lobby": "localhost:25565"})
+	ctx := context.Background()
+	update := func(provider string, servers discovery.Servers) {
+		p.syncDiscoveredServers(ctx, logr.Discard(), provider, servers)
+	}
+
+	update("dns", discovery.Servers{
+		"lobby":   "localhost:30000", // config server is left untouched
+		"game-a":  "localhost:25566",
+		"game-b":  "localhost:25567",
+		"invalid": "localhost:invalid",
+	})
+	require.Equal(t, "localhost:25565", p.Server("lobby").ServerInfo().Addr().String())
+	require.NotNil(t, p.Server("game-a"))
+	require.NotNil(t, p.Server("game-b"))
+	require.Nil(t, p.Server("invalid"))
+
+	// Servers of other providers are left untouched
+	update("directory", discovery.Servers{"game-a": "localhost:40000", "custom": "localhost:25568"})
+	require.Equal(t, "localhost:25566", p.Server("game-a").ServerInfo().Addr().String())
+	require.NotNil(t, p.Server("custom"))
+
+	update("dns", discovery.Servers{"game-a": "localhost:25569"})
+	require.Equal(t, "localhost:25569", p.Server("game-a").ServerInfo().Addr().String())
+	require.Nil(t, p.Server("game-b"))
+	require.NotNil(t, p.Server("lobby"))
+
+	p.forgetDiscoveryProviders(&config.Discovery{Directory: "servers"})
+	require.Nil(t, p.Server("game-a"))
+	require.NotNil(t, p.Server("custom"))
+
+	// Stopped providers don't sync anymore
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	p.syncDiscoveredServers(canceled, logr.Discard(), "dns", discovery.Servers{"game-c": "localhost:25570"})
+	require.Nil(t, p.Server("game-c"))
+}
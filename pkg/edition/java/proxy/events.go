@@ -15,8 +15,10 @@ import (
 	"go.minekube.com/gate/pkg/edition/java/ping"
 	"go.minekube.com/gate/pkg/edition/java/profile"
 	"go.minekube.com/gate/pkg/edition/java/proto/packet"
+	"go.minekube.com/gate/pkg/edition/java/proto/util"
 	"go.minekube.com/gate/pkg/edition/java/proxy/message"
 	"go.minekube.com/gate/pkg/edition/java/proxy/player"
+	"go.minekube.com/gate/pkg/edition/java/query"
 	"go.minekube.com/gate/pkg/util/permission"
 )
 
@@ -51,6 +53,42 @@ func (p *PingEvent) SetPing(ping *ping.ServerPing) {
 //
 //
 
+// QueryEvent is fired when a remote client sends a query stat request
+// to the query server. Subscribers may modify the pre-initialized
+// response or set it to nil to drop the request without replying.
+type QueryEvent struct {
+	addr     net.Addr
+	typ      query.Type
+	response *query.Response
+}
+
+// RemoteAddr returns the address of the client that sent the query request.
+func (q *QueryEvent) RemoteAddr() net.Addr {
+	return q.addr
+}
+
+// Type returns the requested stat type.
+func (q *QueryEvent) Type() query.Type {
+	return q.typ
+}
+
+// Response returns the query response. (pre-initialized by the proxy)
+func (q *QueryEvent) Response() *query.Response {
+	return q.response
+}
+
+// SetResponse sets the query response to use.
+// A nil response drops the request.
+func (q *QueryEvent) SetResponse(response *query.Response) {
+	q.response = response
+}
+
+//
+//
+//
+//
+//
+
 // ConnectionEventConn tracks whether Close was called on the connection.
 type ConnectionEventConn interface {
 	net.Conn
@@ -567,7 +605,9 @@ type KickedFromServerEvent struct {
 //
 // # RedirectPlayerKickResult
 //
-// NotifyKickResult
+// # NotifyKickResult
+//
+// LimboKickResult
 type ServerKickResult interface {
 	isServerKickResult() // assert implemented internally
 }
@@ -576,6 +616,7 @@ var (
 	_ ServerKickResult = (*DisconnectPlayerKickResult)(nil)
 	_ ServerKickResult = (*RedirectPlayerKickResult)(nil)
 	_ ServerKickResult = (*NotifyKickResult)(nil)
+	_ ServerKickResult = (*LimboKickResult)(nil)
 )
 
 func newKickedFromServerEvent(
@@ -652,6 +693,18 @@ type NotifyKickResult struct {
 
 func (*NotifyKickResult) isServerKickResult() {}
 
+// LimboKickResult is a ServerKickResult and
+// tells the proxy to hold the player in limbo, where the player stays connected
+// to the proxy without a server until one of the try servers is available again.
+// The optional message is sent to the player. Players of 1.16+ are disconnected
+// instead if no server of the player's version was joined since the proxy started,
+// as limbo reuses the registries of such a server for its empty world.
+type LimboKickResult struct {
+	Message component.Component
+}
+
+func (*LimboKickResult) isServerKickResult() {}
+
 //
 //
 //
@@ -1177,6 +1230,35 @@ type ShutdownEvent struct{}
 //
 //
 
+// ServerHealthChangedEvent is fired when the health status of a registered server
+// changed as determined by health checks, see the "healthCheck" config.
+// It is also fired with an unknown status when health checks are disabled.
+type ServerHealthChangedEvent struct {
+	server   RegisteredServer
+	previous HealthStatus
+	health   ServerHealth
+}
+
+// Server returns the server whose health changed.
+func (e *ServerHealthChangedEvent) Server() RegisteredServer {
+	return e.server
+}
+
+// PreviousStatus returns the health status of the server before the change.
+func (e *ServerHealthChangedEvent) PreviousStatus() HealthStatus {
+	return e.previous
+}
+
+// Health returns the current health of the server.
+func (e *ServerHealthChangedEvent) Health() ServerHealth {
+	return e.health
+}
+
+//
+//
+//
+//
+
 // CookieReceiveEvent is fired when a cookie from a client is requested either by a proxy plugin or
 // by a backend server. Gate will wait on this event to finish firing before discarding the
 // cookie request (if handled) or forwarding it to the client.
@@ -1326,3 +1408,46 @@ func (e *CookieRequestEvent) Allowed() bool { return !e.denied }
 
 // SetAllowed sets whether the cookie request is allowed to be forwarded to the client.
 func (e *CookieRequestEvent) SetAllowed(allowed bool) { e.denied = !allowed }
+
+//
+//
+//
+//
+
+// CustomClickActionEvent is fired when a 1.21.6+ client clicks a custom action,
+// e.g. a button of a dialog or a custom click event of a chat component.
+// Gate will wait on this event to finish firing before forwarding the action to the backend server.
+//
+// Use the dialog package to parse the payload of submitted dialog forms.
+type CustomClickActionEvent struct {
+	player  Player
+	id      key.Key
+	payload util.BinaryTag
+	denied  bool
+}
+
+func newCustomClickActionEvent(player Player, id key.Key, payload util.BinaryTag) *CustomClickActionEvent {
+	return &CustomClickActionEvent{
+		player:  player,
+		id:      id,
+		payload: payload,
+	}
+}
+
+// Player returns the player who clicked the action.
+func (e *CustomClickActionEvent) Player() Player { return e.player }
+
+// ID returns the identifier of the clicked action.
+// For example: gate:rules/accept
+func (e *CustomClickActionEvent) ID() key.Key { return e.id }
+
+// Payload returns the payload of the action.
+// The Type of the payload is nbt.TagEnd if the action has no payload.
+func (e *CustomClickActionEvent) Payload() util.BinaryTag { return e.payload }
+
+// Allowed returns whether the action is allowed to be forwarded to the backend server.
+func (e *CustomClickActionEvent) Allowed() bool { return !e.denied }
+
+// SetAllowed sets whether the action is allowed to be forwarded to the backend server.
+// Actions handled by the proxy should not be forwarded.
+func (e *CustomClickActionEvent) SetAllowed(allowed bool) { e.denied = !allowed }
@@ -267,3 +267,20 @@ func executeCommand(cmd string, player *connectedPlayer, cmdMgr *command.Manager
 	}
 	return true, nil
 }
+
+// ExecuteCommand runs the command line as the given source, e.g. the console.
+// It fires a CommandExecuteEvent first and does nothing if it was denied.
+// The error is brigodier.ErrDispatcherUnknownCommand if no such command is registered.
+func (p *Proxy) ExecuteCommand(ctx context.Context, src command.Source, commandline string) error {
+	commandline = strings.TrimPrefix(commandline, "/")
+	e := &CommandExecuteEvent{
+		source:          src,
+		commandline:     commandline,
+		originalCommand: commandline,
+	}
+	p.event.Fire(e)
+	if !e.Allowed() {
+		return nil
+	}
+	return p.command.Do(ctx, src, e.Command())
+}
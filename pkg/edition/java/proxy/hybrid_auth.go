@@ -0,0 +1,31 @@
+package proxy
+
+import (
+	"time"
+
+	"go.minekube.com/gate/pkg/edition/java/auth/hybrid"
+	"go.minekube.com/gate/pkg/edition/java/config"
+)
+
+// setupHybridAuth sets up the resolver deciding per username whether
+// players log in online or offline, removing it if hybrid auth is disabled.
+func (p *Proxy) setupHybridAuth(cfg *config.AuthHybrid) {
+	if !cfg.Enabled {
+		p.hybridAuth.Store(nil)
+		return
+	}
+	var store hybrid.Store
+	if cfg.Store != "" {
+		fs, err := hybrid.NewFileStore(cfg.Store)
+		if err != nil {
+			p.log.Error(err, "error loading hybrid auth store, keeping modes in memory only", "path", cfg.Store)
+		} else {
+			store = fs
+		}
+	}
+	p.hybridAuth.Store(hybrid.NewResolver(&hybrid.ProfileAPI{
+		URL:     cfg.ProfileAPIURL.T(),
+		Timeout: time.Duration(cfg.Timeout),
+	}, store, time.Duration(cfg.CacheTTL)))
+	p.log.Info("hybrid authentication enabled", "store", cfg.Store)
+}
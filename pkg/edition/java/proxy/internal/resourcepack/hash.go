@@ -0,0 +1,180 @@
+package resourcepack
+
+import (
+	"context"
+	"crypto/sha1"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+const (
+	// DefaultHashTTL is how long the hash of a resource-pack downloaded from a URL is cached by default.
+	DefaultHashTTL = time.Hour
+	// DefaultDownloadTimeout is the default timeout of downloading a resource-pack.
+	DefaultDownloadTimeout = 30 * time.Second
+	// DefaultMaxSize is the default maximum size of a downloaded resource-pack,
+	// the maximum size accepted by Minecraft clients.
+	DefaultMaxSize = 250 << 20
+)
+
+// defaultClient is used to download resource-packs if the Hasher has no client.
+var defaultClient = &http.Client{Timeout: DefaultDownloadTimeout}
+
+// Hasher computes SHA-1 hashes of resource-packs from local files or URLs and caches them.
+// Hashes of files are computed again when the file was modified.
+// The zero value is ready to use.
+type Hasher struct {
+	Client  *http.Client  // Used to download resource-packs, nil uses a client with DefaultDownloadTimeout.
+	TTL     time.Duration // How long hashes of URLs are cached, 0 uses DefaultHashTTL.
+	Timeout time.Duration // Timeout of a download, 0 uses DefaultDownloadTimeout.
+	MaxSize int64         // Maximum size of a downloaded resource-pack in bytes, 0 uses DefaultMaxSize.
+
+	group singleflight.Group
+	mu    sync.Mutex
+	cache map[string]cachedHash
+}
+
+type cachedHash struct {
+	hash    Hash
+	modTime time.Time // of the file
+	size    int64     // of the file
+	expires time.Time // for URLs
+}
+
+// File returns the SHA-1 hash of the resource-pack file.
+func (h *Hasher) File(path string) (Hash, error) {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	key := "file:" + path
+	if c, ok := h.cached(key); ok && c.modTime.Equal(stat.ModTime()) && c.size == stat.Size() {
+		return c.hash, nil
+	}
+	v, err, _ := h.group.Do(key, func() (any, error) {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = f.Close() }()
+		hash, err := SHA1(f)
+		if err != nil {
+			return nil, fmt.Errorf("error hashing resource pack file %q: %w", path, err)
+		}
+		h.store(key, cachedHash{hash: hash, modTime: stat.ModTime(), size: stat.Size()})
+		return hash, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(Hash), nil
+}
+
+// URL downloads the resource-pack and returns its SHA-1 hash.
+// The download is shared by concurrent calls for the same URL and therefore
+// not canceled by ctx, but limited by the Timeout of the Hasher.
+func (h *Hasher) URL(ctx context.Context, url string) (Hash, error) {
+	key := "url:" + url
+	if c, ok := h.cached(key); ok && time.Now().Before(c.expires) {
+		return c.hash, nil
+	}
+	v, err, _ := h.group.Do(key, func() (any, error) {
+		timeout := h.Timeout
+		if timeout <= 0 {
+			timeout = DefaultDownloadTimeout
+		}
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+		defer cancel()
+		hash, err := h.download(ctx, url)
+		if err != nil {
+			return nil, fmt.Errorf("error hashing resource pack %q: %w", url, err)
+		}
+		ttl := h.TTL
+		if ttl <= 0 {
+			ttl = DefaultHashTTL
+		}
+		h.store(key, cachedHash{hash: hash, expires: time.Now().Add(ttl)})
+		return hash, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(Hash), nil
+}
+
+func (h *Hasher) download(ctx context.Context, url string) (Hash, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	client := h.Client
+	if client == nil {
+		client = defaultClient
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+	maxSize := h.MaxSize
+	if maxSize <= 0 {
+		maxSize = DefaultMaxSize
+	}
+	s := sha1.New()
+	n, err := io.Copy(s, io.LimitReader(res.Body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if n > maxSize {
+		return nil, fmt.Errorf("resource pack exceeds the maximum size of %d bytes", maxSize)
+	}
+	return s.Sum(nil), nil
+}
+
+func (h *Hasher) cached(key string) (cachedHash, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	c, ok := h.cache[key]
+	return c, ok
+}
+
+func (h *Hasher) store(key string, c cachedHash) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.cache == nil {
+		h.cache = map[string]cachedHash{}
+	}
+	h.cache[key] = c
+}
+
+// SHA1 returns the SHA-1 hash of the resource-pack read from r.
+func SHA1(r io.Reader) (Hash, error) {
+	s := sha1.New()
+	if _, err := io.Copy(s, r); err != nil {
+		return nil, err
+	}
+	return s.Sum(nil), nil
+}
+
+// IDForURL returns a stable resource-pack ID for the URL,
+// so that the same pack has the same ID across servers and restarts.
+func IDForURL(url string) uuid.UUID {
+	const version = 5 // UUID v5
+	sum := sha1.Sum([]byte("ResourcePack:" + url))
+	var id uuid.UUID
+	copy(id[:], sum[:16])
+	id[6] = (id[6] & 0x0f) | uint8((version&0xf)<<4)
+	id[8] = (id[8] & 0x3f) | 0x80 // RFC 4122 variant
+	return id
+}
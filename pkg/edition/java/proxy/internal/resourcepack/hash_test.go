@@ -0,0 +1,79 @@
+package resourcepack
+
+import (
+	"context"
+	"crypto/sha1"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHasher_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pack.zip")
+	require.NoError(t, os.WriteFile(path, []byte("first"), 0o644))
+
+	var h Hasher
+	hash, err := h.File(path)
+	require.NoError(t, err)
+	want := sha1.Sum([]byte("first"))
+	require.Equal(t, Hash(want[:]), hash)
+
+	// Modified files are hashed again
+	require.NoError(t, os.WriteFile(path, []byte("second!"), 0o644))
+	hash, err = h.File(path)
+	require.NoError(t, err)
+	want = sha1.Sum([]byte("second!"))
+	require.Equal(t, Hash(want[:]), hash)
+
+	_, err = h.File(filepath.Join(t.TempDir(), "missing.zip"))
+	require.Error(t, err)
+}
+
+func TestHasher_URL(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Path == "/missing.zip" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte("pack"))
+	}))
+	defer srv.Close()
+
+	h := &Hasher{TTL: time.Minute}
+	want := sha1.Sum([]byte("pack"))
+	for range 3 {
+		hash, err := h.URL(context.Background(), srv.URL+"/pack.zip")
+		require.NoError(t, err)
+		require.Equal(t, Hash(want[:]), hash)
+	}
+	require.EqualValues(t, 1, requests.Load(), "hash should be cached")
+
+	_, err := h.URL(context.Background(), srv.URL+"/missing.zip")
+	require.Error(t, err)
+
+	// Canceling the first caller does not fail the shared download
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	hash, err := h.URL(ctx, srv.URL+"/other.zip")
+	require.NoError(t, err)
+	require.Equal(t, Hash(want[:]), hash)
+
+	h.MaxSize = 3
+	_, err = h.URL(context.Background(), srv.URL+"/large.zip")
+	require.ErrorContains(t, err, "maximum size")
+}
+
+func TestIDForURL(t *testing.T) {
+	id := IDForURL("https://example.com/pack.zip")
+	require.Equal(t, id, IDForURL("https://example.com/pack.zip"))
+	require.NotEqual(t, id, IDForURL("https://example.com/other.zip"))
+	require.Equal(t, byte(5), id[6]>>4, "UUID version")
+}
@@ -0,0 +1,416 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/netmc"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet"
+	cfgpacket "go.minekube.com/gate/pkg/edition/java/proto/packet/config"
+	"go.minekube.com/gate/pkg/edition/java/proto/state"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+	"go.minekube.com/gate/pkg/edition/java/scoreboard"
+	"go.minekube.com/gate/pkg/gate/proto"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+// limboKeepAliveInterval is how often players in limbo are sent keep alive
+// packets, since there is no backend server sending them.
+const limboKeepAliveInterval = 5 * time.Second
+
+// limbo holds players connected to the proxy without a server until one of the try servers
+// is available again. Players in limbo are spawned in an empty world and kept alive by the proxy.
+type limbo struct {
+	proxy *Proxy
+	// ping checks whether the server is available for the player.
+	ping func(ctx context.Context, player *connectedPlayer, server RegisteredServer) error
+	// connect connects the player to the server.
+	connect func(ctx context.Context, player *connectedPlayer, server RegisteredServer) (ServerConnectionResult, error)
+	// spawn spawns the player in the world of limbo and sends the message, if not nil.
+	spawn func(player *connectedPlayer, msg component.Component) error
+
+	mu      sync.Mutex
+	players map[uuid.UUID]*connectedPlayer
+	worlds  map[proto.Protocol]*limboWorld // by protocol version
+}
+
+func newLimbo(proxy *Proxy) *limbo {
+	l := &limbo{
+		proxy: proxy,
+		ping: func(ctx context.Context, player *connectedPlayer, server RegisteredServer) error {
+			_, err := proxy.fetchServerPing(ctx, proxy.log, player.RemoteAddr(),
+				server.ServerInfo().Addr(), player.Protocol())
+			return err
+		},
+		connect: func(ctx context.Context, player *connectedPlayer, server RegisteredServer) (ServerConnectionResult, error) {
+			ctx, cancel := withConnectionTimeout(ctx, proxy.config())
+			defer cancel()
+			result, err := player.createConnectionRequest(server).connect(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return result, nil
+		},
+		players: map[uuid.UUID]*connectedPlayer{},
+		worlds:  map[proto.Protocol]*limboWorld{},
+	}
+	l.spawn = l.spawnPlayer
+	return l
+}
+
+// InLimbo returns true if the player is held in limbo,
+// see LimboKickResult and the "limbo" proxy config.
+func (p *Proxy) InLimbo(player Player) bool {
+	p.limbo.mu.Lock()
+	defer p.limbo.mu.Unlock()
+	_, ok := p.limbo.players[player.ID()]
+	return ok
+}
+
+// park moves the player to limbo and sends the message, if not nil.
+// Returns false if the player cannot be spawned in the world of limbo,
+// e.g. because no server sent the world for the player's version yet.
+func (l *limbo) park(player *connectedPlayer, msg component.Component) bool {
+	if err := l.spawn(player, msg); err != nil {
+		player.log.Info("could not move player to limbo", "error", err)
+		return false
+	}
+	l.mu.Lock()
+	l.players[player.ID()] = player
+	l.mu.Unlock()
+	player.log.Info("moved player to limbo")
+	return true
+}
+
+// limboWorld is the world of a backend server recorded for a protocol version.
+// Clients since 1.16 only accept worlds of the registries sent by the server,
+// so limbo reuses the registries and dimension of the last joined server
+// of the same version, without sending any chunks.
+type limboWorld struct {
+	// configPackets are the known packs, registries, tags and features
+	// a 1.20.2+ server sent in the config state, in order.
+	configPackets []proto.Packet
+	joinGame      packet.JoinGame
+}
+
+// errNoLimboWorld is returned when no server sent the world of limbo for the player's version yet.
+var errNoLimboWorld = errors.New("no world recorded for the version of the player yet")
+
+// limboSpawnY is above the build height, so that clients leave the loading terrain
+// screen without receiving any chunks.
+const limboSpawnY = 2048
+
+// limboViewDistance is the view distance of players in limbo, where there is nothing to see.
+const limboViewDistance = 2
+
+// record records the world of the JoinGame a server sent for the protocol.
+func (l *limbo) record(protocol proto.Protocol, configPackets []proto.Packet, joinGame *packet.JoinGame) {
+	if protocol.Lower(version.Minecraft_1_16) {
+		return // worlds are not recorded, see world
+	}
+	w := &limboWorld{
+		configPackets: configPackets,
+		joinGame:      *joinGame,
+	}
+	l.mu.Lock()
+	l.worlds[protocol] = w
+	l.mu.Unlock()
+}
+
+// world returns the world of limbo for the protocol, changed for players in limbo.
+func (l *limbo) world(protocol proto.Protocol) (*limboWorld, error) {
+	if protocol.Lower(version.Minecraft_1_16) {
+		// Worlds before 1.16 don't depend on registries of the server
+		levelType := "flat"
+		return &limboWorld{joinGame: packet.JoinGame{
+			EntityID:         1,
+			Gamemode:         limboGamemode(protocol),
+			MaxPlayers:       1,
+			LevelType:        &levelType,
+			PreviousGamemode: -1,
+			ViewDistance:     limboViewDistance,
+		}}, nil
+	}
+	l.mu.Lock()
+	w, ok := l.worlds[protocol]
+	l.mu.Unlock()
+	if !ok {
+		return nil, errNoLimboWorld
+	}
+	joinGame := w.joinGame
+	joinGame.Gamemode = limboGamemode(protocol)
+	joinGame.PreviousGamemode = -1
+	joinGame.Hardcore = false
+	joinGame.LastDeathPosition = nil
+	joinGame.PortalCooldown = 0
+	joinGame.ViewDistance = limboViewDistance
+	joinGame.SimulationDistance = limboViewDistance
+	return &limboWorld{
+		configPackets: w.configPackets,
+		joinGame:      joinGame,
+	}, nil
+}
+
+// limboGamemode returns spectator, or adventure for clients without spectator mode,
+// so that players can't interact with the empty world.
+func limboGamemode(protocol proto.Protocol) int16 {
+	if protocol.Lower(version.Minecraft_1_8) {
+		return 2
+	}
+	return 3
+}
+
+// spawnPlayer spawns the player in the world of limbo.
+// Since 1.20.2 the registries of the world are sent in the config state first.
+func (l *limbo) spawnPlayer(player *connectedPlayer, msg component.Component) error {
+	w, err := l.world(player.Protocol())
+	if err != nil {
+		return err
+	}
+	if player.Protocol().Lower(version.Minecraft_1_20_2) {
+		return l.join(player, w, msg)
+	}
+	switch h := player.ActiveSessionHandler().(type) {
+	case *clientPlaySessionHandler:
+		// Switch the client back to the config state to send the registries.
+		h.doSwitch().ThenAccept(func(any) {
+			if err := l.configure(player, w, msg); err != nil {
+				player.log.Error(err, "error configuring player for limbo")
+				player.Disconnect(internalServerConnectionError)
+			}
+		})
+		return nil
+	case *clientConfigSessionHandler:
+		return l.configure(player, w, msg)
+	default:
+		return fmt.Errorf("unexpected session handler %T", h)
+	}
+}
+
+// configure sends the registries of the world of limbo to a 1.20.2+ client in the config state.
+// The player joins the world when the client finished the configuration, see limboConfigSessionHandler.
+func (l *limbo) configure(player *connectedPlayer, w *limboWorld, msg component.Component) error {
+	configHandler, ok := player.ActiveSessionHandler().(*clientConfigSessionHandler)
+	if !ok {
+		configHandler = newClientConfigSessionHandler(player)
+	}
+	player.SetActiveSessionHandler(state.Config, &limboConfigSessionHandler{
+		clientConfigSessionHandler: configHandler,
+		limbo:                      l,
+		world:                      w,
+		msg:                        msg,
+	})
+	for _, p := range w.configPackets {
+		if err := player.BufferPacket(p); err != nil {
+			return fmt.Errorf("error buffering %T for player: %w", p, err)
+		}
+	}
+	if err := player.WritePacket(&cfgpacket.FinishedUpdate{}); err != nil {
+		return fmt.Errorf("error writing finished update packet: %w", err)
+	}
+	player.Writer().SetState(state.Play)
+	return nil
+}
+
+// join spawns the player in the world of limbo, leaving the world of the previous server.
+func (l *limbo) join(player *connectedPlayer, w *limboWorld, msg component.Component) error {
+	playHandler, ok := player.ActiveSessionHandler().(*clientPlaySessionHandler)
+	if !ok {
+		playHandler = newClientPlaySessionHandler(player)
+		player.SetActiveSessionHandler(state.Play, playHandler)
+	}
+	if _, err := playHandler.joinWorld(&w.joinGame); err != nil {
+		return err
+	}
+	// Leave the loading terrain screen
+	if err := player.BufferPacket(&packet.PlayerPosition{Y: limboSpawnY}); err != nil {
+		return fmt.Errorf("error buffering position for player: %w", err)
+	}
+	if player.Protocol().GreaterEqual(version.Minecraft_1_20_3) {
+		if err := player.BufferPacket(&packet.GameEvent{Event: packet.GameEventLevelChunksLoadStart}); err != nil {
+			return fmt.Errorf("error buffering game event for player: %w", err)
+		}
+	}
+	if err := player.Flush(); err != nil {
+		return fmt.Errorf("error flushing buffered player packets: %w", err)
+	}
+	// Send the proxy scoreboards reset by the JoinGame again.
+	if err := scoreboard.Sync(player); err != nil {
+		player.log.V(1).Info("error sending scoreboards to player", "error", err)
+	}
+	if msg != nil {
+		_ = player.SendMessage(msg)
+	}
+	return nil
+}
+
+// limboConfigSessionHandler handles a 1.20.2+ client receiving the registries of the world
+// of limbo in the config state and spawns the player when the client finished the configuration.
+type limboConfigSessionHandler struct {
+	*clientConfigSessionHandler
+	limbo *limbo
+	world *limboWorld
+	msg   component.Component
+}
+
+var _ netmc.SessionHandler = (*limboConfigSessionHandler)(nil)
+
+func (h *limboConfigSessionHandler) HandlePacket(pc *proto.PacketContext) {
+	if _, ok := pc.Packet.(*cfgpacket.FinishedUpdate); !ok {
+		h.clientConfigSessionHandler.HandlePacket(pc)
+		return
+	}
+	player := h.player
+	player.SetActiveSessionHandler(state.Play, newClientPlaySessionHandler(player))
+	// Restore the config handler for switching to a server later
+	player.AddSessionHandler(state.Config, h.clientConfigSessionHandler)
+	if err := h.limbo.join(player, h.world, h.msg); err != nil {
+		h.log.Error(err, "error joining world of limbo")
+		player.Disconnect(internalServerConnectionError)
+	}
+}
+
+// limboMessage returns the configured limbo message, prefixed by the reason if not nil.
+func limboMessage(cfg *config.Config, reason component.Component) component.Component {
+	var parts []component.Component
+	if reason != nil {
+		parts = append(parts, reason)
+	}
+	if cfg.Limbo.Message != nil {
+		if len(parts) != 0 {
+			parts = append(parts, &component.Text{Content: "\n"})
+		}
+		parts = append(parts, cfg.Limbo.Message.T())
+	}
+	if len(parts) == 0 {
+		return nil
+	}
+	return &component.Text{Extra: parts}
+}
+
+func (l *limbo) remove(player *connectedPlayer) {
+	l.mu.Lock()
+	delete(l.players, player.ID())
+	l.mu.Unlock()
+}
+
+// list returns the active players in limbo and removes disconnected ones.
+func (l *limbo) list() []*connectedPlayer {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	players := make([]*connectedPlayer, 0, len(l.players))
+	for id, player := range l.players {
+		if !player.Active() {
+			delete(l.players, id)
+			continue
+		}
+		players = append(players, player)
+	}
+	return players
+}
+
+// run keeps players in limbo alive and tries to connect them to a try server until the context is canceled.
+func (l *limbo) run(ctx context.Context) {
+	keepAlive := time.NewTicker(limboKeepAliveInterval)
+	defer keepAlive.Stop()
+	retry := time.NewTimer(l.retryInterval())
+	defer retry.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepAlive.C:
+			for _, player := range l.list() {
+				_ = player.WritePacket(&packet.KeepAlive{RandomID: time.Now().UnixNano()})
+			}
+		case <-retry.C:
+			l.retry(ctx)
+			retry.Reset(l.retryInterval())
+		}
+	}
+}
+
+func (l *limbo) retryInterval() time.Duration {
+	if d := time.Duration(l.proxy.config().Limbo.RetryInterval); d > 0 {
+		return d
+	}
+	return 5 * time.Second
+}
+
+// retry connects players in limbo to the first available try server.
+func (l *limbo) retry(ctx context.Context) {
+	players := l.list()
+	if len(players) == 0 {
+		return
+	}
+	available := map[string]bool{} // by server name, shared by all players of this retry
+	var wg sync.WaitGroup
+	for _, player := range players {
+		server := l.availableServer(ctx, player, available)
+		if server == nil {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			l.reconnect(ctx, player, server)
+		}()
+	}
+	wg.Wait()
+}
+
+// availableServer returns the first try server of the player that answers a ping.
+func (l *limbo) availableServer(ctx context.Context, player *connectedPlayer, available map[string]bool) RegisteredServer {
+	cfg := l.proxy.config()
+	candidates := cfg.ForcedHosts[player.getVirtualHostname()]
+	if len(candidates) == 0 {
+		candidates = cfg.Try
+	}
+	for _, name := range candidates {
+		server := l.proxy.PickServer(name)
+		if server == nil {
+			continue
+		}
+		ok, checked := available[server.ServerInfo().Name()]
+		if !checked {
+			ok = l.ping(ctx, player, server) == nil
+			available[server.ServerInfo().Name()] = ok
+		}
+		if ok {
+			return server
+		}
+	}
+	return nil
+}
+
+// reconnect connects the player to the server and removes it from limbo if successful.
+func (l *limbo) reconnect(ctx context.Context, player *connectedPlayer, server RegisteredServer) {
+	result, err := l.connect(ctx, player, server)
+	if err != nil {
+		player.log.V(1).Info("could not connect player in limbo", "server", server.ServerInfo().Name(), "error", err)
+		return
+	}
+	switch {
+	case result.Status().Successful():
+		l.remove(player)
+		// Start over with the first try server on the next failover
+		player.mu.Lock()
+		player.serversToTry = nil
+		player.tryIndex = 0
+		player.mu.Unlock()
+	case result.Status().ServerDisconnected():
+		// The server rejected the player
+		l.remove(player)
+		reason := result.Reason()
+		if reason == nil {
+			reason = internalServerConnectionError
+		}
+		player.Disconnect(reason)
+	}
+}
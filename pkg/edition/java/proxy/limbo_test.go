@@ -0,0 +1,182 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/lite"
+	"go.minekube.com/gate/pkg/edition/java/netmc"
+	"go.minekube.com/gate/pkg/edition/java/profile"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet"
+	cfgpacket "go.minekube.com/gate/pkg/edition/java/proto/packet/config"
+	"go.minekube.com/gate/pkg/edition/java/proto/state"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+	"go.minekube.com/gate/pkg/gate/proto"
+	"go.minekube.com/gate/pkg/util/configutil"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+func TestLimboMessage(t *testing.T) {
+	cfg := &config.Config{}
+	require.Nil(t, limboMessage(cfg, nil))
+
+	reason := &component.Text{Content: "server closed"}
+	require.Equal(t, &component.Text{Extra: []component.Component{reason}}, limboMessage(cfg, reason))
+
+	cfg.Limbo.Message = &configutil.TextComponent{Content: "waiting"}
+	require.Equal(t, &component.Text{Extra: []component.Component{
+		reason, &component.Text{Content: "\n"}, cfg.Limbo.Message.T(),
+	}}, limboMessage(cfg, reason))
+}
+
+// limboTestConn is a connection in a fixed state.
+type limboTestConn struct {
+	netmc.MinecraftConn
+	ctx   context.Context
+	state *state.Registry
+}
+
+func (c *limboTestConn) Context() context.Context { return c.ctx }
+func (c *limboTestConn) State() *state.Registry   { return c.state }
+
+func newLimboTestPlayer(ctx context.Context, s *state.Registry) *connectedPlayer {
+	return &connectedPlayer{
+		MinecraftConn: &limboTestConn{ctx: ctx, state: s},
+		profile:       &profile.GameProfile{ID: uuid.New(), Name: "player"},
+		log:           logr.Discard(),
+	}
+}
+
+// spawnNothing stubs spawning players in the world of limbo.
+func spawnNothing(*connectedPlayer, component.Component) error { return nil }
+
+func TestLimbo_park(t *testing.T) {
+	l := newLimbo(&Proxy{})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Players that cannot be spawned are not held
+	l.spawn = func(*connectedPlayer, component.Component) error { return errNoLimboWorld }
+	require.False(t, l.park(newLimboTestPlayer(ctx, state.Config), nil))
+	require.Empty(t, l.list())
+
+	l.spawn = spawnNothing
+	player := newLimboTestPlayer(ctx, state.Config)
+	require.True(t, l.park(player, nil))
+	require.Equal(t, []*connectedPlayer{player}, l.list())
+
+	// Disconnected players are removed
+	cancel()
+	require.Empty(t, l.list())
+}
+
+func TestLimbo_world(t *testing.T) {
+	l := newLimbo(&Proxy{})
+
+	// Worlds before 1.16 are not recorded
+	w, err := l.world(version.Minecraft_1_7_2.Protocol)
+	require.NoError(t, err)
+	require.Equal(t, int16(2), w.joinGame.Gamemode, "adventure without spectator mode")
+	require.Equal(t, "flat", *w.joinGame.LevelType)
+	w, err = l.world(version.Minecraft_1_15.Protocol)
+	require.NoError(t, err)
+	require.Equal(t, int16(3), w.joinGame.Gamemode)
+
+	_, err = l.world(version.Minecraft_1_20_5.Protocol)
+	require.ErrorIs(t, err, errNoLimboWorld)
+
+	configPackets := []proto.Packet{&cfgpacket.KnownPacks{}, &cfgpacket.RegistrySync{}}
+	joinGame := &packet.JoinGame{
+		EntityID:          7,
+		Gamemode:          0,
+		Dimension:         1,
+		Hardcore:          true,
+		PreviousGamemode:  1,
+		LastDeathPosition: &packet.DeathPosition{Key: "minecraft:overworld"},
+		ViewDistance:      10,
+	}
+	l.record(version.Minecraft_1_20_5.Protocol, configPackets, joinGame)
+	joinGame.Dimension = 0 // changed for the switch after recording
+
+	w, err = l.world(version.Minecraft_1_20_5.Protocol)
+	require.NoError(t, err)
+	require.Equal(t, configPackets, w.configPackets)
+	require.Equal(t, packet.JoinGame{
+		EntityID:           7,
+		Gamemode:           3,
+		Dimension:          1,
+		PreviousGamemode:   -1,
+		ViewDistance:       limboViewDistance,
+		SimulationDistance: limboViewDistance,
+	}, w.joinGame)
+
+	_, err = l.world(version.Minecraft_1_21_7.Protocol)
+	require.ErrorIs(t, err, errNoLimboWorld, "worlds are recorded by version")
+}
+
+func TestLimbo_retry(t *testing.T) {
+	p := &Proxy{
+		log:     logr.Discard(),
+		lite:    lite.NewLite(),
+		servers: map[string]*registeredServer{},
+		cfg:     &config.Config{Try: []string{"lobby", "fallback"}},
+	}
+	for i, name := range []string{"lobby", "fallback"} {
+		p.servers[name] = newRegisteredServer(NewServerInfo(name, mustParseAddr(fmt.Sprintf("localhost:%d", 25565+i))))
+	}
+	l := newLimbo(p)
+	l.spawn = spawnNothing
+
+	up := map[string]bool{}
+	pinged := map[string]int{}
+	l.ping = func(_ context.Context, _ *connectedPlayer, server RegisteredServer) error {
+		pinged[server.ServerInfo().Name()]++
+		if !up[server.ServerInfo().Name()] {
+			return errors.New("offline")
+		}
+		return nil
+	}
+	connected := make(chan string, 2)
+	l.connect = func(_ context.Context, _ *connectedPlayer, server RegisteredServer) (ServerConnectionResult, error) {
+		connected <- server.ServerInfo().Name()
+		return &connectionResult{status: SuccessConnectionStatus, attemptedConn: server}, nil
+	}
+
+	ctx := context.Background()
+	a, b := newLimboTestPlayer(ctx, state.Play), newLimboTestPlayer(ctx, state.Play)
+	require.True(t, l.park(a, nil))
+	require.True(t, l.park(b, nil))
+
+	// All try servers down, players stay in limbo
+	l.retry(ctx)
+	require.Len(t, l.list(), 2)
+	require.Equal(t, map[string]int{"lobby": 1, "fallback": 1}, pinged, "servers are pinged once per retry")
+	require.Empty(t, connected)
+
+	// The first available try server is used
+	up["fallback"] = true
+	l.retry(ctx)
+	require.Empty(t, l.list())
+	require.Equal(t, "fallback", <-connected)
+	require.Equal(t, "fallback", <-connected)
+}
+
+func TestLimbo_reconnectFailed(t *testing.T) {
+	l := newLimbo(&Proxy{log: logr.Discard()})
+	l.spawn = spawnNothing
+	l.connect = func(context.Context, *connectedPlayer, RegisteredServer) (ServerConnectionResult, error) {
+		return nil, errors.New("timeout")
+	}
+	player := newLimboTestPlayer(context.Background(), state.Play)
+	require.True(t, l.park(player, nil))
+	l.reconnect(context.Background(), player,
+		newRegisteredServer(NewServerInfo("lobby", mustParseAddr("localhost:25565"))))
+	require.Len(t, l.list(), 1, "stays in limbo until the next retry")
+}
@@ -0,0 +1,39 @@
+package proxy
+
+import (
+	"time"
+
+	"go.minekube.com/gate/pkg/edition/java/auth/hybrid"
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/offlineuuid"
+)
+
+// setupOfflineUUIDs sets up the resolver of offline mode player UUIDs,
+// removing it if the default offline UUIDs are used without mapping file.
+func (p *Proxy) setupOfflineUUIDs(cfg *config.AuthOfflineUUID) {
+	var strategy offlineuuid.Strategy
+	if cfg.Strategy == config.PremiumUUIDStrategy {
+		strategy = &offlineuuid.Premium{
+			API: &hybrid.ProfileAPI{
+				URL:     cfg.ProfileAPIURL.T(),
+				Timeout: time.Duration(cfg.Timeout),
+			},
+			CacheTTL: time.Duration(cfg.CacheTTL),
+		}
+	}
+	var mapping *offlineuuid.Mapping
+	if cfg.MappingFile != "" {
+		m, err := offlineuuid.NewMapping(cfg.MappingFile)
+		if err != nil {
+			p.log.Error(err, "error loading offline uuid mapping file, UUIDs are not persisted", "path", cfg.MappingFile)
+		} else {
+			mapping = m
+		}
+	}
+	if strategy == nil && mapping == nil {
+		p.offlineUUIDs.Store(nil)
+		return
+	}
+	p.offlineUUIDs.Store(offlineuuid.NewResolver(strategy, mapping))
+	p.log.Info("offline uuid resolution enabled", "strategy", cfg.Strategy, "mappingFile", cfg.MappingFile)
+}
@@ -2,9 +2,14 @@ package proxy
 
 import (
 	"context"
+	"net"
+	"time"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
+
+	"go.minekube.com/gate/pkg/internal/metrics"
 )
 
 var (
@@ -12,6 +17,93 @@ var (
 	tracer = otel.Tracer("java/proxy")
 )
 
+var (
+	loginAttemptsCounter = metrics.Must(meter.Int64Counter(
+		"gate.login_attempts",
+		metric.WithDescription("The number of login attempts"),
+		metric.WithUnit("1"),
+	))
+	loginFailuresCounter = metrics.Must(meter.Int64Counter(
+		"gate.login_failures",
+		metric.WithDescription("The number of failed logins by reason"),
+		metric.WithUnit("1"),
+	))
+	quotaRejectionsCounter = metrics.Must(meter.Int64Counter(
+		"gate.quota_rejections",
+		metric.WithDescription("The number of connections rejected by a rate limit quota"),
+		metric.WithUnit("1"),
+	))
+	kicksCounter = metrics.Must(meter.Int64Counter(
+		"gate.kicks",
+		metric.WithDescription("The number of players kicked from a server"),
+		metric.WithUnit("1"),
+	))
+	serverSwitchHistogram = metrics.Must(meter.Float64Histogram(
+		"gate.server_switch.duration",
+		metric.WithDescription("The time it took to connect a player to a server"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30),
+	))
+	receivedBytesCounter = metrics.Must(meter.Int64Counter(
+		"gate.received",
+		metric.WithDescription("The bytes received by connection type"),
+		metric.WithUnit("By"),
+	))
+	sentBytesCounter = metrics.Must(meter.Int64Counter(
+		"gate.sent",
+		metric.WithDescription("The bytes sent by connection type"),
+		metric.WithUnit("By"),
+	))
+)
+
+// Login failure reasons of the gate.login_failures metric.
+const (
+	loginFailureUnsupportedVersion = "unsupported_version"
+	loginFailureRateLimited        = "rate_limited"
+	loginFailureInvalidUsername    = "invalid_username"
+	loginFailureInvalidPublicKey   = "invalid_public_key"
+	loginFailureDenied             = "denied"
+	loginFailureAuthentication     = "authentication_failed"
+	loginFailureUUIDResolution     = "uuid_resolution_failed"
+	loginFailureAlreadyConnected   = "already_connected"
+	loginFailureNoAvailableServers = "no_available_servers"
+)
+
+func recordLoginAttempt() {
+	loginAttemptsCounter.Add(context.Background(), 1)
+}
+
+func recordLoginFailure(reason string) {
+	loginFailuresCounter.Add(context.Background(), 1,
+		metric.WithAttributes(attribute.String("reason", reason)))
+}
+
+func recordQuotaRejection(quota string) {
+	quotaRejectionsCounter.Add(context.Background(), 1,
+		metric.WithAttributes(attribute.String("quota", quota)))
+}
+
+func recordKick(server string) {
+	kicksCounter.Add(context.Background(), 1,
+		metric.WithAttributes(attribute.String("server", server)))
+}
+
+// recordServerSwitch records the duration of a server connection attempt.
+func recordServerSwitch(server string, start time.Time, successful bool) {
+	result := "failure"
+	if successful {
+		result = "success"
+	}
+	serverSwitchHistogram.Record(context.Background(), time.Since(start).Seconds(),
+		metric.WithAttributes(attribute.String("server", server), attribute.String("result", result)))
+}
+
+// countConn returns the conn recording the bytes it transfers with the connection type.
+func countConn(conn net.Conn, connection string) net.Conn {
+	return metrics.CountConn(conn, receivedBytesCounter, sentBytesCounter,
+		metric.WithAttributeSet(attribute.NewSet(attribute.String("connection", connection))))
+}
+
 func (p *Proxy) initMeter() error {
 	// player count metric
 	_, err := meter.Int64ObservableGauge(
@@ -39,6 +131,22 @@ func (p *Proxy) initMeter() error {
 	if err != nil {
 		return err
 	}
+	// per server player count metric
+	_, err = meter.Int64ObservableGauge(
+		"gate.server.player_count",
+		metric.WithInt64Callback(func(ctx context.Context, o metric.Int64Observer) error {
+			for _, s := range p.Servers() {
+				o.Observe(int64(s.Players().Len()),
+					metric.WithAttributes(attribute.String("server", s.ServerInfo().Name())))
+			}
+			return nil
+		}),
+		metric.WithDescription("The current player count per registered server"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -0,0 +1,250 @@
+package proxy
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"slices"
+
+	"go.minekube.com/gate/pkg/edition/java/netmc"
+	"go.minekube.com/gate/pkg/edition/java/proto/state/states"
+	"go.minekube.com/gate/pkg/edition/java/proto/util"
+	"go.minekube.com/gate/pkg/gate/proto"
+)
+
+// PacketFilter selects the packets a packet handler registered with Proxy.InterceptPackets is called for.
+// Empty fields match all packets. If both Types and IDs are set, a packet must match one of either.
+type PacketFilter struct {
+	// Directions the packets are bound to. proto.ServerBound selects packets
+	// sent by players, proto.ClientBound selects packets sent by backend servers.
+	Directions []proto.Direction
+	// States the connection must be in, e.g. states.PlayState.
+	States []states.State
+	// Types are the packet types to match, e.g. &packet.KeepAlive{}.
+	// Only packets known to Gate can be matched by type.
+	Types []proto.Packet
+	// IDs are the packet ids to match in the protocol version of the connection.
+	// This allows matching packets unknown to Gate.
+	IDs []proto.PacketID
+}
+
+// InterceptPackets registers fn to be called with a PacketEvent for each packet matching the
+// filter that is received from a client or the backend server of a player, before Gate handles it.
+// This includes the handshake, status and login packets of clients, PacketEvent.Player
+// returns nil until the client was authenticated and became a player.
+// Handlers are called in the order they were registered on the reading goroutine of the connection,
+// so they should return quickly.
+//
+// Packets are only decoded into PacketEvent.Packet if Gate knows the packet type.
+// Intercepting packets has no overhead while no handler is registered.
+//
+// The returned func unregisters the handler.
+func (p *Proxy) InterceptPackets(filter PacketFilter, fn func(*PacketEvent)) (unregister func()) {
+	h := &packetHandler{
+		directions: filter.Directions,
+		states:     filter.States,
+		ids:        filter.IDs,
+		fn:         fn,
+	}
+	for _, t := range filter.Types {
+		h.types = append(h.types, proto.TypeOf(t))
+	}
+
+	p.packetHandlersMu.Lock()
+	defer p.packetHandlersMu.Unlock()
+	handlers := append(slices.Clone(p.loadPacketHandlers()), h)
+	p.packetHandlers.Store(&handlers)
+	return func() {
+		p.packetHandlersMu.Lock()
+		defer p.packetHandlersMu.Unlock()
+		handlers := slices.DeleteFunc(slices.Clone(p.loadPacketHandlers()),
+			func(e *packetHandler) bool { return e == h })
+		p.packetHandlers.Store(&handlers)
+	}
+}
+
+func (p *Proxy) loadPacketHandlers() []*packetHandler {
+	if h := p.packetHandlers.Load(); h != nil {
+		return *h
+	}
+	return nil
+}
+
+type packetHandler struct {
+	directions []proto.Direction
+	states     []states.State
+	types      []proto.PacketType
+	ids        []proto.PacketID
+	fn         func(*PacketEvent)
+}
+
+func (h *packetHandler) matches(pc *proto.PacketContext, state states.State) bool {
+	if len(h.directions) != 0 && !slices.Contains(h.directions, pc.Direction) {
+		return false
+	}
+	if len(h.states) != 0 && !slices.Contains(h.states, state) {
+		return false
+	}
+	if len(h.types) == 0 && len(h.ids) == 0 {
+		return true
+	}
+	if slices.Contains(h.ids, pc.PacketID) {
+		return true
+	}
+	return pc.KnownPacket() && slices.Contains(h.types, proto.TypeOf(pc.Packet))
+}
+
+// packetInterceptor returns the netmc.PacketInterceptor firing PacketEvents
+// for packets read from conn, which is the player's or one of its backend connections.
+// The player is nil for client connections not yet authenticated.
+func (p *Proxy) packetInterceptor(conn netmc.MinecraftConn, player *connectedPlayer, server *serverConnection) netmc.PacketInterceptor {
+	return func(pc *proto.PacketContext) bool {
+		handlers := p.loadPacketHandlers()
+		if len(handlers) == 0 {
+			return true
+		}
+		st := conn.State().State
+		var e *PacketEvent
+		for _, h := range handlers {
+			if !h.matches(pc, st) {
+				continue
+			}
+			if e == nil {
+				e = &PacketEvent{
+					conn:   conn,
+					player: player,
+					server: server,
+					state:  st,
+					pc:     pc,
+				}
+			}
+			h.fn(e)
+			if e.dropped {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// PacketEvent is passed to packet handlers registered with Proxy.InterceptPackets
+// when a matching packet is received from a client or the backend server of a player.
+// Handlers can inspect, modify, drop or inject packets.
+type PacketEvent struct {
+	conn    netmc.MinecraftConn // the connection the packet was read from
+	player  *connectedPlayer    // nil if the client is not yet a player
+	server  *serverConnection   // nil if the packet was sent by the player
+	state   states.State
+	pc      *proto.PacketContext
+	dropped bool
+}
+
+// Player returns the player the packet was sent by or is sent to.
+// Returns nil for handshake, status and login packets of clients not yet authenticated.
+func (e *PacketEvent) Player() Player {
+	if e.player == nil {
+		return nil
+	}
+	return e.player
+}
+
+// Server returns the backend server connection the packet was sent by or is sent to.
+// Returns nil if the player is not connected to a server.
+func (e *PacketEvent) Server() ServerConnection {
+	s := e.server
+	if s == nil && e.player != nil {
+		s = e.player.connectedServer()
+	}
+	if s == nil {
+		return nil
+	}
+	return s
+}
+
+// RemoteAddr returns the remote address of the connection the packet was received on.
+func (e *PacketEvent) RemoteAddr() net.Addr { return e.conn.RemoteAddr() }
+
+// Direction returns the direction the packet is bound to.
+// proto.ServerBound if sent by the player, proto.ClientBound if sent by the backend server.
+func (e *PacketEvent) Direction() proto.Direction { return e.pc.Direction }
+
+// State returns the state of the connection the packet was received on.
+func (e *PacketEvent) State() states.State { return e.state }
+
+// Protocol returns the protocol version of the packet.
+func (e *PacketEvent) Protocol() proto.Protocol { return e.pc.Protocol }
+
+// PacketID returns the id of the packet.
+func (e *PacketEvent) PacketID() proto.PacketID { return e.pc.PacketID }
+
+// Packet returns the decoded packet or nil if the packet is unknown to Gate.
+func (e *PacketEvent) Packet() proto.Packet { return e.pc.Packet }
+
+// Payload returns the packet id and data of the packet as forwarded.
+// It must not be modified, use SetPacket instead.
+func (e *PacketEvent) Payload() []byte { return e.pc.Payload }
+
+// SetPacket replaces the packet with the given packet, which must be registered
+// for the direction, state and protocol version of the intercepted packet.
+// Call SetPacket with Packet() to apply changes made to the decoded packet.
+func (e *PacketEvent) SetPacket(packet proto.Packet) error {
+	registry := e.conn.State().ClientBound
+	if e.pc.Direction == proto.ServerBound {
+		registry = e.conn.State().ServerBound
+	}
+	id, found := registry.ProtocolRegistry(e.pc.Protocol).PacketID(packet)
+	if !found {
+		return fmt.Errorf("packet type %T not registered for %s packets in %s state in protocol %s",
+			packet, e.pc.Direction, e.state, e.pc.Protocol)
+	}
+	buf := new(bytes.Buffer)
+	_ = util.WriteVarInt(buf, int(id))
+	pc := &proto.PacketContext{
+		Direction: e.pc.Direction,
+		Protocol:  e.pc.Protocol,
+		PacketID:  id,
+		Packet:    packet,
+	}
+	if err := util.RecoverFunc(func() error {
+		return packet.Encode(pc, buf)
+	}); err != nil {
+		return fmt.Errorf("error encoding packet %T: %w", packet, err)
+	}
+	e.pc.PacketID = id
+	e.pc.Packet = packet
+	e.pc.Payload = buf.Bytes()
+	return nil
+}
+
+// Drop drops the packet, so it is neither handled by Gate nor forwarded.
+// Handlers registered after the dropping handler are not called.
+func (e *PacketEvent) Drop() { e.dropped = true }
+
+// Dropped returns true if the packet was dropped.
+func (e *PacketEvent) Dropped() bool { return e.dropped }
+
+// Inject writes a packet to the receiver of the intercepted packet, that is,
+// the backend server for packets sent by the player and the player for packets
+// sent by the backend server. Injected packets are not intercepted.
+func (e *PacketEvent) Inject(packet proto.Packet) error {
+	if e.pc.Direction == proto.ClientBound {
+		return e.player.WritePacket(packet)
+	}
+	if e.player == nil {
+		return ErrNoBackendConnection
+	}
+	s := e.player.connectedServer()
+	if s == nil {
+		return ErrNoBackendConnection
+	}
+	mc := s.conn()
+	if mc == nil {
+		return ErrNoBackendConnection
+	}
+	return mc.WritePacket(packet)
+}
+
+// Reply writes a packet back to the sender of the intercepted packet.
+func (e *PacketEvent) Reply(packet proto.Packet) error {
+	return e.conn.WritePacket(packet)
+}
@@ -0,0 +1,60 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/edition/java/proto/packet"
+	"go.minekube.com/gate/pkg/edition/java/proto/state/states"
+	"go.minekube.com/gate/pkg/gate/proto"
+)
+
+func TestPacketHandlerMatches(t *testing.T) {
+	keepAlive := &proto.PacketContext{Direction: proto.ServerBound, PacketID: 0x18, Packet: &packet.KeepAlive{}}
+	unknown := &proto.PacketContext{Direction: proto.ClientBound, PacketID: 0x42}
+
+	newHandler := func(f PacketFilter) *packetHandler {
+		p := &Proxy{}
+		p.InterceptPackets(f, func(*PacketEvent) {})
+		return p.loadPacketHandlers()[0]
+	}
+
+	all := newHandler(PacketFilter{})
+	require.True(t, all.matches(keepAlive, states.PlayState))
+	require.True(t, all.matches(unknown, states.ConfigState))
+
+	byType := newHandler(PacketFilter{Types: []proto.Packet{&packet.KeepAlive{}}})
+	require.True(t, byType.matches(keepAlive, states.PlayState))
+	require.False(t, byType.matches(unknown, states.PlayState))
+
+	byID := newHandler(PacketFilter{IDs: []proto.PacketID{0x42}})
+	require.False(t, byID.matches(keepAlive, states.PlayState))
+	require.True(t, byID.matches(unknown, states.PlayState))
+
+	byDirection := newHandler(PacketFilter{Directions: []proto.Direction{proto.ClientBound}})
+	require.False(t, byDirection.matches(keepAlive, states.PlayState))
+	require.True(t, byDirection.matches(unknown, states.PlayState))
+
+	byState := newHandler(PacketFilter{States: []states.State{states.ConfigState}})
+	require.False(t, byState.matches(keepAlive, states.PlayState))
+	require.True(t, byState.matches(unknown, states.ConfigState))
+}
+
+func TestInterceptPacketsUnregister(t *testing.T) {
+	p := &Proxy{}
+	unregister1 := p.InterceptPackets(PacketFilter{}, func(*PacketEvent) {})
+	unregister2 := p.InterceptPackets(PacketFilter{}, func(*PacketEvent) {})
+	require.Len(t, p.loadPacketHandlers(), 2)
+	unregister1()
+	require.Len(t, p.loadPacketHandlers(), 1)
+	unregister2()
+	require.Empty(t, p.loadPacketHandlers())
+}
+
+func TestPacketEvent_noPlayer(t *testing.T) {
+	e := &PacketEvent{pc: &proto.PacketContext{Direction: proto.ServerBound}}
+	require.Nil(t, e.Player())
+	require.Nil(t, e.Server())
+	require.ErrorIs(t, e.Inject(&packet.KeepAlive{}), ErrNoBackendConnection)
+}
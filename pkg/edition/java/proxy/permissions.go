@@ -0,0 +1,48 @@
+package proxy
+
+import (
+	"context"
+
+	"go.minekube.com/gate/pkg/internal/reload"
+	"go.minekube.com/gate/pkg/util/permission"
+)
+
+// watchPermissions loads the permissions file and reloads it on changes
+// until the returned stop func is called or the context is canceled.
+// If loading fails the previously loaded permissions are kept.
+func (p *Proxy) watchPermissions(ctx context.Context, path string) (stop context.CancelFunc) {
+	ctx, stop = context.WithCancel(ctx)
+	load := func() error {
+		cfg, err := permission.LoadConfig(path)
+		if err != nil {
+			return err
+		}
+		p.permissions.Store(cfg)
+		return nil
+	}
+	if err := load(); err != nil {
+		p.log.Error(err, "error loading permissions file", "path", path)
+	} else {
+		p.log.Info("loaded permissions file", "path", path)
+	}
+	if err := reload.Watch(ctx, path, load); err != nil {
+		p.log.Error(err, "error watching permissions file", "path", path)
+	}
+	return stop
+}
+
+// setupFilePermissions applies the permissions file to players.
+// Permissions undefined by the file fall back to the previous permission.Func.
+func (p *Proxy) setupFilePermissions(e *PermissionsSetupEvent) {
+	player, ok := e.Subject().(Player)
+	if !ok {
+		return
+	}
+	fallback := e.Func()
+	e.SetFunc(func(perm string) permission.TriState {
+		if v := p.permissions.Load().Value(player.ID(), player.Username(), perm); v != permission.Undefined {
+			return v
+		}
+		return fallback(perm)
+	})
+}
@@ -0,0 +1,202 @@
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/jellydator/ttlcache/v3"
+	"golang.org/x/sync/singleflight"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/internal/protoutil"
+	"go.minekube.com/gate/pkg/edition/java/lite"
+	"go.minekube.com/gate/pkg/edition/java/ping"
+	"go.minekube.com/gate/pkg/gate/proto"
+)
+
+var (
+	passthroughPingCache = ttlcache.New[passthroughPingKey, *passthroughPingResult](
+		ttlcache.WithDisableTouchOnHit[passthroughPingKey, *passthroughPingResult](),
+	)
+	passthroughPingGroup = new(singleflight.Group)
+)
+
+func init() {
+	go passthroughPingCache.Start() // start ttl eviction once
+}
+
+type passthroughPingKey struct {
+	serverAddr string
+	protocol   proto.Protocol
+}
+
+type passthroughPingResult struct {
+	ping *ping.ServerPing
+	err  error
+}
+
+// applyPingPassthrough merges the ping response of the first reachable server
+// the inbound connection would be sent to into the given ping according to the
+// configured ping passthrough mode. The ping is left untouched if no server responds.
+func (p *Proxy) applyPingPassthrough(ctx context.Context, log logr.Logger, inbound Inbound, protocol proto.Protocol, dst *ping.ServerPing) {
+	cfg := p.config()
+	mode := cfg.Status.PingPassthrough
+	if !mode.Enabled() {
+		return
+	}
+	for _, server := range p.pingPassthroughServers(inbound) {
+		log := log.WithValues("server", server.ServerInfo().Name())
+		src, err := p.pingServer(ctx, log, inbound, server, protocol, cfg.Status.GetPingPassthroughCacheTTL())
+		if err != nil {
+			log.V(1).Info("could not ping server for ping passthrough", "error", err)
+			continue
+		}
+		mergePing(mode, dst, src)
+		return
+	}
+}
+
+// pingPassthroughServers returns the servers to try for ping passthrough,
+// that are the forced host servers of the inbound virtual host or the try servers.
+func (p *Proxy) pingPassthroughServers(inbound Inbound) []RegisteredServer {
+	cfg := p.config()
+	names := cfg.ForcedHosts[virtualHostname(inbound.VirtualHost())]
+	if len(names) == 0 {
+		names = cfg.Try
+	}
+	servers := make([]RegisteredServer, 0, len(names))
+	for _, name := range names {
+		if s := p.Server(name); s != nil {
+			servers = append(servers, s)
+		} else {
+			servers = append(servers, p.ServerGroup(name)...)
+		}
+	}
+	return servers
+}
+
+// pingServer pings the server and caches the result for the given ttl.
+// A ttl < 0 disables caching.
+func (p *Proxy) pingServer(
+	ctx context.Context,
+	log logr.Logger,
+	inbound Inbound,
+	server RegisteredServer,
+	protocol proto.Protocol,
+	ttl time.Duration,
+) (*ping.ServerPing, error) {
+	addr := server.ServerInfo().Addr()
+	load := func(ctx context.Context) (*ping.ServerPing, error) {
+		return p.fetchServerPing(ctx, log, inbound.RemoteAddr(), addr, protocol)
+	}
+	if ttl < 0 {
+		return load(ctx)
+	}
+
+	key := passthroughPingKey{serverAddr: addr.String(), protocol: protocol}
+	if item := passthroughPingCache.Get(key); item != nil {
+		log.V(1).Info("returning cached passthrough ping result")
+		return item.Value().ping, item.Value().err
+	}
+
+	// Only one ping per server and protocol is in flight at a time,
+	// concurrent requests share the result of the first one.
+	resultChan := passthroughPingGroup.DoChan(fmt.Sprintf("%s/%d", key.serverAddr, key.protocol), func() (any, error) {
+		res, err := load(context.Background())
+		result := &passthroughPingResult{ping: res, err: err}
+		passthroughPingCache.Set(key, result, ttl)
+		return result, nil
+	})
+	select {
+	case r := <-resultChan:
+		result := r.Val.(*passthroughPingResult)
+		return result.ping, result.err
+	case <-ctx.Done():
+		return nil, context.Cause(ctx)
+	}
+}
+
+// fetchServerPing dials the server address and requests its ping response.
+func (p *Proxy) fetchServerPing(
+	ctx context.Context,
+	log logr.Logger,
+	clientAddr, serverAddr net.Addr,
+	protocol proto.Protocol,
+) (*ping.ServerPing, error) {
+	timeout := time.Duration(p.config().ConnectionTimeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", serverAddr.String())
+	if err != nil {
+		return nil, fmt.Errorf("error dialing server: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	if p.config().ProxyProtocolBackend {
+		header := protoutil.ProxyHeader(clientAddr, conn.RemoteAddr())
+		if _, err = header.WriteTo(conn); err != nil {
+			return nil, fmt.Errorf("error writing proxy protocol header to server: %w", err)
+		}
+	}
+
+	host, portStr, err := net.SplitHostPort(serverAddr.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid server address: %w", err)
+	}
+	port, _ := strconv.Atoi(portStr)
+
+	res, err := lite.FetchStatus(log, conn, host, port, protocol)
+	if err != nil {
+		return nil, err
+	}
+	srvPing := new(ping.ServerPing)
+	if err = json.Unmarshal([]byte(res.Status), srvPing); err != nil {
+		return nil, fmt.Errorf("error unmarshalling status response: %w", err)
+	}
+	return srvPing, nil
+}
+
+// mergePing merges the parts of the src ping selected by the mode into dst.
+// The src ping may be shared and is never modified.
+func mergePing(mode config.PingPassthroughMode, dst, src *ping.ServerPing) {
+	switch mode {
+	case config.AllPingPassthroughMode:
+		*dst = *src
+		dst.Players = clonePlayers(src.Players)
+	case config.DescriptionPingPassthroughMode:
+		if src.Description != nil {
+			dst.Description = src.Description
+		}
+		if src.ModInfo != nil {
+			dst.ModInfo = src.ModInfo
+		}
+	case config.ModsPingPassthroughMode:
+		if src.ModInfo != nil {
+			dst.ModInfo = src.ModInfo
+		}
+	case config.PlayersPingPassthroughMode:
+		if src.Players != nil {
+			dst.Players = clonePlayers(src.Players)
+		}
+	}
+}
+
+func clonePlayers(p *ping.Players) *ping.Players {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.Sample = slices.Clone(p.Sample)
+	return &c
+}
@@ -0,0 +1,79 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/component"
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/forge/modinfo"
+	"go.minekube.com/gate/pkg/edition/java/ping"
+	"go.minekube.com/gate/pkg/util/netutil"
+)
+
+func TestMergePing(t *testing.T) {
+	newProxyPing := func() *ping.ServerPing {
+		return &ping.ServerPing{
+			Version:     ping.Version{Protocol: 767, Name: "Gate"},
+			Players:     &ping.Players{Online: 1, Max: 1000},
+			Description: &component.Text{Content: "proxy"},
+			Favicon:     "proxy-favicon",
+		}
+	}
+	backend := &ping.ServerPing{
+		Version: ping.Version{Protocol: 767, Name: "Paper 1.21"},
+		Players: &ping.Players{Online: 42, Max: 100, Sample: []ping.SamplePlayer{
+			{Name: "Alice"},
+		}},
+		Description: &component.Text{Content: "backend"},
+		Favicon:     "backend-favicon",
+		ModInfo:     &modinfo.ModInfo{Type: "FML"},
+	}
+
+	dst := newProxyPing()
+	mergePing(config.DescriptionPingPassthroughMode, dst, backend)
+	require.Equal(t, "backend", dst.Description.Content)
+	require.Equal(t, backend.ModInfo, dst.ModInfo)
+	require.Equal(t, 1, dst.Players.Online)
+	require.Equal(t, "proxy-favicon", string(dst.Favicon))
+
+	dst = newProxyPing()
+	mergePing(config.ModsPingPassthroughMode, dst, backend)
+	require.Equal(t, "proxy", dst.Description.Content)
+	require.Equal(t, backend.ModInfo, dst.ModInfo)
+
+	dst = newProxyPing()
+	mergePing(config.PlayersPingPassthroughMode, dst, backend)
+	require.Equal(t, 42, dst.Players.Online)
+	require.Equal(t, 100, dst.Players.Max)
+	require.Len(t, dst.Players.Sample, 1)
+	require.Equal(t, "Gate", dst.Version.Name)
+
+	dst = newProxyPing()
+	mergePing(config.AllPingPassthroughMode, dst, backend)
+	require.Equal(t, "Paper 1.21", dst.Version.Name)
+	require.Equal(t, "backend-favicon", string(dst.Favicon))
+
+	// Modifying the merged ping must not modify the shared backend ping.
+	dst.Players.Online = 0
+	require.Equal(t, 42, backend.Players.Online)
+}
+
+func TestPingPassthroughServers(t *testing.T) {
+	proxy := createTestProxyWithForcedHosts(t, map[string]string{
+		"lobby":    "localhost:25566",
+		"minigame": "localhost:25567",
+	}, map[string][]string{
+		"mini.example.com": {"minigame"},
+	}, []string{"lobby"})
+
+	names := func(virtualHost string) (n []string) {
+		inbound := newInitialInbound(nil, netutil.NewAddr(virtualHost, "tcp"), 0)
+		for _, s := range proxy.pingPassthroughServers(inbound) {
+			n = append(n, s.ServerInfo().Name())
+		}
+		return n
+	}
+	require.Equal(t, []string{"minigame"}, names("Mini.Example.com:25565"))
+	require.Equal(t, []string{"lobby"}, names("play.example.com:25565"))
+}
@@ -314,7 +314,6 @@ func (p *connectedPlayer) clearResourcePacks() error {
 	return nil
 }
 
-//nolint:unused
 func (p *connectedPlayer) removeResourcePacks(ids ...uuid.UUID) error {
 	if !p.Protocol().GreaterEqual(version.Minecraft_1_20_3) {
 		return nil
@@ -503,29 +502,36 @@ func (p *connectedPlayer) nextServerToTry(current RegisteredServer) RegisteredSe
 		}
 	}
 
-	sameName := func(rs RegisteredServer, name string) bool {
-		return rs.ServerInfo().Name() == name
+	exclude := func(rs RegisteredServer) bool {
+		return (p.connectedServer_ != nil && RegisteredServerEqual(p.connectedServer_.Server(), rs)) ||
+			(p.connInFlight != nil && RegisteredServerEqual(p.connInFlight.Server(), rs)) ||
+			(current != nil && RegisteredServerEqual(current, rs))
 	}
 
 	for i := p.tryIndex; i < len(p.serversToTry); i++ {
 		toTry := p.serversToTry[i]
-		if (p.connectedServer_ != nil && sameName(p.connectedServer_.Server(), toTry)) ||
-			(p.connInFlight != nil && sameName(p.connInFlight.Server(), toTry)) ||
-			(current != nil && sameName(current, toTry)) {
+		s := p.proxy.pickServer(toTry, exclude)
+		if s == nil || !s.healthy() {
 			continue
 		}
-
 		p.tryIndex = i
-		if s := p.proxy.Server(toTry); s != nil {
-			return s
+		if _, isGroup := p.config().ServerGroups.Group(toTry); isGroup {
+			// Continue with the next entry if the picked member fails
+			p.tryIndex = i + 1
 		}
+		return s
 	}
 	return nil
 }
 
 // getVirtualHostname extracts the hostname from the virtual host address and converts it to lowercase.
 func (p *connectedPlayer) getVirtualHostname() string {
-	if p.virtualHost == nil {
+	return virtualHostname(p.virtualHost)
+}
+
+// virtualHostname extracts the hostname from a virtual host address and converts it to lowercase.
+func virtualHostname(virtualHost net.Addr) string {
+	if virtualHost == nil {
 		return ""
 	}
 
@@ -533,7 +539,7 @@ func (p *connectedPlayer) getVirtualHostname() string {
 	// 1. Clear virtual host (removes forge separators, TCPShield separators, etc.)
 	// 2. Extract hostname (removes port)
 	// 3. Convert to lowercase for consistent matching
-	virtualHostStr := p.virtualHost.String()
+	virtualHostStr := virtualHost.String()
 	cleanedHost := lite.ClearVirtualHost(virtualHostStr)
 	hostname := netutil.HostStr(cleanedHost)
 
@@ -26,15 +26,21 @@ import (
 
 	"go.minekube.com/gate/pkg/command"
 	"go.minekube.com/gate/pkg/edition/java/auth"
+	"go.minekube.com/gate/pkg/edition/java/auth/hybrid"
 	"go.minekube.com/gate/pkg/edition/java/config"
 	"go.minekube.com/gate/pkg/edition/java/netmc"
+	"go.minekube.com/gate/pkg/edition/java/offlineuuid"
+	"go.minekube.com/gate/pkg/edition/java/packhost"
 	"go.minekube.com/gate/pkg/edition/java/proxy/message"
+	"go.minekube.com/gate/pkg/edition/java/rcon"
 	"go.minekube.com/gate/pkg/gate/proto"
 	"go.minekube.com/gate/pkg/internal/addrquota"
 	"go.minekube.com/gate/pkg/internal/connwrap"
 	"go.minekube.com/gate/pkg/internal/reload"
+	"go.minekube.com/gate/pkg/util/access"
 	"go.minekube.com/gate/pkg/util/errs"
 	"go.minekube.com/gate/pkg/util/netutil"
+	"go.minekube.com/gate/pkg/util/permission"
 	"go.minekube.com/gate/pkg/util/uuid"
 	"go.minekube.com/gate/pkg/util/validation"
 )
@@ -58,6 +64,8 @@ type Proxy struct {
 	muS           sync.RWMutex                 // Protects following fields
 	servers       map[string]*registeredServer // registered backend servers: by lower case names
 	configServers map[string]bool              // tracks which servers came from config (vs API)
+	discovered    map[string]string            // tracks which servers came from discovery: by lower case names to provider
+	discoveryMu   sync.Mutex                   // serializes syncing discovered servers
 
 	muP         sync.RWMutex                   // Protects following fields
 	playerNames map[string]*connectedPlayer    // lower case usernames map
@@ -67,6 +75,21 @@ type Proxy struct {
 	loginsQuota      *addrquota.Quota
 
 	lite *lite.Lite // lite mode functionality
+
+	permissions atomic.Pointer[permission.Config] // loaded permissions file, nil if disabled
+
+	packetHandlersMu sync.Mutex                       // Serializes updates of packetHandlers
+	packetHandlers   atomic.Pointer[[]*packetHandler] // registered by InterceptPackets
+
+	queue *Queue // queue for full or unavailable servers
+	limbo *limbo // holds players without an available server
+
+	resourcePacks *resourcePacks                // sends the configured resource packs
+	packHost      atomic.Pointer[packhost.Host] // serves local resource packs, nil if disabled
+
+	hybridAuth   atomic.Pointer[hybrid.Resolver]      // resolves online/offline mode per username, nil if disabled
+	offlineUUIDs atomic.Pointer[offlineuuid.Resolver] // resolves offline mode player UUIDs, nil for the default offline UUIDs
+	access       atomic.Pointer[access.Store]         // bans and allowlist, nil if disabled
 }
 
 // Options are the options for a new Java edition Proxy.
@@ -93,9 +116,11 @@ func New(options Options) (p *Proxy, err error) {
 	}
 	authn := options.Authenticator
 	if authn == nil {
+		servers, failover := sessionServers(&options.Config.Auth)
 		opts := auth.Options{
 			// Default to mojang's session server
-			HasJoinedURLFn: auth.CustomHasJoinedURL(options.Config.Auth.SessionServerURL.T()),
+			SessionServers: servers,
+			Failover:       failover,
 		}
 		authn, err = auth.New(opts)
 		if err != nil {
@@ -110,11 +135,15 @@ func New(options Options) (p *Proxy, err error) {
 		channelRegistrar: message.NewChannelRegistrar(),
 		servers:          map[string]*registeredServer{},
 		configServers:    map[string]bool{},
+		discovered:       map[string]string{},
 		playerNames:      map[string]*connectedPlayer{},
 		playerIDs:        map[uuid.UUID]*connectedPlayer{},
 		authenticator:    authn,
 		lite:             lite.NewLite(), // create lite mode functionality for this proxy instance
 	}
+	p.queue = newQueue(p)
+	p.limbo = newLimbo(p)
+	p.resourcePacks = newResourcePacks(p)
 
 	// Connection & login rate limiters
 	p.initQuota(&options.Config.Quota)
@@ -188,7 +217,9 @@ func (p *Proxy) Start(ctx context.Context) error {
 		if p.cfg.ProxyProtocol {
 			p.log.Info("proxy protocol enabled")
 		}
-		if p.cfg.Auth.SessionServerURL != nil {
+		if len(p.cfg.Auth.SessionServers) != 0 {
+			p.log.Info("using custom authentication servers", "count", len(p.cfg.Auth.SessionServers))
+		} else if p.cfg.Auth.SessionServerURL != nil {
 			p.log.Info("using custom authentication server", "url", p.cfg.Auth.SessionServerURL)
 		}
 	}
@@ -210,6 +241,137 @@ func (p *Proxy) Start(ctx context.Context) error {
 
 	stopLn := listen(p.cfg.Bind)
 
+	listenQuery := func(cfg *config.Config) context.CancelFunc {
+		if !queryEnabled(cfg) {
+			return func() {}
+		}
+		qCtx, stop := context.WithCancel(ctx)
+		addr := queryAddr(cfg)
+		eg.Go(func() error {
+			defer stop()
+			p.serveQuery(qCtx, addr)
+			return nil
+		})
+		return stop
+	}
+	stopQuery := listenQuery(p.cfg)
+
+	// rconSrv is the running RCON server, nil if disabled
+	var rconSrv *rcon.Server
+	listenRcon := func(cfg *config.Config) context.CancelFunc {
+		rconSrv = nil
+		if !cfg.Rcon.Enabled {
+			return func() {}
+		}
+		var lc net.ListenConfig
+		ln, err := lc.Listen(ctx, "tcp", cfg.Rcon.Bind)
+		if err != nil {
+			p.log.Error(err, "error listening for rcon connections", "addr", cfg.Rcon.Bind)
+			return func() {}
+		}
+		rCtx, cancel := context.WithCancel(ctx)
+		srv := &rcon.Server{Password: cfg.Rcon.Password, Execute: p.rconExecute}
+		rconSrv = srv
+		eg.Go(func() error {
+			defer cancel()
+			p.serveRcon(rCtx, ln, srv)
+			return nil
+		})
+		// Close the listener before returning, so that the bind address
+		// can be listened on again right away.
+		return func() {
+			cancel()
+			_ = ln.Close()
+		}
+	}
+	stopRcon := listenRcon(p.cfg)
+
+	watchPermissions := func(cfg *config.Config) context.CancelFunc {
+		if cfg.Permissions.File == "" {
+			p.permissions.Store(nil)
+			return func() {}
+		}
+		return p.watchPermissions(ctx, cfg.Permissions.File)
+	}
+	stopPermissions := watchPermissions(p.cfg)
+	defer func() { stopPermissions() }()
+	p.setupHybridAuth(&p.cfg.Auth.Hybrid)
+	p.setupOfflineUUIDs(&p.cfg.Auth.OfflineUUID)
+	p.setupAccess(&p.cfg.Access)
+	defer event.Subscribe(p.event, 0, p.checkAccess)()
+	defer event.Subscribe(p.event, 0, p.setupFilePermissions)()
+
+	defer event.Subscribe(p.event, 0, p.queue.handlePreConnect)()
+	defer event.Subscribe(p.event, 0, p.queue.handleDisconnect)()
+	eg.Go(func() error {
+		p.queue.run(ctx)
+		return nil
+	})
+	eg.Go(func() error {
+		p.limbo.run(ctx)
+		return nil
+	})
+
+	defer event.Subscribe(p.event, 0, p.resourcePacks.handlePostConnect)()
+	defer event.Subscribe(p.event, 0, p.resourcePacks.handleStatus)()
+	prepareResourcePacks := func(cfg *config.Config) {
+		rcfg := cfg.ResourcePacks
+		eg.Go(func() error {
+			p.resourcePacks.prepare(ctx, &rcfg)
+			return nil
+		})
+	}
+	prepareResourcePacks(p.cfg)
+
+	hostResourcePacks := func(cfg *config.Config) context.CancelFunc {
+		if !cfg.ResourcePacks.Host.Enabled {
+			return func() {}
+		}
+		hCtx, stop := context.WithCancel(ctx)
+		hcfg := cfg.ResourcePacks.Host
+		eg.Go(func() error {
+			defer stop()
+			p.startResourcePackHost(hCtx, hcfg)
+			return nil
+		})
+		return stop
+	}
+	stopResourcePackHost := hostResourcePacks(p.cfg)
+
+	checkHealth := func(cfg *config.Config) context.CancelFunc {
+		if !cfg.HealthCheck.Enabled {
+			return func() {}
+		}
+		hCtx, cancel := context.WithCancel(ctx)
+		hcfg := cfg.HealthCheck
+		done := make(chan struct{})
+		eg.Go(func() error {
+			defer close(done)
+			defer cancel()
+			p.runHealthChecks(hCtx, hcfg)
+			return nil
+		})
+		// Wait for the checks to stop so that their health reset
+		// does not wipe the health recorded by the next checks.
+		return func() { cancel(); <-done }
+	}
+	stopHealthChecks := checkHealth(p.cfg)
+
+	discover := func(cfg *config.Config) context.CancelFunc {
+		if !cfg.Discovery.Enabled() {
+			return func() {}
+		}
+		dCtx, stop := context.WithCancel(ctx)
+		dcfg := cfg.Discovery
+		eg.Go(func() error {
+			defer stop()
+			p.runDiscovery(dCtx, dcfg)
+			return nil
+		})
+		return stop
+	}
+	stopDiscovery := discover(p.cfg)
+
 	// Listen for config reloads until we exit
 	defer reload.Subscribe(p.event, func(e *javaConfigUpdateEvent) {
 		*p.cfg = *e.Config
@@ -220,6 +382,62 @@ func (p *Proxy) Start(ctx context.Context) error {
 			stopLn = listen(e.Config.Bind)
 			p.closeMu.Unlock()
 		}
+		if queryEnabled(e.PrevConfig) != queryEnabled(e.Config) ||
+			e.PrevConfig.Query.Port != e.Config.Query.Port ||
+			e.PrevConfig.Bind != e.Config.Bind {
+			p.closeMu.Lock()
+			stopQuery()
+			stopQuery = listenQuery(e.Config)
+			p.closeMu.Unlock()
+		}
+		if e.PrevConfig.Rcon != e.Config.Rcon {
+			p.closeMu.Lock()
+			if rconSrv != nil && e.Config.Rcon.Enabled && e.PrevConfig.Rcon.Bind == e.Config.Rcon.Bind {
+				// Only the password changed, keep the listener
+				rconSrv.SetPassword(e.Config.Rcon.Password)
+			} else {
+				stopRcon()
+				stopRcon = listenRcon(e.Config)
+			}
+			p.closeMu.Unlock()
+		}
+		if e.PrevConfig.Permissions != e.Config.Permissions {
+			p.closeMu.Lock()
+			stopPermissions()
+			stopPermissions = watchPermissions(e.Config)
+			p.closeMu.Unlock()
+		}
+		if !reflect.DeepEqual(e.PrevConfig.Auth.Hybrid, e.Config.Auth.Hybrid) {
+			p.setupHybridAuth(&e.Config.Auth.Hybrid)
+		}
+		if !reflect.DeepEqual(e.PrevConfig.Auth.OfflineUUID, e.Config.Auth.OfflineUUID) {
+			p.setupOfflineUUIDs(&e.Config.Auth.OfflineUUID)
+		}
+		if e.PrevConfig.Access.Enabled != e.Config.Access.Enabled || e.PrevConfig.Access.File != e.Config.Access.File {
+			p.setupAccess(&e.Config.Access)
+		}
+		if e.PrevConfig.HealthCheck != e.Config.HealthCheck {
+			p.closeMu.Lock()
+			stopHealthChecks()
+			stopHealthChecks = checkHealth(e.Config)
+			p.closeMu.Unlock()
+		}
+		if !reflect.DeepEqual(e.PrevConfig.Discovery, e.Config.Discovery) {
+			p.closeMu.Lock()
+			stopDiscovery()
+			p.forgetDiscoveryProviders(&e.Config.Discovery)
+			stopDiscovery = discover(e.Config)
+			p.closeMu.Unlock()
+		}
+		if !reflect.DeepEqual(e.PrevConfig.ResourcePacks, e.Config.ResourcePacks) {
+			prepareResourcePacks(e.Config)
+		}
+		if e.PrevConfig.ResourcePacks.Host != e.Config.ResourcePacks.Host {
+			p.closeMu.Lock()
+			stopResourcePackHost()
+			stopResourcePackHost = hostResourcePacks(e.Config)
+			p.closeMu.Unlock()
+		}
 		if err := p.init(); err != nil {
 			p.log.Error(err, "re-initialization error")
 		}
@@ -240,8 +458,10 @@ func (p *Proxy) Start(ctx context.Context) error {
 				lite.ResetPingCache()
 				p.log.Info("lite ping cache was reset")
 			}
+			p.lite.Limiter().Prune(e.Config.Lite.Routes)
 		} else {
 			lite.ResetPingCache()
+			p.lite.Limiter().Prune(nil)
 		}
 		logInfo()
 	})()
@@ -295,12 +515,37 @@ func (p *Proxy) Shutdown(reason component.Component) {
 	p.event.Wait()
 }
 
+// sessionServers returns the session servers to authenticate players with.
+func sessionServers(c *config.Auth) ([]auth.SessionServer, auth.Failover) {
+	failover := auth.Failover{
+		HedgeDelay:       time.Duration(c.HedgeDelay),
+		FailureThreshold: c.CircuitBreaker.FailureThreshold,
+		BreakDuration:    time.Duration(c.CircuitBreaker.BreakDuration),
+	}
+	if len(c.SessionServers) == 0 {
+		return []auth.SessionServer{{URL: c.SessionServerURL.T()}}, failover
+	}
+	servers := make([]auth.SessionServer, 0, len(c.SessionServers))
+	for _, s := range c.SessionServers {
+		servers = append(servers, auth.SessionServer{
+			URL:     s.URL.T(),
+			Timeout: time.Duration(s.Timeout),
+		})
+	}
+	return servers, failover
+}
+
 // called before starting to actually run the proxy
 func (p *Proxy) init() (err error) {
 	c := p.cfg
 
-	// No need to check, nil default to mojang's session server
-	p.authenticator.SetHasJoinedURLFn(auth.CustomHasJoinedURL(c.Auth.SessionServerURL.T()))
+	// No need to check, empty defaults to mojang's session server.
+	// Unchanged session servers keep their circuit breaker state on reload.
+	if setter, ok := p.authenticator.(auth.SessionServerSetter); ok {
+		setter.SetSessionServers(sessionServers(&c.Auth))
+	} else {
+		p.authenticator.SetHasJoinedURLFn(auth.CustomHasJoinedURL(c.Auth.SessionServerURL.T()))
+	}
 
 	if !c.Lite.Enabled {
 		// Sync servers: register new/updated servers and unregister removed servers
@@ -508,6 +753,7 @@ func (p *Proxy) Unregister(info ServerInfo) bool {
 	}
 	delete(p.servers, name)
 	delete(p.configServers, name) // Clean up config tracking
+	delete(p.discovered, name)
 
 	p.log.Info("unregistered backend server",
 		"name", info.Name(), "addr", info.Addr())
@@ -577,6 +823,7 @@ func (p *Proxy) listenAndServe(ctx context.Context, addr string) error {
 // that has not had any I/O performed on it yet.
 func (p *Proxy) HandleConn(raw net.Conn) {
 	if p.connectionsQuota != nil && p.connectionsQuota.Blocked(netutil.Host(raw.RemoteAddr())) {
+		recordQuotaRejection("connections")
 		p.log.Info("connection exceeded rate limit, closed", "remoteAddr", raw.RemoteAddr())
 		_ = raw.Close()
 		return
@@ -613,6 +860,11 @@ func (p *Proxy) HandleConn(raw net.Conn) {
 		raw = e.Connection()
 	}
 
+	// Lite mode pipes the raw connection and records transferred bytes itself.
+	if !p.cfg.Lite.Enabled {
+		raw = countConn(raw, "client")
+	}
+
 	// Create client connection
 	conn, readLoop := netmc.NewMinecraftConn(
 		ctx, raw, proto.ServerBound,
@@ -620,6 +872,8 @@ func (p *Proxy) HandleConn(raw net.Conn) {
 		time.Duration(p.cfg.ConnectionTimeout)*time.Millisecond,
 		p.cfg.Compression.Level,
 	)
+	// Intercept the handshake, status and login packets until the client becomes a player.
+	conn.SetPacketInterceptor(p.packetInterceptor(conn, nil, nil))
 	conn.SetActiveSessionHandler(state.Handshake, newHandshakeSessionHandler(conn, &sessionHandlerDeps{
 		proxy:          p,
 		registrar:      p,
@@ -0,0 +1,83 @@
+package proxy
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"strings"
+
+	"go.minekube.com/common/minecraft/component/codec/legacy"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/query"
+	"go.minekube.com/gate/pkg/version"
+)
+
+// queryAddr returns the UDP address the query server should listen on.
+// It uses the host of the proxy bind address with the configured query port.
+func queryAddr(cfg *config.Config) string {
+	host, _, err := net.SplitHostPort(cfg.Bind)
+	if err != nil {
+		host = ""
+	}
+	return net.JoinHostPort(host, strconv.Itoa(cfg.Query.Port))
+}
+
+// queryEnabled reports whether the query server should be running.
+func queryEnabled(cfg *config.Config) bool {
+	return cfg.Query.Enabled && !cfg.Lite.Enabled
+}
+
+// serveQuery runs the query server until the context is canceled.
+func (p *Proxy) serveQuery(ctx context.Context, addr string) {
+	p.log.Info("listening for query requests", "addr", addr)
+	defer p.log.Info("stopped listening for query requests", "addr", addr)
+	srv := &query.Server{Response: p.queryResponse}
+	if err := srv.ListenAndServe(ctx, addr); err != nil && ctx.Err() == nil {
+		p.log.Error(err, "error serving query requests", "addr", addr)
+	}
+}
+
+// queryResponse builds the response to a query stat request and
+// fires the QueryEvent to let subscribers modify it.
+func (p *Proxy) queryResponse(_ context.Context, addr net.Addr, typ query.Type) *query.Response {
+	cfg := p.config()
+
+	motd := new(strings.Builder)
+	if m := cfg.Status.Motd.T(); m != nil {
+		if err := (&legacy.Legacy{}).Marshal(motd, m); err != nil {
+			p.log.V(1).Error(err, "error marshal motd to legacy format for query response")
+		}
+	}
+
+	host, port, _ := net.SplitHostPort(cfg.Bind)
+	hostPort, _ := strconv.ParseUint(port, 10, 16)
+	if host == "" {
+		host = "0.0.0.0"
+	}
+
+	res := &query.Response{
+		MOTD:           motd.String(),
+		Map:            "Gate",
+		Version:        versionName,
+		ProxyVersion:   "Gate " + version.String(),
+		CurrentPlayers: p.PlayerCount(),
+		MaxPlayers:     cfg.Status.ShowMaxPlayers,
+		HostPort:       uint16(hostPort),
+		HostIP:         host,
+	}
+	if typ == query.FullType {
+		for _, player := range p.Players() {
+			res.Players = append(res.Players, player.Username())
+		}
+		if cfg.Query.ShowPlugins {
+			for _, pl := range Plugins {
+				res.Plugins = append(res.Plugins, query.Plugin{Name: pl.Name})
+			}
+		}
+	}
+
+	e := &QueryEvent{addr: addr, typ: typ, response: res}
+	p.event.Fire(e)
+	return e.Response()
+}
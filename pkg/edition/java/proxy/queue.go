@@ -0,0 +1,446 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	. "go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/edition/java/bossbar"
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet"
+	"go.minekube.com/gate/pkg/edition/java/proto/state/states"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+// queueBypassPermission allows players to skip the queue.
+const queueBypassPermission = "gate.queue.bypass"
+
+// ErrQueueDisabled is returned when managing the queue while it is disabled in the config.
+var ErrQueueDisabled = errors.New("queue is disabled")
+
+// Queue holds players that try to connect to a full or unavailable server
+// and connects them in order of their priority as soon as slots open.
+// Players are held on their current server or, when joining the proxy, in the login phase.
+//
+// Servers are checked for free slots by pinging them every configured interval,
+// a server that can't be pinged is considered unavailable, e.g. while restarting.
+type Queue struct {
+	proxy *Proxy
+
+	mu      sync.Mutex
+	servers map[string]*serverQueue // by lowercase server name
+	players map[uuid.UUID]*queueEntry
+}
+
+type serverQueue struct {
+	entries []*queueEntry // sorted by priority, then by join time
+	checked time.Time     // time of the last status check
+	down    bool          // whether the last status check failed
+	free    int           // free slots reported by the last status check, -1 = unknown
+}
+
+type queueEntry struct {
+	player     *connectedPlayer
+	server     RegisteredServer
+	priority   int
+	connecting bool            // whether the queue is connecting the player
+	bar        bossbar.BossBar // nil-able
+}
+
+func newQueue(proxy *Proxy) *Queue {
+	return &Queue{
+		proxy:   proxy,
+		servers: map[string]*serverQueue{},
+		players: map[uuid.UUID]*queueEntry{},
+	}
+}
+
+// Queue returns the queue of players waiting to connect to full or unavailable servers.
+func (p *Proxy) Queue() *Queue {
+	return p.queue
+}
+
+// Enabled returns true if the queue is enabled in the config.
+func (q *Queue) Enabled() bool {
+	return q.proxy.config().Queue.Enabled
+}
+
+// Servers returns the servers that have queued players.
+func (q *Queue) Servers() []RegisteredServer {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	servers := make([]RegisteredServer, 0, len(q.servers))
+	for _, sq := range q.servers {
+		if len(sq.entries) != 0 {
+			servers = append(servers, sq.entries[0].server)
+		}
+	}
+	return servers
+}
+
+// Position returns the server the player is queued for and the player's position in the queue starting at 1.
+// Returns nil and 0 if the player is not queued.
+func (q *Queue) Position(player Player) (server RegisteredServer, position int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	e, ok := q.players[player.ID()]
+	if !ok {
+		return nil, 0
+	}
+	return e.server, q.position(e)
+}
+
+// Players returns the players queued for the server in queue order.
+func (q *Queue) Players(server RegisteredServer) []Player {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	sq := q.servers[queueKey(server)]
+	if sq == nil {
+		return nil
+	}
+	players := make([]Player, 0, len(sq.entries))
+	for _, e := range sq.entries {
+		players = append(players, e.player)
+	}
+	return players
+}
+
+// Add queues the player for the server and returns the player's position in the queue.
+// A player can only be queued for one server at a time and is moved from any other queue.
+func (q *Queue) Add(player Player, server RegisteredServer) (position int) {
+	p, ok := player.(*connectedPlayer)
+	if !ok {
+		return 0
+	}
+	cfg := q.proxy.config().Queue
+	priority := queuePriority(&cfg, p)
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.add(p, server, priority)
+}
+
+// Remove removes the player from the queue and returns true if the player was queued.
+func (q *Queue) Remove(player Player) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	e, ok := q.players[player.ID()]
+	if ok {
+		q.remove(e)
+	}
+	return ok
+}
+
+// add queues the player while q.mu is held.
+func (q *Queue) add(player *connectedPlayer, server RegisteredServer, priority int) int {
+	if e, ok := q.players[player.ID()]; ok {
+		if RegisteredServerEqual(e.server, server) {
+			return q.position(e)
+		}
+		q.remove(e)
+	}
+	key := queueKey(server)
+	sq := q.servers[key]
+	if sq == nil {
+		sq = &serverQueue{free: -1}
+		q.servers[key] = sq
+	}
+	e := &queueEntry{player: player, server: server, priority: priority}
+	// Insert after all players with the same or a higher priority
+	i := sort.Search(len(sq.entries), func(i int) bool {
+		return sq.entries[i].priority < priority
+	})
+	sq.entries = slices.Insert(sq.entries, i, e)
+	q.players[player.ID()] = e
+	return i + 1
+}
+
+// remove removes the entry while q.mu is held.
+func (q *Queue) remove(e *queueEntry) {
+	if q.players[e.player.ID()] != e {
+		return
+	}
+	delete(q.players, e.player.ID())
+	if sq := q.servers[queueKey(e.server)]; sq != nil {
+		sq.entries = slices.DeleteFunc(sq.entries, func(o *queueEntry) bool { return o == e })
+	}
+	if e.bar != nil {
+		bar := e.bar
+		go func() { _ = bar.RemoveViewer(e.player) }()
+	}
+}
+
+// position returns the position of the entry while q.mu is held.
+func (q *Queue) position(e *queueEntry) int {
+	if sq := q.servers[queueKey(e.server)]; sq != nil {
+		return slices.Index(sq.entries, e) + 1
+	}
+	return 0
+}
+
+// handlePreConnect queues players connecting to a queued server that
+// is full or unavailable or already has players waiting.
+func (q *Queue) handlePreConnect(e *ServerPreConnectEvent) {
+	cfg := q.proxy.config().Queue
+	if !cfg.Enabled || !e.Allowed() || !queuedServer(&cfg, e.Server()) {
+		return
+	}
+	player, ok := e.Player().(*connectedPlayer)
+	if !ok || player.HasPermission(queueBypassPermission) {
+		return
+	}
+	priority := queuePriority(&cfg, player)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if entry, ok := q.players[player.ID()]; ok && entry.connecting &&
+		RegisteredServerEqual(entry.server, e.Server()) {
+		return // connected by the queue
+	}
+	if sq := q.servers[queueKey(e.Server())]; sq == nil || len(sq.entries) == 0 {
+		// Allow if the server was not checked recently or has free slots
+		if sq == nil || time.Since(sq.checked) > 2*time.Duration(cfg.Interval) {
+			return
+		}
+		if !sq.down && sq.free != 0 {
+			if sq.free > 0 {
+				sq.free--
+			}
+			return
+		}
+	}
+
+	e.Deny()
+	position := q.add(player, e.Server(), priority)
+	if player.CurrentServer() != nil {
+		go func() { _ = player.SendMessage(queueJoinedMsg(e.Server(), position)) }()
+	}
+}
+
+func (q *Queue) handleDisconnect(e *DisconnectEvent) {
+	q.Remove(e.Player())
+}
+
+// run checks queued servers and connects queued players every configured interval until the context is canceled.
+func (q *Queue) run(ctx context.Context) {
+	for {
+		interval := time.Duration(q.proxy.config().Queue.Interval)
+		if interval <= 0 {
+			interval = 2 * time.Second
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+		q.tick(ctx)
+	}
+}
+
+func (q *Queue) tick(ctx context.Context) {
+	type check struct {
+		sq     *serverQueue
+		server RegisteredServer
+		player *connectedPlayer // the first queued player
+	}
+	var checks []check
+	q.mu.Lock()
+	for _, e := range q.players {
+		if !e.player.Active() {
+			q.remove(e)
+		}
+	}
+	for key, sq := range q.servers {
+		if len(sq.entries) == 0 {
+			continue
+		}
+		server := sq.entries[0].server
+		if q.proxy.Server(server.ServerInfo().Name()) == nil {
+			// Server was unregistered
+			for _, e := range slices.Clone(sq.entries) {
+				q.remove(e)
+				if e.player.CurrentServer() == nil {
+					go e.player.Disconnect(noAvailableServers)
+				}
+			}
+			delete(q.servers, key)
+			continue
+		}
+		checks = append(checks, check{sq: sq, server: server, player: sq.entries[0].player})
+	}
+	q.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, c := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			free, err := q.check(ctx, c.server, c.player)
+			q.mu.Lock()
+			defer q.mu.Unlock()
+			c.sq.checked = time.Now()
+			c.sq.down = err != nil
+			c.sq.free = free
+			if err != nil {
+				q.proxy.log.V(1).Info("queued server is unavailable",
+					"server", c.server.ServerInfo().Name(), "error", err)
+				return
+			}
+			if free < 0 {
+				free = 1 // unknown, connect one player at a time
+			}
+			for _, e := range c.sq.entries {
+				if free <= 0 {
+					break
+				}
+				free--
+				if !e.connecting {
+					e.connecting = true
+					go q.connect(ctx, e)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	q.display()
+}
+
+// check pings the server and returns the number of free slots, -1 if unknown.
+func (q *Queue) check(ctx context.Context, server RegisteredServer, player *connectedPlayer) (free int, err error) {
+	res, err := q.proxy.fetchServerPing(ctx, q.proxy.log, player.RemoteAddr(),
+		server.ServerInfo().Addr(), player.Protocol())
+	if err != nil {
+		return 0, err
+	}
+	if res.Players == nil {
+		return -1, nil
+	}
+	return max(res.Players.Max-res.Players.Online, 0), nil
+}
+
+// connect connects the queued player to the server and removes the player from the queue
+// unless the server is unreachable or the player is connecting to another server.
+func (q *Queue) connect(ctx context.Context, e *queueEntry) {
+	ctx, cancel := withConnectionTimeout(ctx, q.proxy.config())
+	defer cancel()
+	result, err := e.player.createConnectionRequest(e.server).connect(ctx)
+
+	q.mu.Lock()
+	e.connecting = false
+	if err != nil || result.Status().ConnectionInProgress() {
+		if err != nil {
+			if sq := q.servers[queueKey(e.server)]; sq != nil {
+				sq.down = true
+			}
+		}
+		q.mu.Unlock()
+		return // try again later
+	}
+	q.remove(e)
+	q.mu.Unlock()
+
+	if result.Status().ServerDisconnected() {
+		reason := result.Reason()
+		if reason == nil {
+			reason = internalServerConnectionError
+		}
+		e.player.handleDisconnectWithReason(e.server, reason, result.safe)
+	}
+}
+
+// display shows queued players their position and keeps
+// players without a server connection in the login phase alive.
+func (q *Queue) display() {
+	mode := q.proxy.config().Queue.Display
+	var updates []func()
+	q.mu.Lock()
+	for _, sq := range q.servers {
+		for i, e := range sq.entries {
+			player := e.player
+			if player.CurrentServer() == nil {
+				if s := player.State().State; s == states.ConfigState || s == states.PlayState {
+					updates = append(updates, func() {
+						_ = player.WritePacket(&packet.KeepAlive{RandomID: time.Now().UnixNano()})
+					})
+				}
+				continue
+			}
+			msg := queuePositionMsg(e.server, i+1, len(sq.entries))
+			switch mode {
+			case config.BossBarQueueDisplay:
+				percent := 1 - float32(i)/float32(len(sq.entries))
+				if e.bar == nil {
+					bar := bossbar.New(msg, percent, bossbar.GreenColor, bossbar.ProgressOverlay)
+					e.bar = bar
+					updates = append(updates, func() { _ = bar.AddViewer(player) })
+				} else {
+					bar := e.bar
+					updates = append(updates, func() {
+						bar.SetName(msg)
+						bar.SetPercent(percent)
+					})
+				}
+			case config.NoneQueueDisplay:
+			default:
+				updates = append(updates, func() { _ = player.SendActionBar(msg) })
+			}
+		}
+	}
+	q.mu.Unlock()
+	for _, update := range updates {
+		update()
+	}
+}
+
+func queueKey(server RegisteredServer) string {
+	return strings.ToLower(server.ServerInfo().Name())
+}
+
+// queuedServer returns true if players are queued for the server.
+func queuedServer(cfg *config.Queue, server RegisteredServer) bool {
+	if server == nil {
+		return false
+	}
+	if len(cfg.Servers) == 0 {
+		return true
+	}
+	name := server.ServerInfo().Name()
+	return slices.ContainsFunc(cfg.Servers, func(s string) bool { return strings.EqualFold(s, name) })
+}
+
+// queuePriority returns the highest priority of the permissions the player has.
+func queuePriority(cfg *config.Queue, player Player) (priority int) {
+	for perm, p := range cfg.Priorities {
+		if p > priority && player.HasPermission(perm) {
+			priority = p
+		}
+	}
+	return priority
+}
+
+func queueJoinedMsg(server RegisteredServer, position int) Component {
+	return &Text{S: Style{Color: Yellow}, Extra: []Component{
+		&Text{Content: fmt.Sprintf("%s is full or unavailable, you were placed in its queue at position ",
+			server.ServerInfo().Name())},
+		&Text{Content: fmt.Sprint(position), S: Style{Color: Green}},
+		&Text{Content: ". Use "},
+		&Text{Content: "/queue leave", S: Style{Color: White}},
+		&Text{Content: " to leave the queue."},
+	}}
+}
+
+func queuePositionMsg(server RegisteredServer, position, total int) Component {
+	return &Text{S: Style{Color: Yellow}, Extra: []Component{
+		&Text{Content: fmt.Sprintf("Queue for %s: ", server.ServerInfo().Name())},
+		&Text{Content: fmt.Sprint(position), S: Style{Color: Green}},
+		&Text{Content: fmt.Sprintf("/%d", total), S: Style{Color: Gray}},
+	}}
+}
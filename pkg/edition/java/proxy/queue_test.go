@@ -0,0 +1,46 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/profile"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+func TestQueueOrder(t *testing.T) {
+	q := newQueue(&Proxy{})
+	server := newRegisteredServer(NewServerInfo("lobby", mustParseAddr("localhost:25565")))
+	newPlayer := func(name string) *connectedPlayer {
+		return &connectedPlayer{profile: &profile.GameProfile{ID: uuid.New(), Name: name}}
+	}
+	a, b, vip := newPlayer("a"), newPlayer("b"), newPlayer("vip")
+
+	require.Equal(t, 1, q.add(a, server, 0))
+	require.Equal(t, 2, q.add(b, server, 0))
+	require.Equal(t, 1, q.add(vip, server, 10))
+	require.Equal(t, 3, q.add(b, server, 0), "re-adding keeps the position")
+
+	_, position := q.Position(a)
+	require.Equal(t, 2, position)
+	require.Equal(t, []Player{vip, a, b}, q.Players(server))
+
+	require.True(t, q.Remove(vip))
+	require.False(t, q.Remove(vip))
+	_, position = q.Position(b)
+	require.Equal(t, 2, position)
+
+	other := newRegisteredServer(NewServerInfo("survival", mustParseAddr("localhost:25566")))
+	require.Equal(t, 1, q.add(b, other, 0), "moves the player to the other queue")
+	require.Equal(t, []Player{a}, q.Players(server))
+}
+
+func TestQueuedServer(t *testing.T) {
+	lobby := newRegisteredServer(NewServerInfo("Lobby", mustParseAddr("localhost:25565")))
+	require.True(t, queuedServer(&config.Queue{}, lobby))
+	require.True(t, queuedServer(&config.Queue{Servers: []string{"lobby"}}, lobby))
+	require.False(t, queuedServer(&config.Queue{Servers: []string{"survival"}}, lobby))
+	require.False(t, queuedServer(&config.Queue{}, nil))
+}
@@ -0,0 +1,84 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+
+	"go.minekube.com/brigodier"
+	"go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/component/codec/legacy"
+
+	"go.minekube.com/gate/pkg/command"
+	"go.minekube.com/gate/pkg/edition/java/rcon"
+	"go.minekube.com/gate/pkg/util/permission"
+)
+
+// serveRcon runs the RCON server on the listener until the context is canceled.
+func (p *Proxy) serveRcon(ctx context.Context, ln net.Listener, srv *rcon.Server) {
+	addr := ln.Addr().String()
+	p.log.Info("listening for rcon connections", "addr", addr)
+	defer p.log.Info("stopped listening for rcon connections", "addr", addr)
+	if err := srv.Serve(ctx, ln); err != nil && ctx.Err() == nil {
+		p.log.Error(err, "error serving rcon connections", "addr", addr)
+	}
+}
+
+// rconExecute runs the command as a new rconSource and returns its output.
+func (p *Proxy) rconExecute(ctx context.Context, addr net.Addr, commandline string) string {
+	p.log.Info("executing rcon command", "remoteAddr", addr, "command", commandline)
+	src := &rconSource{}
+	err := p.ExecuteCommand(ctx, src, commandline)
+	if err != nil {
+		var sErr *brigodier.CommandSyntaxError
+		switch {
+		case errors.Is(err, brigodier.ErrDispatcherUnknownCommand):
+			src.print("Unknown command.")
+		case errors.As(err, &sErr):
+			src.print(sErr.Error())
+		default:
+			p.log.Error(err, "error while running rcon command", "command", commandline)
+			src.print("An error occurred while running this command.")
+		}
+	}
+	return src.output()
+}
+
+// rconSource is the command source of an RCON command having all permissions.
+// Messages sent to it are collected as plain text to respond with.
+type rconSource struct {
+	mu  sync.Mutex
+	out strings.Builder
+}
+
+var _ command.Source = (*rconSource)(nil)
+
+func (s *rconSource) HasPermission(string) bool { return true }
+func (s *rconSource) PermissionValue(string) permission.TriState {
+	return permission.True
+}
+
+func (s *rconSource) SendMessage(msg component.Component, _ ...command.MessageOption) error {
+	b := new(strings.Builder)
+	if err := (&legacy.Legacy{}).Marshal(b, msg); err != nil {
+		return err
+	}
+	s.print(stripLegacyCodes(b.String()))
+	return nil
+}
+
+func (s *rconSource) print(line string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.out.WriteString(line)
+	s.out.WriteByte('\n')
+}
+
+// output returns the collected messages.
+func (s *rconSource) output() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return strings.TrimSuffix(s.out.String(), "\n")
+}
@@ -0,0 +1,134 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/go-logr/logr"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/packhost"
+	"go.minekube.com/gate/pkg/edition/java/proxy/internal/resourcepack"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+// resourcePackUpdatesPermission opts players in to receive hosted resource packs
+// again when the pack file is updated.
+const resourcePackUpdatesPermission = "gate.resourcepack.updates"
+
+// ErrResourcePackHostDisabled is returned by Proxy.HostedResourcePack if the resource pack host is disabled.
+var ErrResourcePackHostDisabled = errors.New("resource pack host is disabled")
+
+// HostedResourcePack returns the info to send the pack with the file name
+// from the directory of the resource pack host to the player with Player.SendResourcePack.
+// The download URL of the info is only valid for the player while they are online.
+//
+// Returns ErrResourcePackHostDisabled if the host is disabled in the config.
+func (p *Proxy) HostedResourcePack(player Player, name string) (*ResourcePackInfo, error) {
+	host := p.packHost.Load()
+	if host == nil {
+		return nil, ErrResourcePackHostDisabled
+	}
+	if player == nil {
+		return nil, errors.New("player must not be nil")
+	}
+	pack, ok := host.Pack(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", packhost.ErrNotFound, name)
+	}
+	u, err := host.URL(player.ID(), name)
+	if err != nil {
+		return nil, err
+	}
+	return &ResourcePackInfo{
+		ID:     hostedResourcePackID(name),
+		URL:    u,
+		Hash:   pack.Hash,
+		Origin: PluginOnProxyResourcePackOrigin,
+	}, nil
+}
+
+// hostedResourcePackID returns the stable ID of the hosted pack, the same for all players.
+func hostedResourcePackID(name string) uuid.UUID {
+	return resourcepack.IDForURL("hosted:" + name)
+}
+
+// startResourcePackHost creates the resource pack host and serves packs until the context is canceled.
+func (p *Proxy) startResourcePackHost(ctx context.Context, cfg config.ResourcePackHost) {
+	log := p.log.WithName("resourcePackHost").WithValues("directory", cfg.Directory)
+	if err := os.MkdirAll(cfg.Directory, 0o755); err != nil {
+		log.Error(err, "could not create resource pack directory")
+		return
+	}
+	host, err := packhost.New(cfg.Directory, cfg.PublicURL, func(id uuid.UUID) bool {
+		return p.Player(id) != nil
+	})
+	if err != nil {
+		log.Error(err, "could not load resource packs")
+		return
+	}
+	p.packHost.Store(host)
+	defer p.packHost.CompareAndSwap(host, nil)
+
+	ctx = logr.NewContext(ctx, log)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		err := host.Watch(ctx, func(modified []packhost.Pack) {
+			for _, pack := range modified {
+				p.pushUpdatedResourcePack(host, pack)
+			}
+		})
+		if err != nil {
+			log.Error(err, "error watching resource packs")
+		}
+	}()
+
+	log.Info("serving resource packs", "bind", cfg.Bind, "packs", len(host.Packs()))
+	defer log.Info("stopped serving resource packs", "bind", cfg.Bind)
+	if err = host.ListenAndServe(ctx, cfg.Bind); err != nil && ctx.Err() == nil {
+		log.Error(err, "error serving resource packs", "bind", cfg.Bind)
+	}
+	wg.Wait()
+}
+
+// pushUpdatedResourcePack sends the updated pack to opted-in players that have it applied or pending.
+func (p *Proxy) pushUpdatedResourcePack(host *packhost.Host, pack packhost.Pack) {
+	id := hostedResourcePackID(pack.Name)
+	for _, player := range p.Players() {
+		cp, ok := player.(*connectedPlayer)
+		if !ok || !cp.HasPermission(resourcePackUpdatesPermission) {
+			continue
+		}
+		var sent *ResourcePackInfo
+		for _, info := range append(cp.AppliedResourcePacks(), cp.PendingResourcePacks()...) {
+			if info.ID == id {
+				sent = info
+				break
+			}
+		}
+		if sent == nil {
+			continue
+		}
+		u, err := host.URL(cp.ID(), pack.Name)
+		if err != nil {
+			continue // removed in the meantime
+		}
+		info := *sent
+		info.URL = u
+		info.Hash = pack.Hash
+		go func() {
+			log := p.log.WithValues("player", cp.Username(), "pack", pack.Name)
+			if err := cp.removeResourcePacks(id); err != nil {
+				log.V(1).Info("could not remove outdated resource pack", "error", err)
+			}
+			if err := cp.SendResourcePack(info); err != nil {
+				log.V(1).Info("could not send updated resource pack", "error", err)
+			}
+		}()
+	}
+}
@@ -0,0 +1,223 @@
+package proxy
+
+import (
+	"context"
+	"encoding/hex"
+
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/proxy/internal/resourcepack"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+// resourcePacks sends the resource packs of the "resourcePacks" config to players.
+// Global packs are sent when joining the proxy and server packs when connecting to the server.
+// Server packs are removed again when switching to another server on 1.20.3+ clients,
+// older clients only keep the last pack sent.
+type resourcePacks struct {
+	proxy  *Proxy
+	hasher resourcepack.Hasher
+}
+
+func newResourcePacks(proxy *Proxy) *resourcePacks {
+	return &resourcePacks{proxy: proxy}
+}
+
+// prepare computes the hashes of all configured packs in advance,
+// so players don't have to wait for packs to be downloaded and hashed.
+func (r *resourcePacks) prepare(ctx context.Context, cfg *config.ResourcePacks) {
+	for _, pack := range allResourcePacks(cfg) {
+		if pack.Hosted != "" {
+			continue // hashed by the host
+		}
+		if _, err := r.info(ctx, nil, &pack); err != nil && ctx.Err() == nil {
+			r.proxy.log.Error(err, "failed to prepare resource pack", "pack", pack.URL)
+		}
+	}
+}
+
+// info returns the info of the configured pack for the player and computes its hash if not configured.
+// The player may only be nil for packs that are not hosted.
+func (r *resourcePacks) info(ctx context.Context, player Player, pack *config.ResourcePack) (*ResourcePackInfo, error) {
+	var (
+		info *ResourcePackInfo
+		err  error
+	)
+	if pack.Hosted != "" {
+		info, err = r.proxy.HostedResourcePack(player, pack.Hosted)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		info = &ResourcePackInfo{
+			ID:     resourcePackID(pack),
+			URL:    pack.URL,
+			Origin: PluginOnProxyResourcePackOrigin,
+		}
+	}
+	info.ShouldForce = pack.Force
+	if pack.Prompt != nil {
+		info.Prompt = pack.Prompt.T()
+	}
+	switch {
+	case pack.Hosted != "":
+		// hashed by the host
+	case pack.Hash != "":
+		info.Hash, err = hex.DecodeString(pack.Hash)
+	case pack.File != "":
+		info.Hash, err = r.hasher.File(pack.File)
+	default:
+		info.Hash, err = r.hasher.URL(ctx, pack.URL)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// handlePostConnect sends the global packs to players joining the proxy,
+// and replaces the packs of the previous server with the packs of the new server.
+func (r *resourcePacks) handlePostConnect(e *ServerPostConnectEvent) {
+	player, ok := e.Player().(*connectedPlayer)
+	if !ok {
+		return
+	}
+	current := player.CurrentServer()
+	if current == nil {
+		return
+	}
+	cfg := &r.proxy.config().ResourcePacks
+
+	var previous, send []config.ResourcePack
+	if e.PreviousServer() == nil {
+		send = append(send, cfg.Global...)
+	} else {
+		previous = cfg.Packs(e.PreviousServer().ServerInfo().Name())
+	}
+	packs := cfg.Packs(current.Server().ServerInfo().Name())
+
+	var remove []uuid.UUID
+	for _, pack := range previous {
+		if !containsResourcePack(packs, &pack) && !containsResourcePack(cfg.Global, &pack) {
+			remove = append(remove, resourcePackID(&pack))
+		}
+	}
+	for _, pack := range packs {
+		if !containsResourcePack(previous, &pack) {
+			send = append(send, pack)
+		}
+	}
+	if len(remove) == 0 && len(send) == 0 {
+		return
+	}
+	// Don't block the connection while packs are hashed
+	go r.apply(player, remove, send)
+}
+
+func (r *resourcePacks) apply(player *connectedPlayer, remove []uuid.UUID, send []config.ResourcePack) {
+	log := r.proxy.log.WithValues("player", player.Username())
+	if err := player.removeResourcePacks(remove...); err != nil {
+		log.V(1).Info("could not remove resource packs", "error", err)
+	}
+	for i := range send {
+		info, err := r.info(player.Context(), player, &send[i])
+		if err != nil {
+			if player.Context().Err() == nil {
+				log.Error(err, "failed to prepare resource pack", "pack", resourcePackName(&send[i]))
+			}
+			continue
+		}
+		if err = player.SendResourcePack(*info); err != nil {
+			log.V(1).Info("could not send resource pack", "url", info.URL, "error", err)
+		}
+	}
+}
+
+// handleStatus runs the configured decline action when a player declines a pack sent by the proxy.
+func (r *resourcePacks) handleStatus(e *PlayerResourcePackStatusEvent) {
+	info := e.PackInfo()
+	if e.Status() != resourcepack.DeclinedResponseStatus || info.Origin != PluginOnProxyResourcePackOrigin {
+		return
+	}
+	pack := r.pack(info.ID)
+	if pack == nil {
+		return // sent by a plugin
+	}
+	player, ok := r.proxy.Player(e.PlayerID()).(*connectedPlayer)
+	if !ok {
+		return
+	}
+	switch pack.OnDecline {
+	case config.KickResourcePackDeclineAction:
+		e.SetOverwriteKick(true)
+		var reason component.Component = &component.Translation{
+			Key: "multiplayer.requiredTexturePrompt.disconnect",
+		}
+		if pack.DeclineMessage != nil {
+			reason = pack.DeclineMessage.T()
+		}
+		player.Disconnect(reason)
+	case config.RedirectResourcePackDeclineAction:
+		e.SetOverwriteKick(true)
+		go r.redirect(player, pack.RedirectServer)
+	}
+}
+
+// redirect connects the player to the server or server group.
+func (r *resourcePacks) redirect(player *connectedPlayer, name string) {
+	server := r.proxy.PickServer(name)
+	if server == nil {
+		r.proxy.log.Info("resource pack redirect server not found",
+			"player", player.Username(), "server", name)
+		return
+	}
+	if current := player.CurrentServer(); current != nil && RegisteredServerEqual(current.Server(), server) {
+		return
+	}
+	ctx, cancel := withConnectionTimeout(player.Context(), r.proxy.config())
+	defer cancel()
+	player.CreateConnectionRequest(server).ConnectWithIndication(ctx)
+}
+
+// pack returns the configured pack with the id or nil if not found.
+func (r *resourcePacks) pack(id uuid.UUID) *config.ResourcePack {
+	for _, pack := range allResourcePacks(&r.proxy.config().ResourcePacks) {
+		if resourcePackID(&pack) == id {
+			return &pack
+		}
+	}
+	return nil
+}
+
+func allResourcePacks(cfg *config.ResourcePacks) []config.ResourcePack {
+	all := append([]config.ResourcePack{}, cfg.Global...)
+	for _, packs := range cfg.Servers {
+		all = append(all, packs...)
+	}
+	return all
+}
+
+func containsResourcePack(packs []config.ResourcePack, pack *config.ResourcePack) bool {
+	for i := range packs {
+		if packs[i].URL == pack.URL && packs[i].Hosted == pack.Hosted {
+			return true
+		}
+	}
+	return false
+}
+
+// resourcePackID returns the stable ID of the configured pack.
+func resourcePackID(pack *config.ResourcePack) uuid.UUID {
+	if pack.Hosted != "" {
+		return hostedResourcePackID(pack.Hosted)
+	}
+	return resourcepack.IDForURL(pack.URL)
+}
+
+func resourcePackName(pack *config.ResourcePack) string {
+	if pack.Hosted != "" {
+		return pack.Hosted
+	}
+	return pack.URL
+}
@@ -0,0 +1,98 @@
+package proxy
+
+import (
+	"context"
+	"crypto/sha1"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/packhost"
+	"go.minekube.com/gate/pkg/edition/java/profile"
+	"go.minekube.com/gate/pkg/edition/java/proxy/internal/resourcepack"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+func TestResourcePacks_Info(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "pack.zip")
+	require.NoError(t, os.WriteFile(file, []byte("pack"), 0o644))
+
+	p := createTestProxy(t, map[string]string{"lobby": "localhost:25566"})
+	r := newResourcePacks(p)
+
+	info, err := r.info(context.Background(), nil, &config.ResourcePack{
+		URL:   "https://example.com/pack.zip",
+		File:  file,
+		Force: true,
+	})
+	require.NoError(t, err)
+	want := sha1.Sum([]byte("pack"))
+	require.Equal(t, resourcepack.Hash(want[:]), info.Hash)
+	require.Equal(t, resourcepack.IDForURL("https://example.com/pack.zip"), info.ID)
+	require.Equal(t, PluginOnProxyResourcePackOrigin, info.Origin)
+	require.True(t, info.ShouldForce)
+	require.Nil(t, info.Prompt)
+
+	info, err = r.info(context.Background(), nil, &config.ResourcePack{
+		URL:  "https://example.com/other.zip",
+		Hash: "2ef7bde608ce5404e97d5f042f95f89f1c232871",
+	})
+	require.NoError(t, err)
+	require.Len(t, info.Hash, sha1.Size)
+}
+
+func TestResourcePacks_Pack(t *testing.T) {
+	p := createTestProxy(t, map[string]string{"lobby": "localhost:25566"})
+	p.cfg.ResourcePacks = config.ResourcePacks{
+		Global: []config.ResourcePack{{URL: "https://example.com/global.zip"}},
+		Servers: map[string][]config.ResourcePack{
+			"lobby": {{
+				URL:            "https://example.com/lobby.zip",
+				OnDecline:      config.RedirectResourcePackDeclineAction,
+				RedirectServer: "hub",
+			}},
+		},
+	}
+	r := newResourcePacks(p)
+
+	pack := r.pack(resourcepack.IDForURL("https://example.com/lobby.zip"))
+	require.NotNil(t, pack)
+	require.Equal(t, "hub", pack.RedirectServer)
+	require.NotNil(t, r.pack(resourcepack.IDForURL("https://example.com/global.zip")))
+	require.Nil(t, r.pack(resourcepack.IDForURL("https://example.com/plugin.zip")))
+}
+
+func TestHostedResourcePack(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "lobby.zip"), []byte("lobby"), 0o644))
+
+	p := createTestProxy(t, map[string]string{"lobby": "localhost:25566"})
+	player := &connectedPlayer{profile: &profile.GameProfile{ID: uuid.New(), Name: "Steve"}}
+
+	_, err := p.HostedResourcePack(player, "lobby.zip")
+	require.ErrorIs(t, err, ErrResourcePackHostDisabled)
+
+	host, err := packhost.New(dir, "http://example.com:25590", nil)
+	require.NoError(t, err)
+	p.packHost.Store(host)
+
+	info, err := p.HostedResourcePack(player, "lobby.zip")
+	require.NoError(t, err)
+	want := sha1.Sum([]byte("lobby"))
+	require.Equal(t, resourcepack.Hash(want[:]), info.Hash)
+	require.Equal(t, hostedResourcePackID("lobby.zip"), info.ID)
+	require.Contains(t, info.URL, player.ID().Undashed())
+
+	_, err = p.HostedResourcePack(player, "missing.zip")
+	require.ErrorIs(t, err, packhost.ErrNotFound)
+
+	// Hosted packs of the config are resolved per player
+	r := newResourcePacks(p)
+	cfgInfo, err := r.info(context.Background(), player, &config.ResourcePack{Hosted: "lobby.zip", Force: true})
+	require.NoError(t, err)
+	require.Equal(t, info.URL, cfgInfo.URL)
+	require.True(t, cfgInfo.ShouldForce)
+}
@@ -163,6 +163,18 @@ type RegisteredServer interface {
 	Players() Players // The players connected to the server on THIS proxy.
 }
 
+// ServerHealthReporter is implemented by the RegisteredServers of the proxy
+// and reports the health determined by health checks, if enabled.
+//
+// Use a type assertion to get the health of a RegisteredServer:
+//
+//	if h, ok := server.(ServerHealthReporter); ok {
+//		health := h.Health()
+//	}
+type ServerHealthReporter interface {
+	Health() ServerHealth
+}
+
 // RegisteredServerEqual returns true if RegisteredServer a and b are equal.
 // They are never equal if one of them is nil.
 func RegisteredServerEqual(a, b RegisteredServer) bool {
@@ -172,6 +184,7 @@ func RegisteredServerEqual(a, b RegisteredServer) bool {
 type registeredServer struct {
 	info    ServerInfo
 	players *players
+	health  serverHealth
 }
 
 func newRegisteredServer(info ServerInfo) *registeredServer {
@@ -186,7 +199,14 @@ func (r *registeredServer) Players() Players {
 	return r.players
 }
 
-var _ RegisteredServer = (*registeredServer)(nil)
+func (r *registeredServer) Health() ServerHealth {
+	return r.health.get()
+}
+
+var (
+	_ RegisteredServer     = (*registeredServer)(nil)
+	_ ServerHealthReporter = (*registeredServer)(nil)
+)
 
 // BroadcastPluginMessage sends the plugin message to all players on the server.
 func BroadcastPluginMessage(sinks []message.ChannelMessageSink, identifier message.ChannelIdentifier, data []byte) {
@@ -224,6 +244,8 @@ type serverConnection struct {
 	completedJoin      atomic.Bool
 	gracefulDisconnect atomic.Bool
 	pendingPings       *lru.SyncCache[int64, time.Time]
+	// limboConfig are the config packets of a 1.20.2+ backend recorded for limbo, see limboWorld.
+	limboConfig []proto.Packet
 
 	mu         sync.RWMutex        // Protects following fields
 	connection netmc.MinecraftConn // the backend server connection
@@ -398,6 +420,8 @@ func (s *serverConnection) connect(ctx context.Context) (result *connectionResul
 
 	debug.Info("connected to server")
 
+	conn = countConn(conn, "server")
+
 	// Wrap server connection
 	logCtx := logr.NewContext(
 		context.Background(),
@@ -409,6 +433,8 @@ func (s *serverConnection) connect(ctx context.Context) (result *connectionResul
 		time.Duration(s.config().ConnectionTimeout)*time.Millisecond,
 		s.config().Compression.Level,
 	)
+	serverMc.SetPacketInterceptor(s.player.proxy.packetInterceptor(serverMc, s.player, s))
+	s.player.proxy.trackServerConnection(s.server, serverMc)
 	resultChan := make(chan *connResponse, 1)
 
 	// Kick off the connection process...
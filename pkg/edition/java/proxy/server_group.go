@@ -0,0 +1,128 @@
+package proxy
+
+import (
+	"context"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	liteconfig "go.minekube.com/gate/pkg/edition/java/lite/config"
+)
+
+// ServerGroup returns the registered member servers of the server group
+// with the name, see the "serverGroups" config. Returns nil if not found.
+func (p *Proxy) ServerGroup(name string) []RegisteredServer {
+	group, ok := p.config().ServerGroups.Group(name)
+	if !ok {
+		return nil
+	}
+	members := make([]RegisteredServer, 0, len(group.Servers))
+	for _, member := range group.Servers {
+		if s := p.Server(member); s != nil {
+			members = append(members, s)
+		}
+	}
+	return members
+}
+
+// PickServer returns the registered server with the name or, if the name is a server group,
+// picks one of its healthy member servers by the load-balancing strategy of the group.
+// Returns nil if not found or no member server is available.
+func (p *Proxy) PickServer(name string) RegisteredServer {
+	s := p.pickServer(name, nil)
+	if s == nil {
+		return nil // return correct nil
+	}
+	return s
+}
+
+// pickServers picks a server for each of n players like PickServer, accounting for the
+// servers picked for the previous players so that the fewest-players and least-connections
+// strategies spread the players over the group instead of picking the same server for all.
+// The returned release func must be called when the players are connected.
+// Returns nil if a pick failed.
+func (p *Proxy) pickServers(name string, n int) (servers []RegisteredServer, release func()) {
+	var (
+		picked   = map[*registeredServer]int{}
+		releases = make([]func(), 0, n)
+	)
+	release = func() {
+		for _, r := range releases {
+			r()
+		}
+	}
+	servers = make([]RegisteredServer, n)
+	for i := range servers {
+		s := p.pick(name, nil, picked)
+		if s == nil {
+			release()
+			return nil, func() {}
+		}
+		picked[s]++
+		// Count the pick as connection until the player is connected
+		releases = append(releases, p.lite.StrategyManager().IncrementConnection(s.ServerInfo().Name()))
+		servers[i] = s
+	}
+	return servers, release
+}
+
+// pickServer is like PickServer but excludes the servers exclude returns true for.
+func (p *Proxy) pickServer(name string, exclude func(RegisteredServer) bool) *registeredServer {
+	return p.pick(name, exclude, nil)
+}
+
+// pick is like pickServer and adds the pending players to the player counts of servers.
+func (p *Proxy) pick(name string, exclude func(RegisteredServer) bool, pending map[*registeredServer]int) *registeredServer {
+	group, ok := p.config().ServerGroups.Group(name)
+	if !ok {
+		s := p.server(name)
+		if s == nil || (exclude != nil && exclude(s)) {
+			return nil
+		}
+		return s
+	}
+
+	candidates := make([]*registeredServer, 0, len(group.Servers))
+	for _, member := range group.Servers {
+		s := p.server(member)
+		if s == nil || !s.healthy() || (exclude != nil && exclude(s)) {
+			continue
+		}
+		candidates = append(candidates, s)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	if group.Strategy == config.FewestPlayersStrategy {
+		players := func(s *registeredServer) int { return s.players.Len() + pending[s] }
+		fewest := candidates[0]
+		for _, s := range candidates[1:] {
+			if players(s) < players(fewest) {
+				fewest = s
+			}
+		}
+		return fewest
+	}
+
+	names := make([]string, len(candidates))
+	for i, s := range candidates {
+		names[i] = s.ServerInfo().Name()
+	}
+	route := &liteconfig.Route{Strategy: group.Strategy}
+	picked, _, ok := p.lite.StrategyManager().GetNextBackend(p.log, route, "group:"+name, names)
+	if !ok {
+		return nil
+	}
+	for _, s := range candidates {
+		if s.ServerInfo().Name() == picked {
+			return s
+		}
+	}
+	return nil
+}
+
+// trackServerConnection counts the connection to the server until it is closed
+// for the least-connections strategy of server groups.
+func (p *Proxy) trackServerConnection(server RegisteredServer, conn interface{ Context() context.Context }) {
+	release := p.lite.StrategyManager().IncrementConnection(server.ServerInfo().Name())
+	context.AfterFunc(conn.Context(), release)
+}
@@ -0,0 +1,94 @@
+package proxy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/lite"
+	liteconfig "go.minekube.com/gate/pkg/edition/java/lite/config"
+	"go.minekube.com/gate/pkg/edition/java/profile"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+func newServerGroupTestProxy(strategy liteconfig.Strategy) *Proxy {
+	p := &Proxy{
+		log:     logr.Discard(),
+		lite:    lite.NewLite(),
+		servers: map[string]*registeredServer{},
+		cfg: &config.Config{ServerGroups: config.ServerGroups{
+			"Lobby": {Servers: []string{"lobby-1", "lobby-2", "lobby-3"}, Strategy: strategy},
+		}},
+	}
+	for i, name := range []string{"lobby-1", "lobby-2", "lobby-3", "survival"} {
+		addr := mustParseAddr(fmt.Sprintf("localhost:%d", 25565+i))
+		p.servers[name] = newRegisteredServer(NewServerInfo(name, addr))
+	}
+	return p
+}
+
+func TestPickServer(t *testing.T) {
+	p := newServerGroupTestProxy(liteconfig.StrategyRoundRobin)
+
+	require.Equal(t, "survival", p.PickServer("survival").ServerInfo().Name())
+	require.Nil(t, p.PickServer("unknown"))
+	require.Len(t, p.ServerGroup("lobby"), 3)
+
+	var picked []string
+	for range 4 {
+		picked = append(picked, p.PickServer("lobby").ServerInfo().Name())
+	}
+	require.Equal(t, []string{"lobby-1", "lobby-2", "lobby-3", "lobby-1"}, picked)
+
+	// Unhealthy and excluded servers are skipped
+	p.servers["lobby-2"].health.record(&config.HealthCheck{}, 0, false)
+	exclude := func(s RegisteredServer) bool { return s.ServerInfo().Name() == "lobby-3" }
+	require.Equal(t, "lobby-1", p.pickServer("lobby", exclude).ServerInfo().Name())
+	exclude = func(s RegisteredServer) bool { return s.ServerInfo().Name() != "lobby-2" }
+	require.Nil(t, p.pickServer("lobby", exclude))
+}
+
+func TestPickServerFewestPlayers(t *testing.T) {
+	p := newServerGroupTestProxy(config.FewestPlayersStrategy)
+	addPlayers := func(server string, n int) {
+		for range n {
+			p.servers[server].players.add(&connectedPlayer{profile: &profile.GameProfile{ID: uuid.New()}})
+		}
+	}
+	addPlayers("lobby-1", 2)
+	addPlayers("lobby-2", 1)
+	addPlayers("lobby-3", 3)
+	require.Equal(t, "lobby-2", p.PickServer("lobby").ServerInfo().Name())
+}
+
+func TestPickServers(t *testing.T) {
+	names := func(servers []RegisteredServer) (n []string) {
+		for _, s := range servers {
+			n = append(n, s.ServerInfo().Name())
+		}
+		return n
+	}
+
+	p := newServerGroupTestProxy(config.FewestPlayersStrategy)
+	for range 2 {
+		p.servers["lobby-1"].players.add(&connectedPlayer{profile: &profile.GameProfile{ID: uuid.New()}})
+	}
+	servers, release := p.pickServers("lobby", 4)
+	release()
+	require.Equal(t, []string{"lobby-2", "lobby-3", "lobby-2", "lobby-3"}, names(servers),
+		"earlier picks count as players")
+
+	p = newServerGroupTestProxy(liteconfig.StrategyLeastConnections)
+	servers, release = p.pickServers("lobby", 3)
+	require.Equal(t, []string{"lobby-1", "lobby-2", "lobby-3"}, names(servers),
+		"earlier picks count as connections")
+	require.Equal(t, uint32(1), p.lite.StrategyManager().GetOrCreateCounter("lobby-2").Load())
+	release()
+	require.Equal(t, uint32(0), p.lite.StrategyManager().GetOrCreateCounter("lobby-2").Load())
+
+	servers, _ = p.pickServers("unknown", 1)
+	require.Nil(t, servers)
+}
@@ -0,0 +1,176 @@
+package proxy
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+)
+
+// HealthStatus is the health status of a RegisteredServer.
+type HealthStatus uint8
+
+// Available health statuses.
+const (
+	// UnknownHealthStatus is the status of servers that were not
+	// checked yet or if health checks are disabled.
+	UnknownHealthStatus HealthStatus = iota
+	HealthyHealthStatus
+	UnhealthyHealthStatus
+)
+
+func (s HealthStatus) String() string {
+	switch s {
+	case HealthyHealthStatus:
+		return "healthy"
+	case UnhealthyHealthStatus:
+		return "unhealthy"
+	default:
+		return "unknown"
+	}
+}
+
+// ServerHealth is the health of a RegisteredServer determined
+// by periodic status pings, see the "healthCheck" config.
+type ServerHealth struct {
+	Status    HealthStatus
+	Latency   time.Duration // Round-trip time of the last successful ping, 0 if none.
+	LastCheck time.Time     // Time of the last ping, zero if never checked.
+}
+
+// serverHealth tracks the health of a registered server.
+type serverHealth struct {
+	mu     sync.Mutex // Protects following fields
+	health ServerHealth
+	streak int // consecutive ping results contradicting the current status
+}
+
+func (h *serverHealth) get() ServerHealth {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.health
+}
+
+// record records a ping result and returns the previous and new health.
+// The status of a server changes after the configured number of consecutive
+// failed or successful pings, or with the first ping if the status is unknown.
+func (h *serverHealth) record(cfg *config.HealthCheck, latency time.Duration, ok bool) (prev, cur ServerHealth) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	prev = h.health
+	h.health.LastCheck = time.Now()
+	if ok {
+		h.health.Latency = latency
+	}
+
+	status, threshold := UnhealthyHealthStatus, cfg.UnhealthyThreshold
+	if ok {
+		status, threshold = HealthyHealthStatus, cfg.HealthyThreshold
+	}
+	if prev.Status == status {
+		h.streak = 0
+		return prev, h.health
+	}
+	h.streak++
+	if prev.Status == UnknownHealthStatus || h.streak >= threshold {
+		h.health.Status = status
+		h.streak = 0
+	}
+	return prev, h.health
+}
+
+// reset resets the health to unknown and returns the previous health.
+func (h *serverHealth) reset() (prev ServerHealth) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	prev = h.health
+	h.health = ServerHealth{}
+	h.streak = 0
+	return prev
+}
+
+// healthy returns false if the server is known to be unhealthy.
+func (r *registeredServer) healthy() bool {
+	return r.health.get().Status != UnhealthyHealthStatus
+}
+
+// runHealthChecks pings all registered servers every interval until the context is canceled
+// and resets their health to unknown afterward.
+func (p *Proxy) runHealthChecks(ctx context.Context, cfg config.HealthCheck) {
+	defer p.resetServerHealth()
+	ticker := time.NewTicker(time.Duration(cfg.Interval))
+	defer ticker.Stop()
+	for {
+		var wg sync.WaitGroup
+		for _, rs := range p.registeredServers() {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				p.checkServerHealth(ctx, &cfg, rs)
+			}()
+		}
+		wg.Wait()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkServerHealth pings the server and fires a ServerHealthChangedEvent if the status changed.
+func (p *Proxy) checkServerHealth(ctx context.Context, cfg *config.HealthCheck, rs *registeredServer) {
+	pingCtx, cancel := context.WithTimeout(ctx, time.Duration(cfg.Timeout))
+	defer cancel()
+	start := time.Now()
+	_, err := p.fetchServerPing(pingCtx, p.log, nil, rs.ServerInfo().Addr(), version.MaximumVersion.Protocol)
+	if ctx.Err() != nil {
+		return // stopped
+	}
+
+	latency := time.Since(start)
+	if err == nil {
+		// Used by the lowest-latency strategy of server groups
+		p.lite.StrategyManager().RecordLatency(rs.ServerInfo().Name(), latency)
+	}
+	prev, cur := rs.health.record(cfg, latency, err == nil)
+	if prev.Status == cur.Status {
+		return
+	}
+	log := p.log.WithValues("server", rs.ServerInfo().Name(), "status", cur.Status)
+	if err != nil {
+		log.Info("server health changed", "error", err)
+	} else {
+		log.Info("server health changed", "latency", cur.Latency.Round(time.Millisecond))
+	}
+	p.event.Fire(&ServerHealthChangedEvent{
+		server:   rs,
+		previous: prev.Status,
+		health:   cur,
+	})
+}
+
+// resetServerHealth resets the health of all servers to unknown.
+func (p *Proxy) resetServerHealth() {
+	for _, rs := range p.registeredServers() {
+		if prev := rs.health.reset(); prev.Status != UnknownHealthStatus {
+			p.event.Fire(&ServerHealthChangedEvent{
+				server:   rs,
+				previous: prev.Status,
+			})
+		}
+	}
+}
+
+func (p *Proxy) registeredServers() []*registeredServer {
+	p.muS.RLock()
+	defer p.muS.RUnlock()
+	l := make([]*registeredServer, 0, len(p.servers))
+	for _, rs := range p.servers {
+		l = append(l, rs)
+	}
+	return l
+}
@@ -0,0 +1,38 @@
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+)
+
+func TestServerHealthRecord(t *testing.T) {
+	cfg := &config.HealthCheck{UnhealthyThreshold: 2, HealthyThreshold: 2}
+	var h serverHealth
+
+	_, cur := h.record(cfg, time.Millisecond, true)
+	require.Equal(t, HealthyHealthStatus, cur.Status, "first check decides unknown status")
+	require.Equal(t, time.Millisecond, cur.Latency)
+
+	_, cur = h.record(cfg, 0, false)
+	require.Equal(t, HealthyHealthStatus, cur.Status)
+	_, cur = h.record(cfg, 0, true)
+	require.Equal(t, HealthyHealthStatus, cur.Status, "success resets the failure streak")
+	_, cur = h.record(cfg, 0, false)
+	require.Equal(t, HealthyHealthStatus, cur.Status)
+	prev, cur := h.record(cfg, 0, false)
+	require.Equal(t, HealthyHealthStatus, prev.Status)
+	require.Equal(t, UnhealthyHealthStatus, cur.Status)
+	require.Equal(t, time.Millisecond, cur.Latency, "keeps the last successful latency")
+
+	_, cur = h.record(cfg, 0, true)
+	require.Equal(t, UnhealthyHealthStatus, cur.Status)
+	_, cur = h.record(cfg, 2*time.Millisecond, true)
+	require.Equal(t, HealthyHealthStatus, cur.Status)
+
+	require.Equal(t, HealthyHealthStatus, h.reset().Status)
+	require.Equal(t, ServerHealth{}, h.get())
+}
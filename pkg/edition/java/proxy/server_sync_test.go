@@ -276,6 +276,7 @@ func createTestProxy(t *testing.T, servers map[string]string) *Proxy {
 		event:         event.Nop,
 		servers:       make(map[string]*registeredServer),
 		configServers: make(map[string]bool),
+		discovered:    make(map[string]string),
 		authenticator: authenticator,
 	}
 
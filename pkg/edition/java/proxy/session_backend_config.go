@@ -77,9 +77,9 @@ func (b *backendConfigSessionHandler) HandlePacket(pc *proto.PacketContext) {
 		b.handleRemoveResourcePackRequest(p)
 	case *config.FinishedUpdate:
 		b.handleFinishedUpdate(p)
-	case *config.TagsUpdate:
-		b.forwardToPlayer(pc, nil)
-	case *config.RegistrySync:
+	case *config.KnownPacks, *config.RegistrySync, *config.TagsUpdate, *config.ActiveFeatures:
+		// Recorded to sync the registries of the limbo world with clients
+		b.serverConn.limboConfig = append(b.serverConn.limboConfig, p)
 		b.forwardToPlayer(pc, nil)
 	case *plugin.Message:
 		b.handlePluginMessage(pc, p)
@@ -13,6 +13,7 @@ import (
 	"go.minekube.com/gate/pkg/edition/java/proxy/bungeecord"
 	"go.minekube.com/gate/pkg/edition/java/proxy/phase"
 	"go.minekube.com/gate/pkg/edition/java/proxy/tablist"
+	"go.minekube.com/gate/pkg/edition/java/scoreboard"
 	"go.minekube.com/gate/pkg/gate/proto"
 	"reflect"
 	"time"
@@ -265,6 +266,9 @@ func (b *backendTransitionSessionHandler) handleJoinGame(pc *proto.PacketContext
 		}
 	}
 
+	// Record the world of the server for limbo before the JoinGame is changed for the switch.
+	b.serverConn.player.proxy.limbo.record(b.serverConn.player.Protocol(), b.serverConn.limboConfig, p)
+
 	if err := playHandler.handleBackendJoinGame(pc, p, b.serverConn); err != nil {
 		failResult("JoinGame packet could not be handled, client-side switching server failed: %w", err)
 		return // not handled
@@ -297,6 +301,11 @@ func (b *backendTransitionSessionHandler) handleJoinGame(pc *proto.PacketContext
 		}
 	}
 
+	// Send the proxy scoreboards reset by the JoinGame again.
+	if err = scoreboard.Sync(b.serverConn.player); err != nil {
+		b.log.V(1).Info("error sending scoreboards to player", "error", err)
+	}
+
 	// We're done!
 	postConnectEvent := newServerPostConnectEvent(b.serverConn.player, nil)
 	// Assign previousServer only if non-nil to prevent storing a typed nil pointer,
@@ -1,6 +1,7 @@
 package proxy
 
 import (
+	"context"
 	"sync/atomic"
 
 	"github.com/go-logr/logr"
@@ -25,6 +26,8 @@ type authSessionHandler struct {
 	inbound    *loginInboundConn
 	profile    *profile.GameProfile
 	onlineMode bool
+	// onLoginSuccess is called once the login success was sent to the player, nil if unset.
+	onLoginSuccess func(ctx context.Context)
 
 	loginState *atomic.Pointer[authLoginState] // 1.20.2+
 
@@ -50,7 +53,7 @@ func newAuthSessionHandler(
 	profile *profile.GameProfile,
 	onlineMode bool,
 	sessionHandlerDeps *sessionHandlerDeps,
-) netmc.SessionHandler {
+) *authSessionHandler {
 	var defaultState atomic.Pointer[authLoginState]
 	defaultState.Store(&startAuthLoginState)
 	return &authSessionHandler{
@@ -92,8 +95,10 @@ func (a *authSessionHandler) Activated() {
 		a.inbound.IdentifiedKey(),
 		a.sessionHandlerDeps,
 	)
+	conn.SetPacketInterceptor(a.proxy.packetInterceptor(conn, player, nil))
 	a.connectedPlayer = player
 	if !a.registrar.canRegisterConnection(player) {
+		recordLoginFailure(loginFailureAlreadyConnected)
 		player.Disconnect(alreadyConnected)
 		return
 	}
@@ -175,11 +180,13 @@ func (a *authSessionHandler) completeLoginProtocolPhaseAndInitialize(player *con
 	}
 
 	if !loginEvent.Allowed() {
+		recordLoginFailure(loginFailureDenied)
 		player.Disconnect(loginEvent.Reason())
 		return
 	}
 
 	if !a.registrar.registerConnection(player) {
+		recordLoginFailure(loginFailureAlreadyConnected)
 		player.Disconnect(alreadyConnected)
 		return
 	}
@@ -194,6 +201,10 @@ func (a *authSessionHandler) completeLoginProtocolPhaseAndInitialize(player *con
 
 	a.loginState.Store(&successSentAuthLoginState)
 
+	if a.onLoginSuccess != nil {
+		a.onLoginSuccess(logr.NewContext(player.Context(), a.log))
+	}
+
 	if a.inbound.Protocol().Lower(version.Minecraft_1_20_2) {
 		a.loginState.Store(&acknowledgedAuthLoginState)
 		a.connectedPlayer.MinecraftConn.SetActiveSessionHandler(state.Play,
@@ -220,6 +231,10 @@ func (a *authSessionHandler) connectToInitialServer(player *connectedPlayer) {
 		return
 	}
 	if chooseServer.InitialServer() == nil {
+		if a.config().Limbo.Enabled && a.proxy.limbo.park(player, limboMessage(a.config(), nil)) {
+			return
+		}
+		recordLoginFailure(loginFailureNoAvailableServers)
 		player.Disconnect(noAvailableServers) // Will call Disconnected() in InitialConnectSessionHandler
 		return
 	}
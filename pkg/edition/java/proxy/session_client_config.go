@@ -73,6 +73,8 @@ func (h *clientConfigSessionHandler) HandlePacket(pc *proto.PacketContext) {
 		h.handleKnownPacks(p, pc)
 	case *cookie.CookieResponse:
 		h.handleCookieResponse(p)
+	case *packet.CustomClickAction:
+		h.handleCustomClickAction(p, pc)
 	default:
 		forwardToServer(pc, h.player)
 	}
@@ -188,6 +190,19 @@ func (h *clientConfigSessionHandler) handleCookieResponse(p *cookie.CookieRespon
 	forwardCookieReceive(e, smc)
 }
 
+func (h *clientConfigSessionHandler) handleCustomClickAction(p *packet.CustomClickAction, pc *proto.PacketContext) {
+	e := newCustomClickActionEvent(h.player, p.ID, p.Payload)
+	h.event().Fire(e)
+	if !e.Allowed() {
+		return
+	}
+	smc, ok := h.player.connectionInFlightOrConnectedServer().ensureConnected()
+	if !ok {
+		return
+	}
+	_ = smc.Write(pc.Payload)
+}
+
 func forwardCookieReceive(e *CookieReceiveEvent, conn netmc.MinecraftConn) {
 	key := e.Key()
 	if key == nil {
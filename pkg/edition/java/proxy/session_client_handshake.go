@@ -72,9 +72,14 @@ func (h *handshakeSessionHandler) HandlePacket(p *proto.PacketContext) {
 		return
 	}
 	switch typed := p.Packet.(type) {
-	// TODO legacy pings
 	case *packet.Handshake:
 		h.handleHandshake(typed, p)
+	case *packet.LegacyPing:
+		h.handleLegacyPing(typed, p)
+	case *packet.LegacyHandshake:
+		_ = netmc.CloseWith(h.conn, &packet.LegacyDisconnect{
+			Reason: "Your client is extremely old. Please update to a newer version of Minecraft.",
+		})
 	default:
 		// Unknown packet received.
 		// Better to close the connection.
@@ -102,12 +107,12 @@ func (h *handshakeSessionHandler) handleHandshake(handshake *packet.Handshake, p
 		dialTimeout := time.Duration(h.config().ConnectionTimeout)
 		if nextState == state.Login {
 			// Lite mode enabled, pipe the connection.
-			lite.Forward(dialTimeout, h.config().Lite.Routes, h.log, h.conn, handshake, pc, h.proxy.Lite().StrategyManager())
+			lite.Forward(dialTimeout, h.config().Lite.Routes, h.log, h.conn, handshake, pc, h.proxy.Lite().StrategyManager(), h.proxy.Lite().Limiter())
 			return
 		}
 		// Resolve ping response for lite mode.
 		resolvePingResponse = func(log logr.Logger, statusRequestCtx *proto.PacketContext) (logr.Logger, *packet.StatusResponse, error) {
-			return lite.ResolveStatusResponse(dialTimeout, h.config().Lite.Routes, log, h.conn, handshake, pc, statusRequestCtx, h.proxy.Lite().StrategyManager())
+			return lite.ResolveStatusResponse(dialTimeout, h.config().Lite.Routes, log, h.conn, handshake, pc, statusRequestCtx, h.proxy.Lite().StrategyManager(), h.proxy.Lite().Limiter())
 		}
 	}
 
@@ -136,8 +141,11 @@ func (h *handshakeSessionHandler) handleHandshake(handshake *packet.Handshake, p
 }
 
 func (h *handshakeSessionHandler) handleLogin(p *packet.Handshake, inbound *initialInbound) {
+	recordLoginAttempt()
+
 	// Check for supported client version.
 	if !version.Protocol(p.ProtocolVersion).Supported() {
+		recordLoginFailure(loginFailureUnsupportedVersion)
 		_ = inbound.disconnect(&component.Translation{
 			Key:  "multiplayer.disconnect.outdated_client",
 			With: []component.Component{&component.Text{Content: version.SupportedVersionsString}},
@@ -147,6 +155,8 @@ func (h *handshakeSessionHandler) handleLogin(p *packet.Handshake, inbound *init
 
 	// Client IP-block rate limiter preventing too fast logins hitting the Mojang API
 	if h.loginsQuota != nil && h.loginsQuota.Blocked(netutil.Host(inbound.RemoteAddr())) {
+		recordQuotaRejection("logins")
+		recordLoginFailure(loginFailureRateLimited)
 		_ = netmc.CloseWith(h.conn, packet.NewDisconnect(&component.Text{
 			Content: "You are logging in too fast, please calm down and retry.",
 			S:       component.Style{Color: color.Red},
@@ -160,6 +170,7 @@ func (h *handshakeSessionHandler) handleLogin(p *packet.Handshake, inbound *init
 	// and lower, otherwise IP information will never get forwarded.
 	if h.config().Forwarding.Mode == config.VelocityForwardingMode &&
 		p.ProtocolVersion < int(version.Minecraft_1_13.Protocol) {
+		recordLoginFailure(loginFailureUnsupportedVersion)
 		_ = netmc.CloseWith(h.conn, packet.NewDisconnect(&component.Text{
 			Content: "This server is only compatible with versions 1.13 and above.",
 		}, proto.Protocol(p.ProtocolVersion), h.conn.State().State))
@@ -172,6 +183,29 @@ func (h *handshakeSessionHandler) handleLogin(p *packet.Handshake, inbound *init
 	h.conn.SetActiveSessionHandler(state.Login, handler)
 }
 
+func (h *handshakeSessionHandler) handleLegacyPing(p *packet.LegacyPing, pc *proto.PacketContext) {
+	if h.config().Lite.Enabled {
+		// Lite mode has no status of its own to respond with.
+		h.log.V(1).Info("received legacy ping in lite mode, closing connection")
+		_ = h.conn.Close()
+		return
+	}
+
+	// Only 1.6 clients send the virtual host they connected to.
+	vHost := h.conn.LocalAddr()
+	if p.Host != "" {
+		vHost = netutil.NewAddr(
+			fmt.Sprintf("%s:%d", p.Host, p.Port),
+			h.conn.LocalAddr().Network(),
+		)
+	}
+	inbound := newInitialInbound(h.conn, vHost, packet.StatusHandshakeIntent)
+
+	handler := newStatusSessionHandler(h.conn, inbound, h.sessionHandlerDeps, nil)
+	h.conn.SetActiveSessionHandler(state.Status, handler)
+	handler.HandlePacket(pc)
+}
+
 func stateForProtocol(status int) *state.Registry {
 
 	switch states.State(status) {
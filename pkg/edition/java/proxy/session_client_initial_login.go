@@ -13,6 +13,7 @@ import (
 	"github.com/go-logr/logr"
 	"go.minekube.com/common/minecraft/color"
 	"go.minekube.com/common/minecraft/component"
+	"go.minekube.com/gate/pkg/edition/java/auth/hybrid"
 	"go.minekube.com/gate/pkg/edition/java/netmc"
 	"go.minekube.com/gate/pkg/edition/java/proto/util"
 	"go.minekube.com/gate/pkg/edition/java/proxy/crypto"
@@ -101,6 +102,7 @@ func (l *initialLoginSessionHandler) handleServerLogin(login *packet.ServerLogin
 
 	// Validate username format
 	if !playerNameRegex.MatchString(login.Username) {
+		recordLoginFailure(loginFailureInvalidUsername)
 		_ = l.inbound.disconnect(invalidPlayerName)
 		return
 	}
@@ -109,6 +111,7 @@ func (l *initialLoginSessionHandler) handleServerLogin(login *packet.ServerLogin
 	if playerKey != nil {
 		if playerKey.Expired() {
 			l.log.V(1).Info("expired player public key")
+			recordLoginFailure(loginFailureInvalidPublicKey)
 			_ = l.inbound.disconnect(&component.Translation{
 				Key: "multiplayer.disconnect.invalid_public_key_signature",
 			})
@@ -124,6 +127,7 @@ func (l *initialLoginSessionHandler) handleServerLogin(login *packet.ServerLogin
 
 		if !isKeyValid {
 			l.log.V(1).Info("invalid player public key signature")
+			recordLoginFailure(loginFailureInvalidPublicKey)
 			_ = l.inbound.disconnect(&component.Translation{
 				Key: "multiplayer.disconnect.invalid_public_key",
 			})
@@ -132,6 +136,7 @@ func (l *initialLoginSessionHandler) handleServerLogin(login *packet.ServerLogin
 	} else if l.conn.Protocol().GreaterEqual(version.Minecraft_1_19) &&
 		l.config().ForceKeyAuthentication &&
 		l.conn.Protocol().Lower(version.Minecraft_1_19_3) {
+		recordLoginFailure(loginFailureInvalidPublicKey)
 		_ = l.inbound.disconnect(&component.Translation{
 			Key: "multiplayer.disconnect.missing_public_key",
 		})
@@ -148,6 +153,7 @@ func (l *initialLoginSessionHandler) handleServerLogin(login *packet.ServerLogin
 	}
 
 	if e.Result() == DeniedPreLogin {
+		recordLoginFailure(loginFailureDenied)
 		_ = l.inbound.disconnect(e.Reason())
 		return
 	}
@@ -157,11 +163,20 @@ func (l *initialLoginSessionHandler) handleServerLogin(login *packet.ServerLogin
 			return nil // Player was disconnected
 		}
 
+		provider, hasProfile := netmc.Assert[GameProfileProvider](l.conn)
+		ctx := logr.NewContext(l.conn.Context(), l.log)
+		hybridAuth := l.proxy.hybridAuth.Load()
+		onlineMode := resolveOnlineMode(ctx, e.Result(), l.config().OnlineMode,
+			hybridAuth, hasProfile, l.login.Username)
+		if netmc.Closed(l.conn) {
+			return nil // Player was disconnected
+		}
+
 		if e.Result() != ForceOfflineModePreLogin &&
-			(e.Result() == ForceOnlineModePreLogin || l.config().OnlineMode) {
+			(e.Result() == ForceOnlineModePreLogin || onlineMode) {
 
-			if p, ok := netmc.Assert[GameProfileProvider](l.conn); ok {
-				sh := l.newAuthSessionHandler(l.inbound, p.GameProfile(), false)
+			if hasProfile {
+				sh := l.newAuthSessionHandler(l.inbound, provider.GameProfile(), false)
 				l.conn.SetActiveSessionHandler(state.Login, sh)
 				return nil
 			}
@@ -180,17 +195,68 @@ func (l *initialLoginSessionHandler) handleServerLogin(login *packet.ServerLogin
 		}
 
 		// Offline mode login
-		sh := l.newAuthSessionHandler(l.inbound, profile.NewOffline(l.login.Username), false)
+		gameProfile := profile.NewOffline(l.login.Username)
+		offlineUUIDs := l.proxy.offlineUUIDs.Load()
+		if offlineUUIDs != nil {
+			id, err := offlineUUIDs.Resolve(ctx, l.login.Username)
+			if netmc.Closed(l.conn) {
+				return nil // Player was disconnected
+			}
+			if err != nil {
+				// Don't let the player join with another UUID and lose their data
+				recordLoginFailure(loginFailureUUIDResolution)
+				if netmc.CloseWith(l.conn, packet.NewDisconnect(unableResolveUUID, l.conn.Protocol(), l.conn.State().State)) == nil {
+					l.log.Error(err, "unable to resolve offline mode player uuid")
+				}
+				return nil
+			}
+			gameProfile.ID = id
+		}
+		sh := l.newAuthSessionHandler(l.inbound, gameProfile, false)
+		hybridOffline := hybridAuth != nil && e.Result() == AllowedPreLogin
+		if hybridOffline || offlineUUIDs != nil {
+			// Only persist the resolved mode and UUID once the player logged in.
+			username, id := l.login.Username, gameProfile.ID
+			sh.onLoginSuccess = func(ctx context.Context) {
+				if hybridOffline {
+					hybridAuth.LoggedInOffline(ctx, username)
+				}
+				if offlineUUIDs != nil {
+					offlineUUIDs.LoggedIn(ctx, username, id)
+				}
+			}
+		}
 		l.conn.SetActiveSessionHandler(state.Login, sh)
 		return nil
 	})
 }
 
+// resolveOnlineMode returns whether the player should log in in online mode.
+// With hybrid auth the mode is resolved per username, unless a PreLoginEvent subscriber
+// forced a mode. Connections providing their own game profile, e.g. from Connect,
+// skip the lookup and keep their profile by logging in in online mode.
+func resolveOnlineMode(
+	ctx context.Context,
+	result PreLoginResult,
+	onlineMode bool,
+	hybridAuth *hybrid.Resolver,
+	hasProfile bool,
+	username string,
+) bool {
+	if result != AllowedPreLogin || hybridAuth == nil {
+		return onlineMode
+	}
+	if hasProfile {
+		return true
+	}
+	return hybridAuth.Resolve(ctx, username) == hybrid.Online
+}
+
 func (l *initialLoginSessionHandler) newAuthSessionHandler(
 	inbound *loginInboundConn,
 	profile *profile.GameProfile,
 	onlineMode bool,
-) netmc.SessionHandler {
+) *authSessionHandler {
 	return newAuthSessionHandler(
 		inbound,
 		profile,
@@ -213,6 +279,11 @@ var unableAuthWithMojang = &component.Text{
 	S:       component.Style{Color: color.Red},
 }
 
+var unableResolveUUID = &component.Text{
+	Content: "Unable to look up your account.\nPlease try again!",
+	S:       component.Style{Color: color.Red},
+}
+
 func (l *initialLoginSessionHandler) handleEncryptionResponse(resp *packet.EncryptionResponse) {
 	if !l.assertState(encryptionRequestSentLoginState) {
 		return
@@ -296,12 +367,14 @@ func (l *initialLoginSessionHandler) handleEncryptionResponse(resp *packet.Encry
 			// The player disconnected before receiving authentication response.
 			return
 		}
+		recordLoginFailure(loginFailureAuthentication)
 		_ = netmc.CloseWith(l.conn, packet.NewDisconnect(unableAuthWithMojang, l.conn.Protocol(), l.conn.State().State))
 		return
 	}
 
 	if !authResp.OnlineMode() {
 		log.Info("disconnect offline mode player")
+		recordLoginFailure(loginFailureAuthentication)
 		// Apparently an offline-mode user logged onto this online-mode proxy.
 		_ = netmc.CloseWith(l.conn, packet.NewDisconnect(onlineModeOnly, l.conn.Protocol(), l.conn.State().State))
 		return
@@ -316,6 +389,10 @@ func (l *initialLoginSessionHandler) handleEncryptionResponse(resp *packet.Encry
 		return
 	}
 
+	if r := l.proxy.hybridAuth.Load(); r != nil {
+		r.Authenticated(ctx, gameProfile.Name)
+	}
+
 	// All went well, initialize the session.
 	sh := l.newAuthSessionHandler(l.inbound, gameProfile, true)
 	l.conn.SetActiveSessionHandler(state.Login, sh)
@@ -0,0 +1,33 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/edition/java/auth/hybrid"
+)
+
+func TestResolveOnlineMode(t *testing.T) {
+	ctx := context.Background()
+	var lookups int
+	r := hybrid.NewResolver(hybrid.CheckerFunc(func(_ context.Context, username string) (bool, error) {
+		lookups++
+		return username == "Notch", nil
+	}), nil)
+
+	require.True(t, resolveOnlineMode(ctx, AllowedPreLogin, false, r, false, "Notch"))
+	require.False(t, resolveOnlineMode(ctx, AllowedPreLogin, true, r, false, "cracked"))
+	require.Equal(t, 2, lookups)
+
+	// Connections providing a game profile keep it without a lookup
+	require.True(t, resolveOnlineMode(ctx, AllowedPreLogin, false, r, true, "cracked"))
+	require.Equal(t, 2, lookups)
+
+	// Forced modes and disabled hybrid auth use the config
+	require.False(t, resolveOnlineMode(ctx, ForceOfflineModePreLogin, false, r, false, "Notch"))
+	require.True(t, resolveOnlineMode(ctx, AllowedPreLogin, true, nil, true, "cracked"))
+	require.False(t, resolveOnlineMode(ctx, AllowedPreLogin, false, nil, true, "cracked"))
+	require.Equal(t, 2, lookups)
+}
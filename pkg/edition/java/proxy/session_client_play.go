@@ -25,6 +25,7 @@ import (
 	"go.minekube.com/gate/pkg/edition/java/proto/packet/chat"
 	"go.minekube.com/gate/pkg/edition/java/proxy/message"
 	"go.minekube.com/gate/pkg/edition/java/proxy/phase"
+	"go.minekube.com/gate/pkg/edition/java/scoreboard"
 	"go.minekube.com/gate/pkg/util/uuid"
 
 	"github.com/go-logr/logr"
@@ -117,6 +118,8 @@ func (c *clientPlaySessionHandler) HandlePacket(pc *proto.PacketContext) {
 		c.handleChatAcknowledgement(p)
 	case *cpacket.CookieResponse:
 		c.handleCookieResponse(p)
+	case *packet.CustomClickAction:
+		c.handleCustomClickAction(p, pc)
 	case *packet.JoinGame:
 		c.handleJoinGame(pc)
 	default:
@@ -403,19 +406,73 @@ func (c *clientPlaySessionHandler) handleBackendJoinGame(pc *proto.PacketContext
 	if !ok {
 		return errors.New("no backend server connection")
 	}
+	firstJoin, err := c.joinWorld(joinGame)
+	if err != nil {
+		return err
+	}
+	if firstJoin {
+		// Required for Legacy Forge
+		c.player.phase().OnFirstJoin(c.player)
+	}
+
+	// Tell the server about the proxy's plugin message channels.
+	serverVersion := serverMc.Protocol()
+	channels := c.proxy().ChannelRegistrar().ChannelsForProtocol(serverVersion)
+	if len(channels) != 0 {
+		channelsPacket := plugin.ConstructChannelsPacket(serverVersion, channels.UnsortedList()...)
+		if err = serverMc.BufferPacket(channelsPacket); err != nil {
+			return fmt.Errorf("error buffering %T for backend: %w", channelsPacket, err)
+		}
+	}
+	// Tell the server about this client's plugin message channels.
+	if c.player.clientsideChannels.Len() != 0 {
+		channelsPacket := plugin.ConstructChannelsPacket(serverVersion, c.player.clientsideChannels.UnsortedList()...)
+		if err = serverMc.BufferPacket(channelsPacket); err != nil {
+			return fmt.Errorf("error buffering %T for backend: %w", channelsPacket, err)
+		}
+	}
+
+	// If we had plugin messages queued during login/FML handshake, send them now.
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for c.mu.loginPluginMessages.Len() != 0 {
+		pm := c.mu.loginPluginMessages.PopFront()
+		if err = serverMc.BufferPacket(pm); err != nil {
+			return fmt.Errorf("error buffering %T for backend: %w", pm, err)
+		}
+	}
+
+	// Flush everything
+	if err = c.player.Flush(); err != nil {
+		return fmt.Errorf("error flushing buffered player packets: %w", err)
+	}
+	if serverMc.Flush() != nil {
+		return fmt.Errorf("error flushing buffered backend packets: %w", err)
+	}
+	destination.completeJoin()
+	return nil
+}
+
+// joinWorld buffers the JoinGame for the player, switching from the world the player
+// is in if spawned already, and clears what the client keeps across worlds.
+// Returns true if this is the first world the player joins. The caller must flush.
+func (c *clientPlaySessionHandler) joinWorld(joinGame *packet.JoinGame) (firstJoin bool, err error) {
+	// The client resets its scoreboard with the JoinGame, so proxy scoreboards
+	// are held back until they are sent again after the player connected.
+	scoreboard.Reset(c.player.ID())
+
 	playerVersion := c.player.Protocol()
 	if c.spawned.CompareAndSwap(false, true) {
 		// The player wasn't spawned in yet, so we don't need to do anything special.
 		// Just send JoinGame.
 		if err = c.player.BufferPacket(joinGame); err != nil {
-			return fmt.Errorf("error buffering %T for player: %w", joinGame, err)
+			return false, fmt.Errorf("error buffering %T for player: %w", joinGame, err)
 		}
-		// Required for Legacy Forge
-		c.player.phase().OnFirstJoin(c.player)
+		firstJoin = true
 	} else {
 		// Clear tab list to avoid duplicate entries
 		if err = c.player.tabList.RemoveAll(); err != nil {
-			return fmt.Errorf("error clearing tablist entries: %w", err)
+			return false, fmt.Errorf("error clearing tablist entries: %w", err)
 		}
 		// The player is switching from a server already, so we need to tell the client to change
 		// entity IDs and send new dimension information.
@@ -431,70 +488,37 @@ func (c *clientPlaySessionHandler) handleBackendJoinGame(pc *proto.PacketContext
 			}
 		}
 		if err != nil {
-			return err
+			return false, err
 		}
 	}
 
 	// Remove previous boss bars. These don't get cleared when sending JoinGame, thus the need to
 	// track them.
 	c.mu.Lock()
-	defer c.mu.Unlock()
 	for barID := range c.mu.serverBossBars {
 		deletePacket := &bossbar.BossBar{
 			ID:     barID,
 			Action: bossbar.RemoveAction,
 		}
 		if err = c.player.BufferPacket(deletePacket); err != nil {
-			return fmt.Errorf("error buffering boss bar remove packet for player: %w", err)
+			c.mu.Unlock()
+			return false, fmt.Errorf("error buffering boss bar remove packet for player: %w", err)
 		}
 	}
 	c.mu.serverBossBars = make(map[uuid.UUID]struct{}) // clear
-
-	// Tell the server about the proxy's plugin message channels.
-	serverVersion := serverMc.Protocol()
-	channels := c.proxy().ChannelRegistrar().ChannelsForProtocol(serverVersion)
-	if len(channels) != 0 {
-		channelsPacket := plugin.ConstructChannelsPacket(serverVersion, channels.UnsortedList()...)
-		if err = serverMc.BufferPacket(channelsPacket); err != nil {
-			return fmt.Errorf("error buffering %T for backend: %w", channelsPacket, err)
-		}
-	}
-	// Tell the server about this client's plugin message channels.
-	if c.player.clientsideChannels.Len() != 0 {
-		channelsPacket := plugin.ConstructChannelsPacket(serverVersion, c.player.clientsideChannels.UnsortedList()...)
-		if err = serverMc.BufferPacket(channelsPacket); err != nil {
-			return fmt.Errorf("error buffering %T for backend: %w", channelsPacket, err)
-		}
-	}
-
-	// If we had plugin messages queued during login/FML handshake, send them now.
-	for c.mu.loginPluginMessages.Len() != 0 {
-		pm := c.mu.loginPluginMessages.PopFront()
-		if err = serverMc.BufferPacket(pm); err != nil {
-			return fmt.Errorf("error buffering %T for backend: %w", pm, err)
-		}
-	}
+	c.mu.Unlock()
 
 	// Clear any title from the previous server.
 	if playerVersion.GreaterEqual(version.Minecraft_1_8) {
 		resetTitle, err := title.New(playerVersion, &title.Builder{Action: title.Reset})
 		if err != nil {
-			return err
+			return false, err
 		}
 		if err = c.player.BufferPacket(resetTitle); err != nil {
-			return fmt.Errorf("error buffering %T for player: %w", resetTitle, err)
+			return false, fmt.Errorf("error buffering %T for player: %w", resetTitle, err)
 		}
 	}
-
-	// Flush everything
-	if err = c.player.Flush(); err != nil {
-		return fmt.Errorf("error flushing buffered player packets: %w", err)
-	}
-	if serverMc.Flush() != nil {
-		return fmt.Errorf("error flushing buffered backend packets: %w", err)
-	}
-	destination.completeJoin()
-	return nil
+	return firstJoin, nil
 }
 
 func (c *clientPlaySessionHandler) doFastClientServerSwitch(joinGame *packet.JoinGame, playerVersion proto.Protocol) error {
@@ -901,6 +925,14 @@ func (c *clientPlaySessionHandler) handleCookieResponse(p *cpacket.CookieRespons
 	forwardCookieReceive(e, smc)
 }
 
+func (c *clientPlaySessionHandler) handleCustomClickAction(p *packet.CustomClickAction, pc *proto.PacketContext) {
+	e := newCustomClickActionEvent(c.player, p.ID, p.Payload)
+	c.proxy().event.Fire(e)
+	if e.Allowed() {
+		c.forwardToServer(pc)
+	}
+}
+
 // doSwitch handles switching stages for swapping between servers.
 func (c *clientPlaySessionHandler) doSwitch() *future.Future[any] {
 	c.log.V(1).WithName("doSwitch").Info("switching servers")
@@ -3,8 +3,11 @@ package proxy
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/go-logr/logr"
+	"go.minekube.com/common/minecraft/component/codec/legacy"
 	"go.minekube.com/gate/pkg/edition/java/forge/modinfo"
 	"go.minekube.com/gate/pkg/edition/java/netmc"
 	"go.minekube.com/gate/pkg/edition/java/ping"
@@ -64,12 +67,13 @@ func (h *statusSessionHandler) HandlePacket(pc *proto.PacketContext) {
 		return
 	}
 
-	switch pc.Packet.(type) {
+	switch typed := pc.Packet.(type) {
 	case *packet.StatusRequest:
 		h.handleStatusRequest(pc)
 	case *packet.StatusPing:
 		h.handleStatusPing(pc)
-	// TODO add LegacyPing
+	case *packet.LegacyPing:
+		h.handleLegacyPing(pc, typed)
 	default:
 		// unexpected packet, simply close
 		_ = h.conn.Close()
@@ -116,6 +120,7 @@ func (h *statusSessionHandler) handleStatusRequest(pc *proto.PacketContext) {
 	log := h.log
 	if h.resolvePingResponse == nil {
 		e.ping = newInitialPing(h.proxy, pc.Protocol)
+		h.proxy.applyPingPassthrough(h.conn.Context(), log, h.inbound, e.ping.Version.Protocol, e.ping)
 	} else {
 		var err error
 		var res *packet.StatusResponse
@@ -161,6 +166,82 @@ func (h *statusSessionHandler) handleStatusRequest(pc *proto.PacketContext) {
 	})
 }
 
+func (h *statusSessionHandler) handleLegacyPing(pc *proto.PacketContext, p *packet.LegacyPing) {
+	if h.receivedRequest {
+		// Already sent response
+		_ = h.conn.Close()
+		return
+	}
+	h.receivedRequest = true
+
+	e := &PingEvent{
+		inbound: h.inbound,
+		ping:    newInitialPing(h.proxy, pc.Protocol),
+	}
+	h.proxy.applyPingPassthrough(h.conn.Context(), h.log, h.inbound, e.ping.Version.Protocol, e.ping)
+
+	h.eventMgr.Fire(e)
+
+	if e.ping == nil {
+		_ = h.conn.Close()
+		h.log.V(1).Info("ping response was set to nil by an event handler, no response is sent")
+		return
+	}
+	if !h.inbound.Active() {
+		return
+	}
+	_ = netmc.CloseWith(h.conn, newLegacyDisconnect(e.ping, p.Version))
+}
+
+// newLegacyDisconnect returns the response to a legacy ping of the given version.
+func newLegacyDisconnect(p *ping.ServerPing, v packet.LegacyPingVersion) *packet.LegacyDisconnect {
+	players := p.Players
+	if players == nil {
+		players = &ping.Players{}
+	}
+	motd := new(strings.Builder)
+	if p.Description != nil {
+		_ = (&legacy.Legacy{}).Marshal(motd, p.Description)
+	}
+	// Legacy clients only show the first line.
+	firstLine, _, _ := strings.Cut(motd.String(), "\n")
+
+	if v == packet.LegacyPingVersion13 {
+		// Beta 1.8 to 1.3 clients use '§' as delimiter and don't support colors.
+		return &packet.LegacyDisconnect{Reason: strings.Join([]string{
+			stripLegacyCodes(firstLine),
+			strconv.Itoa(players.Online),
+			strconv.Itoa(players.Max),
+		}, "§")}
+	}
+	// 1.4 to 1.6 clients support more fields and color codes.
+	return &packet.LegacyDisconnect{Reason: strings.Join([]string{
+		"§1",
+		strconv.Itoa(int(p.Version.Protocol)),
+		p.Version.Name,
+		firstLine,
+		strconv.Itoa(players.Online),
+		strconv.Itoa(players.Max),
+	}, "\x00")}
+}
+
+// stripLegacyCodes removes '§' formatting codes from s.
+func stripLegacyCodes(s string) string {
+	b := new(strings.Builder)
+	skip := false
+	for _, r := range s {
+		switch {
+		case skip:
+			skip = false
+		case r == '§':
+			skip = true
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
 func (h *statusSessionHandler) handleStatusPing(p *proto.PacketContext) {
 	// Just return again and close
 	defer h.conn.Close()
@@ -0,0 +1,33 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/color"
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/edition/java/ping"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet"
+)
+
+func TestNewLegacyDisconnect(t *testing.T) {
+	p := &ping.ServerPing{
+		Version: ping.Version{Protocol: 767, Name: "Gate 1.7.2-1.21"},
+		Players: &ping.Players{Online: 3, Max: 100},
+		Description: &component.Text{
+			Content: "A Gate Proxy\nsecond line",
+			S:       component.Style{Color: color.Aqua},
+		},
+	}
+
+	d := newLegacyDisconnect(p, packet.LegacyPingVersion13)
+	require.Equal(t, "A Gate Proxy§3§100", d.Reason)
+
+	d = newLegacyDisconnect(p, packet.LegacyPingVersion16)
+	require.Equal(t, "§1\x00767\x00Gate 1.7.2-1.21\x00§bA Gate Proxy\x003\x00100", d.Reason)
+
+	p.Players = nil
+	d = newLegacyDisconnect(p, packet.LegacyPingVersion14)
+	require.Equal(t, "§1\x00767\x00Gate 1.7.2-1.21\x00§bA Gate Proxy\x000\x000", d.Reason)
+}
@@ -208,7 +208,11 @@ func (p *connectedPlayer) handleConnectionErr2(
 	if kickedFromCurrent {
 		next := p.nextServerToTry(rs)
 		if next == nil {
-			result = &DisconnectPlayerKickResult{Reason: friendlyReason}
+			if p.config().Limbo.Enabled {
+				result = &LimboKickResult{Message: limboMessage(p.config(), friendlyReason)}
+			} else {
+				result = &DisconnectPlayerKickResult{Reason: friendlyReason}
+			}
 		} else {
 			result = &RedirectPlayerKickResult{Server: next}
 		}
@@ -226,6 +230,7 @@ func (p *connectedPlayer) handleConnectionErr2(
 }
 
 func (p *connectedPlayer) handleKickEvent(e *KickedFromServerEvent, friendlyReason Component, kickedFromCurrent bool) {
+	recordKick(e.Server().ServerInfo().Name())
 	p.proxy.Event().Fire(e)
 
 	// There can't be any connection in flight now.
@@ -288,6 +293,10 @@ func (p *connectedPlayer) handleKickEvent(e *KickedFromServerEvent, friendlyReas
 		} else {
 			p.Disconnect(result.Message)
 		}
+	case *LimboKickResult:
+		if !p.proxy.limbo.park(p, result.Message) {
+			p.Disconnect(friendlyReason)
+		}
 	default:
 		// In case someone gets creative, assume we want to disconnect the player.
 		p.Disconnect(friendlyReason)
@@ -398,7 +407,10 @@ func (c *connectionRequest) internalConnect(ctx context.Context) (result *connec
 	conn := newServerConnection(server, c.previousServer, c.player)
 	c.player.setInFlightConnection(conn)
 	defer c.resetIfInFlightIs(conn)
-	return conn.connect(ctx)
+	start := time.Now()
+	result, err = conn.connect(ctx)
+	recordServerSwitch(server.ServerInfo().Name(), start, err == nil && result.Status().Successful())
+	return result, err
 }
 
 func (c *connectionRequest) resetIfInFlightIs(establishedConnection *serverConnection) {
@@ -0,0 +1,211 @@
+// Package query implements the GameSpy 4 (GS4) protocol used by
+// Minecraft servers to answer UDP query requests.
+//
+// See https://minecraft.wiki/w/Query for the protocol specification.
+package query
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Packet types of the query protocol.
+const (
+	TypeHandshake byte = 0x09
+	TypeStat      byte = 0x00
+)
+
+// magic is the prefix of every query request.
+var magic = [2]byte{0xFE, 0xFD}
+
+// Type is the type of stat requested by a client.
+type Type uint8
+
+const (
+	// BasicType is a basic stat request only asking for
+	// MOTD, game type, map, player counts and host.
+	BasicType Type = iota
+	// FullType is a full stat request also asking for the
+	// plugin list, version and the names of online players.
+	FullType
+)
+
+func (t Type) String() string {
+	switch t {
+	case BasicType:
+		return "basic"
+	case FullType:
+		return "full"
+	}
+	return "unknown"
+}
+
+// Request is a decoded query request.
+type Request struct {
+	Type      byte  // TypeHandshake or TypeStat
+	SessionID int32 // Echoed back in the response.
+	Token     int32 // The challenge token, only set for TypeStat.
+	Stat      Type  // The requested stat type, only set for TypeStat.
+}
+
+// ErrInvalidRequest is returned when a datagram is not a valid query request.
+var ErrInvalidRequest = errors.New("invalid query request")
+
+// DecodeRequest decodes a query request datagram.
+func DecodeRequest(b []byte) (*Request, error) {
+	if len(b) < 7 || b[0] != magic[0] || b[1] != magic[1] {
+		return nil, ErrInvalidRequest
+	}
+	req := &Request{
+		Type:      b[2],
+		SessionID: int32(binary.BigEndian.Uint32(b[3:7])),
+	}
+	switch req.Type {
+	case TypeHandshake:
+		return req, nil
+	case TypeStat:
+		if len(b) < 11 {
+			return nil, fmt.Errorf("%w: stat request too short", ErrInvalidRequest)
+		}
+		req.Token = int32(binary.BigEndian.Uint32(b[7:11]))
+		// A full stat request is padded with 4 additional bytes.
+		if len(b) >= 15 {
+			req.Stat = FullType
+		}
+		return req, nil
+	}
+	return nil, fmt.Errorf("%w: unknown type %#x", ErrInvalidRequest, req.Type)
+}
+
+// EncodeHandshake encodes the response to a handshake request
+// carrying the challenge token the client must send with stat requests.
+func EncodeHandshake(sessionID, token int32) []byte {
+	b := new(bytes.Buffer)
+	b.WriteByte(TypeHandshake)
+	_ = binary.Write(b, binary.BigEndian, sessionID)
+	writeString(b, strconv.Itoa(int(token)))
+	return b.Bytes()
+}
+
+// Plugin is a plugin listed in a full stat response.
+type Plugin struct {
+	Name    string
+	Version string // Optional
+}
+
+func (p Plugin) String() string {
+	if p.Version == "" {
+		return p.Name
+	}
+	return p.Name + " " + p.Version
+}
+
+// Response is the data returned to a stat request.
+type Response struct {
+	MOTD           string   // The message of the day in legacy format.
+	GameType       string   // Defaults to "SMP".
+	GameID         string   // Defaults to "MINECRAFT".
+	Map            string   // The name of the map.
+	Version        string   // The game version (only sent in full stat).
+	ProxyVersion   string   // The software version, prefixed to the plugin list (only sent in full stat).
+	Plugins        []Plugin // Only sent in full stat.
+	CurrentPlayers int
+	MaxPlayers     int
+	HostPort       uint16
+	HostIP         string
+	Players        []string // The player names (only sent in full stat).
+}
+
+// Encode encodes the response to the given stat type.
+func (r *Response) Encode(sessionID int32, typ Type) []byte {
+	b := new(bytes.Buffer)
+	b.WriteByte(TypeStat)
+	_ = binary.Write(b, binary.BigEndian, sessionID)
+
+	gameType := orDefault(r.GameType, "SMP")
+	if typ == BasicType {
+		writeString(b, r.MOTD)
+		writeString(b, gameType)
+		writeString(b, r.Map)
+		writeString(b, strconv.Itoa(r.CurrentPlayers))
+		writeString(b, strconv.Itoa(r.MaxPlayers))
+		_ = binary.Write(b, binary.LittleEndian, r.HostPort)
+		writeString(b, r.HostIP)
+		return b.Bytes()
+	}
+
+	// Full stat
+	b.Write(fullStatPadding)
+	for _, kv := range [][2]string{
+		{"hostname", r.MOTD},
+		{"gametype", gameType},
+		{"game_id", orDefault(r.GameID, "MINECRAFT")},
+		{"version", r.Version},
+		{"plugins", r.pluginsString()},
+		{"map", r.Map},
+		{"numplayers", strconv.Itoa(r.CurrentPlayers)},
+		{"maxplayers", strconv.Itoa(r.MaxPlayers)},
+		{"hostport", strconv.Itoa(int(r.HostPort))},
+		{"hostip", r.HostIP},
+	} {
+		writeString(b, kv[0])
+		writeString(b, kv[1])
+	}
+	b.WriteByte(0) // end of key-value section
+	b.Write(playersPadding)
+	for _, name := range r.Players {
+		writeString(b, name)
+	}
+	b.WriteByte(0) // end of players section
+	return b.Bytes()
+}
+
+// pluginsString formats the plugins the way Bukkit does:
+// "<software>: <plugin> <version>; <plugin> <version>"
+func (r *Response) pluginsString() string {
+	s := new(strings.Builder)
+	s.WriteString(r.ProxyVersion)
+	if len(r.Plugins) == 0 {
+		return s.String()
+	}
+	if s.Len() != 0 {
+		s.WriteString(": ")
+	}
+	for i, pl := range r.Plugins {
+		if i != 0 {
+			s.WriteString("; ")
+		}
+		s.WriteString(pl.String())
+	}
+	return s.String()
+}
+
+var (
+	fullStatPadding = []byte("splitnum\x00\x80\x00")
+	playersPadding  = []byte("\x01player_\x00\x00")
+)
+
+// writeString writes a null-terminated ISO-8859-1 string.
+func writeString(b *bytes.Buffer, s string) {
+	for _, r := range s {
+		if r == 0 {
+			continue
+		}
+		if r > 0xFF {
+			r = '?'
+		}
+		b.WriteByte(byte(r))
+	}
+	b.WriteByte(0)
+}
+
+func orDefault(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
@@ -0,0 +1,106 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeRequest(t *testing.T) {
+	_, err := DecodeRequest([]byte{0x01, 0x02, 0x09, 0, 0, 0, 1})
+	require.ErrorIs(t, err, ErrInvalidRequest)
+
+	req, err := DecodeRequest([]byte{0xFE, 0xFD, 0x09, 0, 0, 0, 1})
+	require.NoError(t, err)
+	require.Equal(t, TypeHandshake, req.Type)
+	require.Equal(t, int32(1), req.SessionID)
+
+	req, err = DecodeRequest([]byte{0xFE, 0xFD, 0x00, 0, 0, 0, 1, 0, 0, 0x30, 0x39})
+	require.NoError(t, err)
+	require.Equal(t, TypeStat, req.Type)
+	require.Equal(t, int32(12345), req.Token)
+	require.Equal(t, BasicType, req.Stat)
+
+	req, err = DecodeRequest([]byte{0xFE, 0xFD, 0x00, 0, 0, 0, 1, 0, 0, 0x30, 0x39, 0, 0, 0, 0})
+	require.NoError(t, err)
+	require.Equal(t, FullType, req.Stat)
+}
+
+func TestResponse_Encode(t *testing.T) {
+	r := &Response{
+		MOTD:           "A §bGate",
+		Map:            "Gate",
+		Version:        "1.21",
+		ProxyVersion:   "Gate v1",
+		Plugins:        []Plugin{{Name: "a"}, {Name: "b", Version: "2"}},
+		CurrentPlayers: 2,
+		MaxPlayers:     10,
+		HostPort:       25565,
+		HostIP:         "127.0.0.1",
+		Players:        []string{"alice", "bob"},
+	}
+
+	basic := r.Encode(7, BasicType)
+	require.Equal(t, TypeStat, basic[0])
+	require.Equal(t, uint32(7), binary.BigEndian.Uint32(basic[1:5]))
+	fields := bytes.SplitN(basic[5:], []byte{0}, 6)
+	require.Equal(t, []byte("A \xa7bGate"), fields[0])
+	require.Equal(t, "SMP", string(fields[1]))
+	require.Equal(t, "Gate", string(fields[2]))
+	require.Equal(t, "2", string(fields[3]))
+	require.Equal(t, "10", string(fields[4]))
+	require.Equal(t, uint16(25565), binary.LittleEndian.Uint16(fields[5][:2]))
+	require.Equal(t, "127.0.0.1\x00", string(fields[5][2:]))
+
+	full := r.Encode(7, FullType)
+	require.True(t, bytes.HasPrefix(full[5:], fullStatPadding))
+	require.Contains(t, string(full), "plugins\x00Gate v1: a; b 2\x00")
+	require.Contains(t, string(full), "game_id\x00MINECRAFT\x00")
+	require.True(t, bytes.HasSuffix(full, []byte("\x01player_\x00\x00alice\x00bob\x00\x00")))
+}
+
+func TestServer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ln, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := &Server{Response: func(_ context.Context, _ net.Addr, typ Type) *Response {
+		return &Response{MOTD: typ.String(), MaxPlayers: 5}
+	}}
+	go func() { _ = srv.Serve(ctx, ln) }()
+
+	conn, err := net.Dial("udp", ln.LocalAddr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	buf := make([]byte, 1024)
+	roundTrip := func(req []byte) []byte {
+		_, err := conn.Write(req)
+		require.NoError(t, err)
+		n, err := conn.Read(buf)
+		require.NoError(t, err)
+		return buf[:n]
+	}
+
+	res := roundTrip([]byte{0xFE, 0xFD, TypeHandshake, 0, 0, 0, 3})
+	require.Equal(t, TypeHandshake, res[0])
+	token, err := strconv.Atoi(string(bytes.TrimSuffix(res[5:], []byte{0})))
+	require.NoError(t, err)
+
+	stat := binary.BigEndian.AppendUint32([]byte{0xFE, 0xFD, TypeStat, 0, 0, 0, 3}, uint32(int32(token)))
+	res = roundTrip(stat)
+	require.Equal(t, TypeStat, res[0])
+	require.True(t, bytes.HasPrefix(res[5:], []byte("basic\x00")))
+
+	res = roundTrip(append(stat, 0, 0, 0, 0))
+	require.Contains(t, string(res), "hostname\x00full\x00")
+}
@@ -0,0 +1,105 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/jellydator/ttlcache/v3"
+)
+
+// ResponseFunc returns the response to a stat request of the given type.
+// Returning nil drops the request without replying.
+type ResponseFunc func(ctx context.Context, addr net.Addr, typ Type) *Response
+
+// DefaultChallengeTTL is the default time a challenge token is valid for.
+const DefaultChallengeTTL = 30 * time.Second
+
+// Server is a query protocol server answering UDP query requests.
+type Server struct {
+	// Response is called to retrieve the response for a stat request.
+	Response ResponseFunc
+	// ChallengeTTL is the time a handshake challenge token is valid for.
+	// If zero, DefaultChallengeTTL is used.
+	ChallengeTTL time.Duration
+}
+
+// maxDatagramSize is the maximum size of a query request.
+// Requests are tiny, but we allow some slack.
+const maxDatagramSize = 64
+
+// ListenAndServe listens on the UDP address and serves query
+// requests until the context is canceled.
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	var lc net.ListenConfig
+	conn, err := lc.ListenPacket(ctx, "udp", addr)
+	if err != nil {
+		return err
+	}
+	return s.Serve(ctx, conn)
+}
+
+// Serve serves query requests on the packet connection until
+// the context is canceled. The connection is closed on return.
+func (s *Server) Serve(ctx context.Context, conn net.PacketConn) error {
+	defer func() { _ = conn.Close() }()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() { <-ctx.Done(); _ = conn.Close() }()
+
+	log := logr.FromContextOrDiscard(ctx)
+
+	ttl := s.ChallengeTTL
+	if ttl <= 0 {
+		ttl = DefaultChallengeTTL
+	}
+	challenges := ttlcache.New[string, int32](ttlcache.WithTTL[string, int32](ttl))
+	go challenges.Start()
+	defer challenges.Stop()
+
+	buf := make([]byte, maxDatagramSize)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+
+		req, err := DecodeRequest(buf[:n])
+		if err != nil {
+			log.V(2).Info("dropping invalid query request", "remoteAddr", addr, "error", err)
+			continue
+		}
+
+		var res []byte
+		switch req.Type {
+		case TypeHandshake:
+			token := rand.Int32()
+			challenges.Set(addr.String(), token, ttlcache.DefaultTTL)
+			res = EncodeHandshake(req.SessionID, token)
+		case TypeStat:
+			item := challenges.Get(addr.String(), ttlcache.WithDisableTouchOnHit[string, int32]())
+			if item == nil || item.Value() != req.Token {
+				log.V(2).Info("dropping query request with invalid challenge token", "remoteAddr", addr)
+				continue
+			}
+			r := s.Response(ctx, addr, req.Stat)
+			if r == nil {
+				continue
+			}
+			res = r.Encode(req.SessionID, req.Stat)
+		}
+
+		if _, err = conn.WriteTo(res, addr); err != nil {
+			log.V(1).Info("error writing query response", "remoteAddr", addr, "error", err)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+// Package rcon implements the Source RCON protocol used by
+// Minecraft servers to execute commands remotely over TCP.
+//
+// See https://minecraft.wiki/w/RCON for the protocol specification.
+package rcon
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Packet types of the RCON protocol.
+// Note that TypeCommand and TypeAuthResponse share the same value.
+const (
+	TypeResponse     int32 = 0 // The response to a command.
+	TypeCommand      int32 = 2 // A command to execute.
+	TypeAuthResponse int32 = 2 // The response to a login request.
+	TypeAuth         int32 = 3 // A login request with the password as body.
+)
+
+// AuthFailedID is the request id of an auth response to a wrong password.
+const AuthFailedID int32 = -1
+
+const (
+	// MaxRequestBodySize is the maximum body size of a packet sent by clients.
+	MaxRequestBodySize = 1446
+	// MaxResponseBodySize is the maximum body size of a single response packet.
+	// Longer responses are split into multiple packets.
+	MaxResponseBodySize = 4096
+
+	// headerSize is the size of the request id and type.
+	headerSize = 8
+	// minPacketSize is the size of a packet with an empty body
+	// including the body and padding null terminators.
+	minPacketSize = headerSize + 2
+)
+
+// ErrInvalidPacket is returned when reading a malformed packet.
+var ErrInvalidPacket = errors.New("invalid rcon packet")
+
+// Packet is an RCON packet.
+type Packet struct {
+	RequestID int32
+	Type      int32
+	Body      string
+}
+
+// ReadPacket reads a packet whose body is at most maxBodySize bytes long.
+func ReadPacket(rd io.Reader, maxBodySize int) (*Packet, error) {
+	var length int32
+	if err := binary.Read(rd, binary.LittleEndian, &length); err != nil {
+		return nil, err
+	}
+	if length < minPacketSize || int(length) > minPacketSize+maxBodySize {
+		return nil, fmt.Errorf("%w: length %d", ErrInvalidPacket, length)
+	}
+	b := make([]byte, length)
+	if _, err := io.ReadFull(rd, b); err != nil {
+		return nil, err
+	}
+	if b[length-1] != 0 || b[length-2] != 0 {
+		return nil, fmt.Errorf("%w: missing null terminators", ErrInvalidPacket)
+	}
+	return &Packet{
+		RequestID: int32(binary.LittleEndian.Uint32(b[0:4])),
+		Type:      int32(binary.LittleEndian.Uint32(b[4:8])),
+		Body:      string(b[headerSize : length-2]),
+	}, nil
+}
+
+// WritePacket writes the packet in a single write.
+func WritePacket(wr io.Writer, p *Packet) error {
+	buf := bytes.NewBuffer(make([]byte, 0, 4+minPacketSize+len(p.Body)))
+	_ = binary.Write(buf, binary.LittleEndian, int32(minPacketSize+len(p.Body)))
+	_ = binary.Write(buf, binary.LittleEndian, p.RequestID)
+	_ = binary.Write(buf, binary.LittleEndian, p.Type)
+	buf.WriteString(p.Body)
+	buf.Write([]byte{0, 0})
+	_, err := wr.Write(buf.Bytes())
+	return err
+}
+
+// WriteResponse writes the body as response packets to the request id,
+// splitting it into multiple packets if it exceeds MaxResponseBodySize.
+func WriteResponse(wr io.Writer, requestID int32, body string) error {
+	for {
+		chunk := body
+		if len(chunk) > MaxResponseBodySize {
+			chunk = chunk[:MaxResponseBodySize]
+		}
+		body = body[len(chunk):]
+		if err := WritePacket(wr, &Packet{RequestID: requestID, Type: TypeResponse, Body: chunk}); err != nil {
+			return err
+		}
+		if body == "" {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package rcon
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPacket(t *testing.T) {
+	buf := new(bytes.Buffer)
+	require.NoError(t, WritePacket(buf, &Packet{RequestID: 7, Type: TypeAuth, Body: "pw"}))
+	require.Equal(t, []byte{
+		12, 0, 0, 0, // length
+		7, 0, 0, 0, // request id
+		3, 0, 0, 0, // type
+		'p', 'w', 0, 0,
+	}, buf.Bytes())
+
+	p, err := ReadPacket(buf, MaxRequestBodySize)
+	require.NoError(t, err)
+	require.Equal(t, &Packet{RequestID: 7, Type: TypeAuth, Body: "pw"}, p)
+
+	_, err = ReadPacket(bytes.NewReader([]byte{9, 0, 0, 0}), MaxRequestBodySize)
+	require.ErrorIs(t, err, ErrInvalidPacket)
+
+	require.NoError(t, WritePacket(buf, &Packet{Body: strings.Repeat("a", 20)}))
+	_, err = ReadPacket(buf, 10)
+	require.ErrorIs(t, err, ErrInvalidPacket)
+}
+
+func TestWriteResponse(t *testing.T) {
+	buf := new(bytes.Buffer)
+	body := strings.Repeat("a", MaxResponseBodySize+1)
+	require.NoError(t, WriteResponse(buf, 3, body))
+
+	p, err := ReadPacket(buf, MaxResponseBodySize)
+	require.NoError(t, err)
+	require.Len(t, p.Body, MaxResponseBodySize)
+	p, err = ReadPacket(buf, MaxResponseBodySize)
+	require.NoError(t, err)
+	require.Equal(t, "a", p.Body)
+	require.Equal(t, int32(3), p.RequestID)
+	require.Zero(t, buf.Len())
+}
+
+func TestServer(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := &Server{
+		Password: "secret",
+		Execute: func(_ context.Context, _ net.Addr, command string) string {
+			return "ran " + command
+		},
+	}
+	done := make(chan error)
+	go func() { done <- srv.Serve(ctx, ln) }()
+
+	dial := func() net.Conn {
+		conn, err := net.Dial("tcp", ln.Addr().String())
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = conn.Close() })
+		return conn
+	}
+	roundTrip := func(conn net.Conn, p *Packet) *Packet {
+		require.NoError(t, WritePacket(conn, p))
+		res, err := ReadPacket(conn, MaxResponseBodySize)
+		require.NoError(t, err)
+		return res
+	}
+
+	// Wrong password
+	conn := dial()
+	res := roundTrip(conn, &Packet{RequestID: 1, Type: TypeAuth, Body: "wrong"})
+	require.Equal(t, AuthFailedID, res.RequestID)
+
+	// Command before authentication
+	conn = dial()
+	res = roundTrip(conn, &Packet{RequestID: 1, Type: TypeCommand, Body: "glist"})
+	require.Equal(t, AuthFailedID, res.RequestID)
+
+	conn = dial()
+	res = roundTrip(conn, &Packet{RequestID: 1, Type: TypeAuth, Body: "secret"})
+	require.Equal(t, &Packet{RequestID: 1, Type: TypeAuthResponse}, res)
+	res = roundTrip(conn, &Packet{RequestID: 2, Type: TypeCommand, Body: "glist"})
+	require.Equal(t, &Packet{RequestID: 2, Type: TypeResponse, Body: "ran glist"}, res)
+	res = roundTrip(conn, &Packet{RequestID: 3, Type: TypeResponse})
+	require.Equal(t, &Packet{RequestID: 3, Type: TypeResponse}, res)
+
+	// Changed password applies to new logins
+	srv.SetPassword("changed")
+	res = roundTrip(dial(), &Packet{RequestID: 1, Type: TypeAuth, Body: "secret"})
+	require.Equal(t, AuthFailedID, res.RequestID)
+	res = roundTrip(dial(), &Packet{RequestID: 1, Type: TypeAuth, Body: "changed"})
+	require.Equal(t, &Packet{RequestID: 1, Type: TypeAuthResponse}, res)
+	res = roundTrip(conn, &Packet{RequestID: 4, Type: TypeCommand, Body: "glist"})
+	require.Equal(t, "ran glist", res.Body, "logged in clients stay connected")
+
+	cancel()
+	require.NoError(t, <-done)
+}
@@ -0,0 +1,130 @@
+package rcon
+
+import (
+	"bufio"
+	"context"
+	"crypto/subtle"
+	"errors"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/go-logr/logr"
+)
+
+// ExecuteFunc executes the command received from the remote address
+// and returns the output to respond with.
+type ExecuteFunc func(ctx context.Context, addr net.Addr, command string) string
+
+// Server is an RCON server executing commands of authenticated clients.
+type Server struct {
+	// Password is the password clients must log in with.
+	// An empty password rejects all clients.
+	// Use SetPassword to change it while serving.
+	Password string
+	// Execute is called to execute a command.
+	Execute ExecuteFunc
+
+	mu sync.RWMutex // protects Password
+}
+
+// SetPassword changes the password for clients logging in from now on.
+// Clients already logged in stay connected.
+func (s *Server) SetPassword(password string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Password = password
+}
+
+func (s *Server) password() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.Password
+}
+
+// ListenAndServe listens on the TCP address and serves RCON
+// clients until the context is canceled.
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	var lc net.ListenConfig
+	ln, err := lc.Listen(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	return s.Serve(ctx, ln)
+}
+
+// Serve serves RCON clients on the listener until the context is canceled.
+// The listener and all client connections are closed on return.
+func (s *Server) Serve(ctx context.Context, ln net.Listener) error {
+	defer func() { _ = ln.Close() }()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() { <-ctx.Done(); _ = ln.Close() }()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.serveConn(ctx, conn)
+		}()
+	}
+}
+
+// serveConn serves a client until it disconnects or the context is canceled.
+func (s *Server) serveConn(ctx context.Context, conn net.Conn) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() { <-ctx.Done(); _ = conn.Close() }()
+
+	log := logr.FromContextOrDiscard(ctx).WithValues("remoteAddr", conn.RemoteAddr())
+	rd := bufio.NewReader(conn)
+	authenticated := false
+	for {
+		p, err := ReadPacket(rd, MaxRequestBodySize)
+		if err != nil {
+			if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+				log.V(1).Info("error reading rcon packet", "error", err)
+			}
+			return
+		}
+
+		switch {
+		case p.Type == TypeAuth:
+			password := s.password()
+			if password == "" || subtle.ConstantTimeCompare([]byte(p.Body), []byte(password)) != 1 {
+				log.Info("rcon client failed to authenticate")
+				_ = WritePacket(conn, &Packet{RequestID: AuthFailedID, Type: TypeAuthResponse})
+				return
+			}
+			authenticated = true
+			log.V(1).Info("rcon client authenticated")
+			err = WritePacket(conn, &Packet{RequestID: p.RequestID, Type: TypeAuthResponse})
+		case !authenticated:
+			log.V(1).Info("rcon client sent packet before authenticating", "type", p.Type)
+			_ = WritePacket(conn, &Packet{RequestID: AuthFailedID, Type: TypeAuthResponse})
+			return
+		case p.Type == TypeCommand:
+			err = WriteResponse(conn, p.RequestID, s.Execute(ctx, conn.RemoteAddr(), p.Body))
+		default:
+			// Clients send an empty response packet after a command to detect
+			// the end of a multi-packet response, which we mirror back.
+			err = WritePacket(conn, &Packet{RequestID: p.RequestID, Type: TypeResponse})
+		}
+		if err != nil {
+			log.V(1).Info("error writing rcon packet", "error", err)
+			return
+		}
+	}
+}
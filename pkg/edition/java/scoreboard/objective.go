@@ -0,0 +1,164 @@
+package scoreboard
+
+import (
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/edition/java/proto/packet/chat"
+	packet "go.minekube.com/gate/pkg/edition/java/proto/packet/scoreboard"
+	"go.minekube.com/gate/pkg/gate/proto"
+)
+
+// Objective is an objective of a Scoreboard holding the scores of entities (e.g. player names).
+type Objective struct {
+	board        *Scoreboard
+	name         string // immutable
+	displayName  component.Component
+	renderType   RenderType
+	numberFormat *NumberFormat
+	scores       map[string]Score // by entity
+}
+
+// Score is the score of an entity in an objective.
+type Score struct {
+	Value        int
+	DisplayName  component.Component // Since 1.20.3, nil shows the entity name
+	NumberFormat *NumberFormat       // Since 1.20.3, nil uses the objective's number format
+}
+
+// Name returns the name of the objective.
+func (o *Objective) Name() string { return o.name }
+
+// DisplayName returns the display name of the objective.
+func (o *Objective) DisplayName() component.Component {
+	o.board.mu.Lock()
+	defer o.board.mu.Unlock()
+	return o.displayName
+}
+
+// SetDisplayName sets the display name of the objective.
+func (o *Objective) SetDisplayName(displayName component.Component) {
+	o.board.mu.Lock()
+	defer o.board.mu.Unlock()
+	o.displayName = displayName
+	o.update()
+}
+
+// RenderType returns the render type of the objective.
+func (o *Objective) RenderType() RenderType {
+	o.board.mu.Lock()
+	defer o.board.mu.Unlock()
+	return o.renderType
+}
+
+// SetRenderType sets the render type of the objective.
+func (o *Objective) SetRenderType(renderType RenderType) {
+	o.board.mu.Lock()
+	defer o.board.mu.Unlock()
+	if o.renderType == renderType {
+		return
+	}
+	o.renderType = renderType
+	o.update()
+}
+
+// NumberFormat returns the number format of the objective or nil if default.
+func (o *Objective) NumberFormat() *NumberFormat {
+	o.board.mu.Lock()
+	defer o.board.mu.Unlock()
+	return o.numberFormat
+}
+
+// SetNumberFormat sets the number format of the objective's scores since 1.20.3.
+// A nil format shows the score values.
+func (o *Objective) SetNumberFormat(format *NumberFormat) {
+	o.board.mu.Lock()
+	defer o.board.mu.Unlock()
+	o.numberFormat = format
+	o.update()
+}
+
+// Score returns the score of the entity.
+func (o *Objective) Score(entity string) (Score, bool) {
+	o.board.mu.Lock()
+	defer o.board.mu.Unlock()
+	score, ok := o.scores[entity]
+	return score, ok
+}
+
+// Scores returns the scores of the objective by entity.
+func (o *Objective) Scores() map[string]Score {
+	o.board.mu.Lock()
+	defer o.board.mu.Unlock()
+	scores := make(map[string]Score, len(o.scores))
+	for entity, score := range o.scores {
+		scores[entity] = score
+	}
+	return scores
+}
+
+// SetScore sets the score of the entity.
+func (o *Objective) SetScore(entity string, score Score) {
+	o.board.mu.Lock()
+	defer o.board.mu.Unlock()
+	o.scores[entity] = score
+	if o.registered() {
+		o.board.write(o.scorePacket(entity, score))
+	}
+}
+
+// RemoveScore removes the score of the entity.
+func (o *Objective) RemoveScore(entity string) {
+	o.board.mu.Lock()
+	defer o.board.mu.Unlock()
+	if _, ok := o.scores[entity]; !ok {
+		return
+	}
+	delete(o.scores, entity)
+	if !o.registered() {
+		return
+	}
+	for _, v := range o.board.viewers {
+		if !v.synced {
+			continue
+		}
+		var p proto.Packet
+		if supportsResetScore(v.Viewer) {
+			p = &packet.ResetScore{Entity: entity, Objective: o.name}
+		} else {
+			p = &packet.Score{Entity: entity, Action: packet.RemoveScoreAction, Objective: o.name}
+		}
+		_ = v.WritePacket(p)
+	}
+}
+
+// registered returns true if the objective was not removed from the scoreboard.
+func (o *Objective) registered() bool {
+	return o.board.objective(o.name) == o
+}
+
+func (o *Objective) update() {
+	if o.registered() {
+		o.board.write(o.createPacket(packet.UpdateObjectiveMode))
+	}
+}
+
+func (o *Objective) createPacket(mode packet.ObjectiveMode) *packet.Objective {
+	return &packet.Objective{
+		Name:         o.name,
+		Mode:         mode,
+		DisplayName:  chat.FromComponent(o.displayName),
+		RenderType:   o.renderType,
+		NumberFormat: o.numberFormat,
+	}
+}
+
+func (o *Objective) scorePacket(entity string, score Score) *packet.Score {
+	return &packet.Score{
+		Entity:       entity,
+		Action:       packet.UpdateScoreAction,
+		Objective:    o.name,
+		Value:        score.Value,
+		DisplayName:  chat.FromComponent(score.DisplayName),
+		NumberFormat: score.NumberFormat,
+	}
+}
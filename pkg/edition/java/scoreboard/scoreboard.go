@@ -0,0 +1,382 @@
+// Package scoreboard provides proxy-side scoreboards with objectives, scores and teams
+// that are shown to players independent of the server they are connected to.
+//
+// The proxy sends the scoreboards a player views again after switching servers,
+// so network-wide sidebars, below-name scores and teams work without a plugin on every server.
+// Names of objectives and teams should not collide with the names used by backend servers.
+package scoreboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/edition/java/internal/methods"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet/chat"
+	packet "go.minekube.com/gate/pkg/edition/java/proto/packet/scoreboard"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+	"go.minekube.com/gate/pkg/gate/proto"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+// Viewer is the interface for a scoreboard viewer (e.g. a player).
+type Viewer interface {
+	ID() uuid.UUID
+	Context() context.Context
+	proto.PacketWriter
+}
+
+// MaxNameLength is the max length of objective and team names supported by all clients.
+const MaxNameLength = 16
+
+var (
+	// ErrNameTaken is returned when creating an objective or team with a name already in use.
+	ErrNameTaken = errors.New("name already in use")
+	// ErrInvalidName is returned when creating an objective or team with an invalid name.
+	ErrInvalidName = errors.New("invalid name")
+)
+
+// Scoreboard is a set of objectives and teams shown to its viewers.
+// It is safe for concurrent use.
+type Scoreboard struct {
+	mu         sync.Mutex // protects following fields and serializes packet writes
+	viewers    map[uuid.UUID]*boardViewer
+	objectives []*Objective
+	teams      []*Team
+	slots      map[DisplaySlot]*Objective
+}
+
+type boardViewer struct {
+	Viewer
+	// synced is false while the client's scoreboard is reset and the viewer waits to be synced
+	synced  bool
+	removed context.CancelFunc
+}
+
+// New returns a new empty scoreboard.
+func New() *Scoreboard {
+	return &Scoreboard{
+		viewers: map[uuid.UUID]*boardViewer{},
+		slots:   map[DisplaySlot]*Objective{},
+	}
+}
+
+// AddViewer shows the scoreboard to the viewer.
+func (s *Scoreboard) AddViewer(viewer Viewer) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.viewers[viewer.ID()]; ok {
+		return nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	v := &boardViewer{Viewer: viewer, synced: true, removed: cancel}
+	s.viewers[viewer.ID()] = v
+	track(viewer.ID(), s)
+	go func() {
+		select {
+		case <-viewer.Context().Done():
+			s.mu.Lock()
+			s.removeViewer(viewer.ID())
+			s.mu.Unlock()
+		case <-ctx.Done(): // removed by RemoveViewer
+		}
+	}()
+	return s.writeAll(v)
+}
+
+// RemoveViewer removes the scoreboard from the viewer.
+func (s *Scoreboard) RemoveViewer(viewer Viewer) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.viewers[viewer.ID()]
+	if !ok {
+		return nil
+	}
+	s.removeViewer(viewer.ID())
+	if !v.synced {
+		return nil
+	}
+	var err error
+	for _, o := range s.objectives {
+		err = errors.Join(err, v.WritePacket(&packet.Objective{Name: o.name, Mode: packet.RemoveObjectiveMode}))
+	}
+	for _, t := range s.teams {
+		err = errors.Join(err, v.WritePacket(&packet.Team{Name: t.name, Mode: packet.RemoveTeamMode}))
+	}
+	return err
+}
+
+func (s *Scoreboard) removeViewer(id uuid.UUID) {
+	if v, ok := s.viewers[id]; ok {
+		delete(s.viewers, id)
+		v.removed()
+		untrack(id, s)
+	}
+}
+
+// Viewers returns the viewers of the scoreboard.
+func (s *Scoreboard) Viewers() []Viewer {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	viewers := make([]Viewer, 0, len(s.viewers))
+	for _, v := range s.viewers {
+		viewers = append(viewers, v.Viewer)
+	}
+	return viewers
+}
+
+// RemoveAllViewers removes the scoreboard from all viewers.
+func (s *Scoreboard) RemoveAllViewers() {
+	for _, v := range s.Viewers() {
+		_ = s.RemoveViewer(v)
+	}
+}
+
+// writeAll writes the whole scoreboard to the viewer.
+func (s *Scoreboard) writeAll(v *boardViewer) error {
+	var pks []proto.Packet
+	for _, o := range s.objectives {
+		pks = append(pks, o.createPacket(packet.CreateObjectiveMode))
+		for entity, score := range o.scores {
+			pks = append(pks, o.scorePacket(entity, score))
+		}
+	}
+	for slot, o := range s.slots {
+		pks = append(pks, &packet.DisplayObjective{Slot: slot, Objective: o.name})
+	}
+	for _, t := range s.teams {
+		pks = append(pks, t.createPacket(packet.CreateTeamMode, t.entries))
+	}
+	for _, p := range pks {
+		if err := v.WritePacket(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// write writes the packets to all synced viewers.
+// Errors are ignored as viewers not in the play state are synced later.
+func (s *Scoreboard) write(pks ...proto.Packet) {
+	for _, v := range s.viewers {
+		if !v.synced {
+			continue
+		}
+		for _, p := range pks {
+			_ = v.WritePacket(p)
+		}
+	}
+}
+
+// NewObjective creates a new objective.
+// The name must be unique in the scoreboard and at most MaxNameLength characters long.
+func (s *Scoreboard) NewObjective(name string, displayName component.Component, renderType RenderType) (*Objective, error) {
+	if err := validName(name); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.objective(name) != nil {
+		return nil, fmt.Errorf("%w: objective %q", ErrNameTaken, name)
+	}
+	o := &Objective{
+		board:       s,
+		name:        name,
+		displayName: displayName,
+		renderType:  renderType,
+		scores:      map[string]Score{},
+	}
+	s.objectives = append(s.objectives, o)
+	s.write(o.createPacket(packet.CreateObjectiveMode))
+	return o, nil
+}
+
+// Objective returns the objective with the name or nil if not found.
+func (s *Scoreboard) Objective(name string) *Objective {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.objective(name)
+}
+
+func (s *Scoreboard) objective(name string) *Objective {
+	for _, o := range s.objectives {
+		if o.name == name {
+			return o
+		}
+	}
+	return nil
+}
+
+// Objectives returns the objectives of the scoreboard.
+func (s *Scoreboard) Objectives() []*Objective {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Objective(nil), s.objectives...)
+}
+
+// RemoveObjective removes the objective and its scores.
+func (s *Scoreboard) RemoveObjective(o *Objective) {
+	if o == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if o.board != s || !remove(&s.objectives, o) {
+		return
+	}
+	for slot, displayed := range s.slots {
+		if displayed == o {
+			delete(s.slots, slot)
+		}
+	}
+	s.write(&packet.Objective{Name: o.name, Mode: packet.RemoveObjectiveMode})
+}
+
+// SetDisplaySlot displays the objective in the slot.
+// A nil objective clears the slot.
+func (s *Scoreboard) SetDisplaySlot(slot DisplaySlot, o *Objective) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if o == nil {
+		if _, ok := s.slots[slot]; !ok {
+			return
+		}
+		delete(s.slots, slot)
+		s.write(&packet.DisplayObjective{Slot: slot})
+		return
+	}
+	if o.board != s || s.objective(o.name) != o || s.slots[slot] == o {
+		return
+	}
+	s.slots[slot] = o
+	s.write(&packet.DisplayObjective{Slot: slot, Objective: o.name})
+}
+
+// DisplaySlot returns the objective displayed in the slot or nil if none.
+func (s *Scoreboard) DisplaySlot(slot DisplaySlot) *Objective {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.slots[slot]
+}
+
+// NewTeam creates a new team.
+// The name must be unique in the scoreboard and at most MaxNameLength characters long.
+func (s *Scoreboard) NewTeam(name string) (*Team, error) {
+	if err := validName(name); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.team(name) != nil {
+		return nil, fmt.Errorf("%w: team %q", ErrNameTaken, name)
+	}
+	t := &Team{
+		board:             s,
+		name:              name,
+		color:             ResetTeamColor,
+		nameTagVisibility: AlwaysNameTagVisibility,
+		collisionRule:     AlwaysCollisionRule,
+	}
+	s.teams = append(s.teams, t)
+	s.write(t.createPacket(packet.CreateTeamMode, nil))
+	return t, nil
+}
+
+// Team returns the team with the name or nil if not found.
+func (s *Scoreboard) Team(name string) *Team {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.team(name)
+}
+
+func (s *Scoreboard) team(name string) *Team {
+	for _, t := range s.teams {
+		if t.name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+// Teams returns the teams of the scoreboard.
+func (s *Scoreboard) Teams() []*Team {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Team(nil), s.teams...)
+}
+
+// RemoveTeam removes the team.
+func (s *Scoreboard) RemoveTeam(t *Team) {
+	if t == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if t.board != s || !remove(&s.teams, t) {
+		return
+	}
+	s.write(&packet.Team{Name: t.name, Mode: packet.RemoveTeamMode})
+}
+
+// DisplaySlot is a slot an objective can be displayed in.
+type DisplaySlot = packet.DisplaySlot
+
+// Available display slots.
+const (
+	ListDisplaySlot      = packet.ListDisplaySlot
+	SidebarDisplaySlot   = packet.SidebarDisplaySlot
+	BelowNameDisplaySlot = packet.BelowNameDisplaySlot
+)
+
+// TeamSidebarDisplaySlot returns the sidebar slot shown to members of teams with the color since 1.8.
+func TeamSidebarDisplaySlot(color TeamColor) DisplaySlot {
+	return SidebarDisplaySlot + 2 + DisplaySlot(color)
+}
+
+// RenderType is how the scores of an objective are rendered in the tab list.
+type RenderType = packet.RenderType
+
+// Available render types.
+const (
+	IntegerRenderType = packet.IntegerRenderType
+	HeartsRenderType  = packet.HeartsRenderType
+)
+
+// NumberFormat is how scores are displayed since 1.20.3.
+type NumberFormat = packet.NumberFormat
+
+// BlankNumberFormat returns a number format that hides scores.
+func BlankNumberFormat() *NumberFormat {
+	return &NumberFormat{Type: packet.BlankNumberFormat}
+}
+
+// FixedNumberFormat returns a number format that shows the text instead of scores.
+func FixedNumberFormat(text component.Component) *NumberFormat {
+	return &NumberFormat{Type: packet.FixedNumberFormat, Fixed: chat.FromComponent(text)}
+}
+
+func validName(name string) error {
+	if name == "" || len([]rune(name)) > MaxNameLength {
+		return fmt.Errorf("%w: %q must be 1 to %d characters long", ErrInvalidName, name, MaxNameLength)
+	}
+	return nil
+}
+
+func remove[T comparable](s *[]T, v T) bool {
+	for i, e := range *s {
+		if e == v {
+			*s = append((*s)[:i], (*s)[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// supportsResetScore returns true if the viewer removes scores with the reset score packet.
+func supportsResetScore(viewer Viewer) bool {
+	p, ok := methods.Protocol(viewer)
+	return !ok || p.GreaterEqual(version.Minecraft_1_20_3)
+}
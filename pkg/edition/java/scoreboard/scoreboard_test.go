@@ -0,0 +1,153 @@
+package scoreboard
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/component"
+
+	packet "go.minekube.com/gate/pkg/edition/java/proto/packet/scoreboard"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+	"go.minekube.com/gate/pkg/gate/proto"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+type testViewer struct {
+	id       uuid.UUID
+	ctx      context.Context
+	protocol proto.Protocol
+
+	mu      sync.Mutex
+	packets []proto.Packet
+}
+
+func newTestViewer(t *testing.T, protocol *proto.Version) *testViewer {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	return &testViewer{id: uuid.New(), ctx: ctx, protocol: protocol.Protocol}
+}
+
+func (v *testViewer) ID() uuid.UUID            { return v.id }
+func (v *testViewer) Context() context.Context { return v.ctx }
+func (v *testViewer) Protocol() proto.Protocol { return v.protocol }
+func (v *testViewer) WritePacket(p proto.Packet) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.packets = append(v.packets, p)
+	return nil
+}
+
+// take returns and clears the written packets.
+func (v *testViewer) take() []proto.Packet {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	p := v.packets
+	v.packets = nil
+	return p
+}
+
+func TestScoreboard(t *testing.T) {
+	s := New()
+	o, err := s.NewObjective("kills", &component.Text{Content: "Kills"}, IntegerRenderType)
+	require.NoError(t, err)
+	o.SetScore("Steve", Score{Value: 3})
+	s.SetDisplaySlot(BelowNameDisplaySlot, o)
+
+	_, err = s.NewObjective("kills", nil, IntegerRenderType)
+	require.ErrorIs(t, err, ErrNameTaken)
+	_, err = s.NewTeam("this-name-is-too-long")
+	require.ErrorIs(t, err, ErrInvalidName)
+
+	team, err := s.NewTeam("red")
+	require.NoError(t, err)
+	team.AddEntries("Steve")
+
+	// New viewers get the whole scoreboard
+	v := newTestViewer(t, version.Minecraft_1_20_3)
+	require.NoError(t, s.AddViewer(v))
+	require.Equal(t, []proto.Packet{
+		o.createPacket(packet.CreateObjectiveMode),
+		o.scorePacket("Steve", Score{Value: 3}),
+		&packet.DisplayObjective{Slot: BelowNameDisplaySlot, Objective: "kills"},
+		team.createPacket(packet.CreateTeamMode, []string{"Steve"}),
+	}, v.take())
+
+	// Updates are sent to viewers
+	o.SetScore("Alex", Score{Value: 1})
+	team.SetColor(RedTeamColor)
+	o.RemoveScore("Alex")
+	require.Equal(t, []proto.Packet{
+		o.scorePacket("Alex", Score{Value: 1}),
+		team.createPacket(packet.UpdateTeamMode, nil),
+		&packet.ResetScore{Entity: "Alex", Objective: "kills"},
+	}, v.take())
+
+	// Clients before 1.20.3 remove scores with the score packet
+	legacy := newTestViewer(t, version.Minecraft_1_8)
+	require.NoError(t, s.AddViewer(legacy))
+	legacy.take()
+	o.SetScore("Alex", Score{Value: 1})
+	o.RemoveScore("Alex")
+	require.Equal(t, &packet.Score{Entity: "Alex", Action: packet.RemoveScoreAction, Objective: "kills"},
+		legacy.take()[1])
+
+	require.NoError(t, s.RemoveViewer(legacy))
+	require.Equal(t, []proto.Packet{
+		&packet.Objective{Name: "kills", Mode: packet.RemoveObjectiveMode},
+		&packet.Team{Name: "red", Mode: packet.RemoveTeamMode},
+	}, legacy.take())
+	require.Len(t, s.Viewers(), 1)
+}
+
+func TestResetAndSync(t *testing.T) {
+	s := New()
+	v := newTestViewer(t, version.Minecraft_1_21_5)
+	require.NoError(t, s.AddViewer(v))
+	o, err := s.NewObjective("coins", &component.Text{Content: "Coins"}, IntegerRenderType)
+	require.NoError(t, err)
+	v.take()
+
+	// Updates are held back while the client's scoreboard is reset
+	Reset(v.ID())
+	o.SetScore("Steve", Score{Value: 10})
+	require.Empty(t, v.take())
+
+	require.NoError(t, Sync(v))
+	require.Equal(t, []proto.Packet{
+		o.createPacket(packet.CreateObjectiveMode),
+		o.scorePacket("Steve", Score{Value: 10}),
+	}, v.take())
+
+	// Synced viewers are not sent the scoreboard again
+	require.NoError(t, Sync(v))
+	require.Empty(t, v.take())
+}
+
+func TestSidebar(t *testing.T) {
+	s := New()
+	sb, err := s.NewSidebar("lobby", &component.Text{Content: "Lobby"})
+	require.NoError(t, err)
+	require.Equal(t, sb.Objective(), s.DisplaySlot(SidebarDisplaySlot))
+
+	require.NoError(t, sb.SetLines(
+		&component.Text{Content: "Players: 1"},
+		&component.Text{Content: "Server: lobby"},
+	))
+	require.Len(t, s.Teams(), 2)
+	require.Equal(t, []string{lineEntry(0)}, s.Team("lobby.0").Entries())
+	score, ok := sb.Objective().Score(lineEntry(0))
+	require.True(t, ok)
+	require.Equal(t, 2, score.Value, "top line should have the highest score")
+
+	require.NoError(t, sb.SetLines(&component.Text{Content: "Players: 2"}))
+	require.Len(t, s.Teams(), 1)
+	require.Equal(t, []component.Component{&component.Text{Content: "Players: 2"}}, sb.Lines())
+	_, ok = sb.Objective().Score(lineEntry(1))
+	require.False(t, ok)
+
+	sb.Remove()
+	require.Empty(t, s.Teams())
+	require.Empty(t, s.Objectives())
+}
@@ -0,0 +1,121 @@
+package scoreboard
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"go.minekube.com/common/minecraft/component"
+)
+
+// MaxSidebarLines is the max number of lines a client shows in the sidebar.
+const MaxSidebarLines = 15
+
+// maxSidebarNameLength leaves room for the line suffix of the team names.
+const maxSidebarNameLength = MaxNameLength - 2
+
+// Sidebar is a sidebar objective showing lines of text.
+//
+// Each line is the prefix of a team with an invisible entry, so lines can be
+// updated without flickering and are not sorted by the client.
+// The scores are hidden since 1.20.3. Clients before 1.13 only show the first 16 characters of a line.
+type Sidebar struct {
+	board     *Scoreboard
+	objective *Objective
+
+	mu    sync.Mutex // protects lines and serializes updates
+	lines []*Team    // from top to bottom
+}
+
+// NewSidebar creates a new objective with the name displayed in the sidebar of the scoreboard.
+// The name must be at most 14 characters long.
+func (s *Scoreboard) NewSidebar(name string, title component.Component) (*Sidebar, error) {
+	if len([]rune(name)) > maxSidebarNameLength {
+		return nil, fmt.Errorf("%w: sidebar name %q must be at most %d characters long",
+			ErrInvalidName, name, maxSidebarNameLength)
+	}
+	o, err := s.NewObjective(name, title, IntegerRenderType)
+	if err != nil {
+		return nil, err
+	}
+	o.SetNumberFormat(BlankNumberFormat())
+	s.SetDisplaySlot(SidebarDisplaySlot, o)
+	return &Sidebar{board: s, objective: o}, nil
+}
+
+// Objective returns the objective of the sidebar.
+func (sb *Sidebar) Objective() *Objective { return sb.objective }
+
+// Title returns the title of the sidebar.
+func (sb *Sidebar) Title() component.Component { return sb.objective.DisplayName() }
+
+// SetTitle sets the title of the sidebar.
+func (sb *Sidebar) SetTitle(title component.Component) { sb.objective.SetDisplayName(title) }
+
+// Lines returns the lines of the sidebar from top to bottom.
+func (sb *Sidebar) Lines() []component.Component {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+	lines := make([]component.Component, len(sb.lines))
+	for i, t := range sb.lines {
+		lines[i] = t.Prefix()
+	}
+	return lines
+}
+
+// SetLines sets the lines of the sidebar from top to bottom.
+// Only the first MaxSidebarLines lines are shown.
+func (sb *Sidebar) SetLines(lines ...component.Component) error {
+	if len(lines) > MaxSidebarLines {
+		lines = lines[:MaxSidebarLines]
+	}
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+
+	// Remove lines that are no longer needed
+	for len(sb.lines) > len(lines) {
+		i := len(sb.lines) - 1
+		t := sb.lines[i]
+		sb.objective.RemoveScore(lineEntry(i))
+		sb.board.RemoveTeam(t)
+		sb.lines = sb.lines[:i]
+	}
+	for i, line := range lines {
+		if i < len(sb.lines) {
+			sb.lines[i].SetPrefix(line)
+			continue
+		}
+		t, err := sb.board.NewTeam(sb.objective.name + "." + strconv.FormatInt(int64(i), 16))
+		if err != nil {
+			return err
+		}
+		t.SetPrefix(line)
+		t.AddEntries(lineEntry(i))
+		sb.lines = append(sb.lines, t)
+	}
+	// Scores sort the lines in the sidebar, the top line has the highest score
+	for i := range sb.lines {
+		entry := lineEntry(i)
+		score := Score{Value: len(sb.lines) - i}
+		if current, ok := sb.objective.Score(entry); !ok || current.Value != score.Value {
+			sb.objective.SetScore(entry, score)
+		}
+	}
+	return nil
+}
+
+// Remove removes the sidebar from the scoreboard.
+func (sb *Sidebar) Remove() {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+	sb.board.RemoveObjective(sb.objective)
+	for _, t := range sb.lines {
+		sb.board.RemoveTeam(t)
+	}
+	sb.lines = nil
+}
+
+// lineEntry returns the invisible entry of the line, a color code followed by a reset.
+func lineEntry(i int) string {
+	return "§" + strconv.FormatInt(int64(i), 16) + "§r"
+}
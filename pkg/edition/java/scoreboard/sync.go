@@ -0,0 +1,66 @@
+package scoreboard
+
+import (
+	"errors"
+	"sync"
+
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+// viewing tracks the scoreboards each viewer views.
+var viewing = struct {
+	sync.Mutex
+	boards map[uuid.UUID][]*Scoreboard
+}{boards: map[uuid.UUID][]*Scoreboard{}}
+
+func track(viewer uuid.UUID, s *Scoreboard) {
+	viewing.Lock()
+	defer viewing.Unlock()
+	viewing.boards[viewer] = append(viewing.boards[viewer], s)
+}
+
+func untrack(viewer uuid.UUID, s *Scoreboard) {
+	viewing.Lock()
+	defer viewing.Unlock()
+	boards := viewing.boards[viewer]
+	remove(&boards, s)
+	if len(boards) == 0 {
+		delete(viewing.boards, viewer)
+	} else {
+		viewing.boards[viewer] = boards
+	}
+}
+
+func boards(viewer uuid.UUID) []*Scoreboard {
+	viewing.Lock()
+	defer viewing.Unlock()
+	return append([]*Scoreboard(nil), viewing.boards[viewer]...)
+}
+
+// Reset marks the scoreboards of the viewer as reset by the client, e.g. when the client
+// joins another server. Updates are not sent to the viewer until Sync is called.
+//
+// The proxy calls Reset and Sync for players switching servers.
+func Reset(viewer uuid.UUID) {
+	for _, s := range boards(viewer) {
+		s.mu.Lock()
+		if v, ok := s.viewers[viewer]; ok {
+			v.synced = false
+		}
+		s.mu.Unlock()
+	}
+}
+
+// Sync sends the scoreboards of the viewer again that were reset by Reset.
+func Sync(viewer Viewer) error {
+	var err error
+	for _, s := range boards(viewer.ID()) {
+		s.mu.Lock()
+		if v, ok := s.viewers[viewer.ID()]; ok && !v.synced {
+			v.synced = true
+			err = errors.Join(err, s.writeAll(v))
+		}
+		s.mu.Unlock()
+	}
+	return err
+}
@@ -0,0 +1,257 @@
+package scoreboard
+
+import (
+	"slices"
+
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/edition/java/proto/packet/chat"
+	packet "go.minekube.com/gate/pkg/edition/java/proto/packet/scoreboard"
+)
+
+// Team is a team of a Scoreboard.
+// Team entries are entity names, e.g. player names.
+type Team struct {
+	board             *Scoreboard
+	name              string // immutable
+	displayName       component.Component
+	prefix, suffix    component.Component
+	color             TeamColor
+	nameTagVisibility NameTagVisibility
+	collisionRule     CollisionRule
+	flags             byte
+	entries           []string
+}
+
+// Name returns the name of the team.
+func (t *Team) Name() string { return t.name }
+
+// DisplayName returns the display name of the team.
+func (t *Team) DisplayName() component.Component {
+	t.board.mu.Lock()
+	defer t.board.mu.Unlock()
+	return t.displayName
+}
+
+// SetDisplayName sets the display name of the team.
+func (t *Team) SetDisplayName(displayName component.Component) {
+	t.set(func() { t.displayName = displayName })
+}
+
+// Prefix returns the prefix shown before the names of team members.
+func (t *Team) Prefix() component.Component {
+	t.board.mu.Lock()
+	defer t.board.mu.Unlock()
+	return t.prefix
+}
+
+// SetPrefix sets the prefix shown before the names of team members.
+// Clients before 1.13 only show the first 16 characters.
+func (t *Team) SetPrefix(prefix component.Component) {
+	t.set(func() { t.prefix = prefix })
+}
+
+// Suffix returns the suffix shown after the names of team members.
+func (t *Team) Suffix() component.Component {
+	t.board.mu.Lock()
+	defer t.board.mu.Unlock()
+	return t.suffix
+}
+
+// SetSuffix sets the suffix shown after the names of team members.
+// Clients before 1.13 only show the first 16 characters.
+func (t *Team) SetSuffix(suffix component.Component) {
+	t.set(func() { t.suffix = suffix })
+}
+
+// Color returns the color of the team.
+func (t *Team) Color() TeamColor {
+	t.board.mu.Lock()
+	defer t.board.mu.Unlock()
+	return t.color
+}
+
+// SetColor sets the color of the names of team members since 1.8.
+func (t *Team) SetColor(color TeamColor) {
+	t.set(func() { t.color = color })
+}
+
+// NameTagVisibility returns for whom the name tags of team members are visible.
+func (t *Team) NameTagVisibility() NameTagVisibility {
+	t.board.mu.Lock()
+	defer t.board.mu.Unlock()
+	return t.nameTagVisibility
+}
+
+// SetNameTagVisibility sets for whom the name tags of team members are visible since 1.8.
+func (t *Team) SetNameTagVisibility(visibility NameTagVisibility) {
+	t.set(func() { t.nameTagVisibility = visibility })
+}
+
+// CollisionRule returns with whom team members collide.
+func (t *Team) CollisionRule() CollisionRule {
+	t.board.mu.Lock()
+	defer t.board.mu.Unlock()
+	return t.collisionRule
+}
+
+// SetCollisionRule sets with whom team members collide since 1.9.
+func (t *Team) SetCollisionRule(rule CollisionRule) {
+	t.set(func() { t.collisionRule = rule })
+}
+
+// FriendlyFire returns true if team members can attack each other.
+func (t *Team) FriendlyFire() bool {
+	t.board.mu.Lock()
+	defer t.board.mu.Unlock()
+	return t.flags&packet.AllowFriendlyFireFlag != 0
+}
+
+// SetFriendlyFire sets whether team members can attack each other.
+func (t *Team) SetFriendlyFire(allow bool) {
+	t.set(func() { t.flags = setFlag(t.flags, packet.AllowFriendlyFireFlag, allow) })
+}
+
+// SeeFriendlyInvisibles returns true if team members can see invisible team members.
+func (t *Team) SeeFriendlyInvisibles() bool {
+	t.board.mu.Lock()
+	defer t.board.mu.Unlock()
+	return t.flags&packet.SeeFriendlyInvisiblesFlag != 0
+}
+
+// SetSeeFriendlyInvisibles sets whether team members can see invisible team members.
+func (t *Team) SetSeeFriendlyInvisibles(see bool) {
+	t.set(func() { t.flags = setFlag(t.flags, packet.SeeFriendlyInvisiblesFlag, see) })
+}
+
+// Entries returns the entries of the team.
+func (t *Team) Entries() []string {
+	t.board.mu.Lock()
+	defer t.board.mu.Unlock()
+	return slices.Clone(t.entries)
+}
+
+// HasEntry returns true if the entry is in the team.
+func (t *Team) HasEntry(entry string) bool {
+	t.board.mu.Lock()
+	defer t.board.mu.Unlock()
+	return slices.Contains(t.entries, entry)
+}
+
+// AddEntries adds the entries to the team.
+// An entry can only be in one team, so entries are moved from other teams of the client.
+func (t *Team) AddEntries(entries ...string) {
+	t.board.mu.Lock()
+	defer t.board.mu.Unlock()
+	var added []string
+	for _, entry := range entries {
+		if !slices.Contains(t.entries, entry) && !slices.Contains(added, entry) {
+			added = append(added, entry)
+		}
+	}
+	if len(added) == 0 {
+		return
+	}
+	t.entries = append(t.entries, added...)
+	if t.registered() {
+		t.board.write(&packet.Team{Name: t.name, Mode: packet.AddEntitiesTeamMode, Entities: added})
+	}
+}
+
+// RemoveEntries removes the entries from the team.
+func (t *Team) RemoveEntries(entries ...string) {
+	t.board.mu.Lock()
+	defer t.board.mu.Unlock()
+	var removed []string
+	for _, entry := range entries {
+		if remove(&t.entries, entry) {
+			removed = append(removed, entry)
+		}
+	}
+	if len(removed) != 0 && t.registered() {
+		t.board.write(&packet.Team{Name: t.name, Mode: packet.RemoveEntitiesTeamMode, Entities: removed})
+	}
+}
+
+// set updates the team info and sends it to the viewers.
+func (t *Team) set(fn func()) {
+	t.board.mu.Lock()
+	defer t.board.mu.Unlock()
+	fn()
+	if t.registered() {
+		t.board.write(t.createPacket(packet.UpdateTeamMode, nil))
+	}
+}
+
+// registered returns true if the team was not removed from the scoreboard.
+func (t *Team) registered() bool {
+	return t.board.team(t.name) == t
+}
+
+func (t *Team) createPacket(mode packet.TeamMode, entries []string) *packet.Team {
+	return &packet.Team{
+		Name:              t.name,
+		Mode:              mode,
+		DisplayName:       chat.FromComponent(t.displayName),
+		Prefix:            chat.FromComponent(t.prefix),
+		Suffix:            chat.FromComponent(t.suffix),
+		FriendlyFlags:     t.flags,
+		NameTagVisibility: t.nameTagVisibility,
+		CollisionRule:     t.collisionRule,
+		Color:             t.color,
+		Entities:          entries,
+	}
+}
+
+func setFlag(flags, flag byte, set bool) byte {
+	if set {
+		return flags | flag
+	}
+	return flags &^ flag
+}
+
+// TeamColor is the color of the names of team members.
+type TeamColor = packet.TeamColor
+
+// Available team colors.
+const (
+	BlackTeamColor       = packet.BlackTeamColor
+	DarkBlueTeamColor    = packet.DarkBlueTeamColor
+	DarkGreenTeamColor   = packet.DarkGreenTeamColor
+	DarkAquaTeamColor    = packet.DarkAquaTeamColor
+	DarkRedTeamColor     = packet.DarkRedTeamColor
+	DarkPurpleTeamColor  = packet.DarkPurpleTeamColor
+	GoldTeamColor        = packet.GoldTeamColor
+	GrayTeamColor        = packet.GrayTeamColor
+	DarkGrayTeamColor    = packet.DarkGrayTeamColor
+	BlueTeamColor        = packet.BlueTeamColor
+	GreenTeamColor       = packet.GreenTeamColor
+	AquaTeamColor        = packet.AquaTeamColor
+	RedTeamColor         = packet.RedTeamColor
+	LightPurpleTeamColor = packet.LightPurpleTeamColor
+	YellowTeamColor      = packet.YellowTeamColor
+	WhiteTeamColor       = packet.WhiteTeamColor
+	ResetTeamColor       = packet.ResetTeamColor
+)
+
+// NameTagVisibility is for whom the name tags of team members are visible.
+type NameTagVisibility = packet.NameTagVisibility
+
+// Available name tag visibilities.
+const (
+	AlwaysNameTagVisibility            = packet.AlwaysNameTagVisibility
+	NeverNameTagVisibility             = packet.NeverNameTagVisibility
+	HideForOtherTeamsNameTagVisibility = packet.HideForOtherTeamsNameTagVisibility
+	HideForOwnTeamNameTagVisibility    = packet.HideForOwnTeamNameTagVisibility
+)
+
+// CollisionRule is with whom team members collide.
+type CollisionRule = packet.CollisionRule
+
+// Available collision rules.
+const (
+	AlwaysCollisionRule         = packet.AlwaysCollisionRule
+	NeverCollisionRule          = packet.NeverCollisionRule
+	PushOtherTeamsCollisionRule = packet.PushOtherTeamsCollisionRule
+	PushOwnTeamCollisionRule    = packet.PushOwnTeamCollisionRule
+)
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"strings"
 
 	jconfig "go.minekube.com/gate/pkg/edition/java/config"
 	"go.minekube.com/gate/pkg/internal/api"
@@ -16,6 +17,11 @@ var DefaultConfig = Config{
 		Enabled: false,
 		Bind:    "0.0.0.0:9090",
 	},
+	Metrics: Metrics{
+		Enabled: false,
+		Bind:    "0.0.0.0:9225",
+		Path:    "/metrics",
+	},
 	Connect: connect.DefaultConfig,
 	API: API{
 		Enabled: false,
@@ -29,6 +35,8 @@ type Config struct {
 	Config jconfig.Config `yaml:"config,omitempty"`
 	// See HealthService struct.
 	HealthService HealthService `json:"healthService,omitempty" yaml:"healthService,omitempty"`
+	// See Metrics struct.
+	Metrics Metrics `json:"metrics,omitempty" yaml:"metrics,omitempty"`
 	// See Connect struct.
 	Connect connect.Config `json:"connect,omitempty" yaml:"connect,omitempty"`
 	// See API struct.
@@ -42,6 +50,14 @@ type HealthService struct {
 	Bind    string `json:"bind,omitempty" yaml:"bind,omitempty"`
 }
 
+// Metrics is a Prometheus scrape endpoint exposing proxy metrics
+// in the text exposition format without requiring an OpenTelemetry collector.
+type Metrics struct {
+	Enabled bool   `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Bind    string `json:"bind,omitempty" yaml:"bind,omitempty"`
+	Path    string `json:"path,omitempty" yaml:"path,omitempty"`
+}
+
 // API is the configuration for the Gate API.
 type API struct {
 	Enabled bool       `json:"enabled,omitempty" yaml:"enabled,omitempty"`
@@ -62,6 +78,15 @@ func (c *Config) Validate() (warns []error, errs []error) {
 		}
 	}
 
+	if c.Metrics.Enabled {
+		if err := validation.ValidHostPort(c.Metrics.Bind); err != nil {
+			e("Invalid metrics bind address %q: %v", c.Metrics.Bind, err)
+		}
+		if !strings.HasPrefix(c.Metrics.Path, "/") {
+			e("Invalid metrics path %q, must start with /", c.Metrics.Path)
+		}
+	}
+
 	prefix := func(p string, errs []error) (pErrs []error) {
 		for _, err := range errs {
 			pErrs = append(pErrs, fmt.Errorf("%s: %w", p, err))
@@ -7,6 +7,8 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/robinbraemer/event"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	"go.minekube.com/gate/pkg/gate/config"
 	"go.minekube.com/gate/pkg/internal/hashutil"
@@ -21,6 +23,7 @@ func setupConnect(
 	c *config.Config,
 	eventMgr event.Manager,
 	instance connectcfg.Instance,
+	healthSrv *health.Server,
 ) error {
 	return coll.Add(process.RunnableFunc(func(ctx context.Context) error {
 		log := logr.FromContextOrDiscard(ctx).WithName("connect")
@@ -63,16 +66,21 @@ func setupConnect(
 			runnable, err := connectcfg.New(connect, instance)
 			if err != nil {
 				log.Error(err, "error setting up Connect")
+				healthSrv.SetServingStatus(HealthServiceConnect, healthpb.HealthCheckResponse_NOT_SERVING)
 				return
 			}
 
 			var runCtx context.Context
 			runCtx, stopConnect = context.WithCancel(ctx)
 
+			healthSrv.SetServingStatus(HealthServiceConnect, healthpb.HealthCheckResponse_SERVING)
 			go func() {
 				defer stopConnect()
 				if err = runnable.Start(runCtx); err != nil {
 					log.Error(err, "error with Connect")
+					if runCtx.Err() == nil {
+						healthSrv.SetServingStatus(HealthServiceConnect, healthpb.HealthCheckResponse_NOT_SERVING)
+					}
 					return
 				}
 				log.Info("connect stopped")
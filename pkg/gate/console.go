@@ -0,0 +1,166 @@
+package gate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"go.minekube.com/brigodier"
+	"go.minekube.com/common/minecraft/color"
+	"go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/component/codec/legacy"
+
+	"go.minekube.com/gate/pkg/command"
+	jproxy "go.minekube.com/gate/pkg/edition/java/proxy"
+	"go.minekube.com/gate/pkg/internal/console"
+	"go.minekube.com/gate/pkg/runtime/process"
+	"go.minekube.com/gate/pkg/util/permission"
+)
+
+// Console reads proxy commands from an input like os.Stdin
+// and runs them with all permissions.
+//
+// It is also an io.Writer that should be used for log output,
+// so that log lines are printed above the current input line.
+type Console struct {
+	term *console.Terminal
+	src  command.Source
+}
+
+// NewConsole returns a new Console reading commands from in and writing to out.
+// If in is an interactive terminal, the console supports line editing,
+// history and tab completion.
+func NewConsole(in *os.File, out io.Writer) *Console {
+	c := &Console{}
+	c.term = console.NewTerminal(in, out, "> ", nil)
+	c.src = &consoleSource{w: c.term}
+	return c
+}
+
+// Write writes p above the current input line.
+func (c *Console) Write(p []byte) (int, error) {
+	return c.term.Write(p)
+}
+
+// WithConsole is a StartOption for Start
+// that reads and runs commands from the provided Console.
+//
+// This setting is disabled by default.
+func WithConsole(c *Console) StartOption {
+	return func(o *startOptions) {
+		o.console = c
+	}
+}
+
+// runnable returns a runnable reading and running commands until the context is canceled.
+// It returns on Ctrl+C, shutting down Gate as the terminal does not send interrupt signals in raw mode.
+func (c *Console) runnable(log logr.Logger, p *jproxy.Proxy) process.Runnable {
+	return process.RunnableFunc(func(ctx context.Context) error {
+		c.term.SetComplete(func(line string) (int, []string) {
+			suggestions, err := p.Command().OfferSuggestions(ctx, c.src, line)
+			if err != nil {
+				return 0, nil
+			}
+			return strings.LastIndex(line, " ") + 1, suggestions
+		})
+		defer func() { _ = c.term.Close() }()
+
+		type result struct {
+			line string
+			err  error
+		}
+		lines := make(chan result)
+		go func() {
+			for {
+				line, err := c.term.ReadLine()
+				select {
+				case lines <- result{line, err}:
+				case <-ctx.Done():
+					return
+				}
+				if err != nil {
+					return
+				}
+			}
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case r := <-lines:
+				if errors.Is(r.err, console.ErrInterrupt) {
+					log.Info("console interrupted, shutting down")
+					return nil
+				}
+				if r.err != nil {
+					if !errors.Is(r.err, io.EOF) {
+						log.Error(r.err, "error reading console input")
+					}
+					// Keep running without console input.
+					<-ctx.Done()
+					return nil
+				}
+				c.execute(ctx, log, p, r.line)
+			}
+		}
+	})
+}
+
+// execute runs the command line and prints errors to the console.
+func (c *Console) execute(ctx context.Context, log logr.Logger, p *jproxy.Proxy, line string) {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return
+	}
+	err := p.ExecuteCommand(ctx, c.src, line)
+	if err == nil {
+		return
+	}
+	if errors.Is(err, brigodier.ErrDispatcherUnknownCommand) {
+		_ = c.src.SendMessage(&component.Text{
+			Content: "Unknown command.",
+			S:       component.Style{Color: color.Red},
+		})
+		return
+	}
+	var sErr *brigodier.CommandSyntaxError
+	if errors.As(err, &sErr) {
+		_ = c.src.SendMessage(&component.Text{
+			Content: sErr.Error(),
+			S:       component.Style{Color: color.Red},
+		})
+		return
+	}
+	log.Error(err, "error while running console command", "command", line)
+	_ = c.src.SendMessage(&component.Text{
+		Content: "An error occurred while running this command.",
+		S:       component.Style{Color: color.Red},
+	})
+}
+
+// consoleSource is the command source of the console having all permissions.
+type consoleSource struct {
+	w io.Writer
+}
+
+var _ command.Source = (*consoleSource)(nil)
+
+func (s *consoleSource) HasPermission(string) bool { return true }
+func (s *consoleSource) PermissionValue(string) permission.TriState {
+	return permission.True
+}
+
+// SendMessage prints the message with legacy color codes translated to ANSI colors.
+func (s *consoleSource) SendMessage(msg component.Component, _ ...command.MessageOption) error {
+	b := new(strings.Builder)
+	if err := (&legacy.Legacy{}).Marshal(b, msg); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(s.w, console.AnsiFromLegacy(b.String()))
+	return err
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/robinbraemer/event"
 	"github.com/spf13/viper"
 	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc/health"
 	"gopkg.in/yaml.v3"
 
 	"go.minekube.com/gate/pkg/edition"
@@ -75,7 +76,8 @@ func New(options Options) (gate *Gate, err error) {
 	})
 
 	gate = &Gate{
-		proc: process.New(process.Options{AllOrNothing: true}),
+		proc:   process.New(process.Options{AllOrNothing: true}),
+		health: newHealthServer(),
 	}
 
 	c := options.Config
@@ -113,7 +115,15 @@ func New(options Options) (gate *Gate, err error) {
 		}
 	}
 
-	if err = setupConnect(gate.proc, c, eventMgr, gate.Java()); err != nil {
+	if err = setupConnect(gate.proc, c, eventMgr, gate.Java(), gate.health); err != nil {
+		return nil, err
+	}
+
+	if err = gate.proc.Add(setupHealthService(c, eventMgr, gate)); err != nil {
+		return nil, err
+	}
+
+	if err = gate.proc.Add(setupMetrics(c, eventMgr)); err != nil {
 		return nil, err
 	}
 
@@ -129,6 +139,7 @@ type Gate struct {
 	javaProxy    *jproxy.Proxy      // The Java edition proxy.
 	bedrockProxy *bproxy.Proxy      // The Bedrock edition proxy.
 	proc         process.Collection // Parallel running proc.
+	health       *health.Server     // Reports the serving status of Gate's components.
 }
 
 // Java returns the Java edition proxy, or nil if none.
@@ -141,6 +152,13 @@ func (g *Gate) Bedrock() *bproxy.Proxy {
 	return g.bedrockProxy
 }
 
+// Health returns the gRPC health server reporting the serving status of
+// Gate and its components. It is served on the HealthService bind address
+// if enabled, but can also be registered with any other gRPC server.
+func (g *Gate) Health() *health.Server {
+	return g.health
+}
+
 // Start starts the Gate instance and all underlying proc.
 func (g *Gate) Start(ctx context.Context) error {
 	ctx, span := otel.Tracer("gate").Start(ctx, "gate.Start")
@@ -158,6 +176,7 @@ type startOptions struct {
 	conf                      *config.Config
 	autoShutdownOnSignal      bool
 	autoConfigReloadWatchPath string
+	console                   *Console
 }
 
 // WithConfig is a StartOption for Start
@@ -234,6 +253,13 @@ func Start(ctx context.Context, opts ...StartOption) error {
 		return fmt.Errorf("error creating Gate instance: %w", err)
 	}
 
+	// Setup console command input if enabled.
+	if c.console != nil {
+		if err = gate.proc.Add(c.console.runnable(log.WithName("console"), gate.Java())); err != nil {
+			return err
+		}
+	}
+
 	// Setup os signal channel to trigger Gate shutdown.
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
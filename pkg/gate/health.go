@@ -0,0 +1,236 @@
+package gate
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/robinbraemer/event"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	jproxy "go.minekube.com/gate/pkg/edition/java/proxy"
+	"go.minekube.com/gate/pkg/gate/config"
+	"go.minekube.com/gate/pkg/internal/hashutil"
+	"go.minekube.com/gate/pkg/internal/reload"
+	"go.minekube.com/gate/pkg/runtime/process"
+)
+
+// Service names reported by the health service in addition to the overall
+// status of Gate (empty service name). Components that are not enabled
+// report SERVICE_UNKNOWN.
+const (
+	HealthServiceJava    = "java"    // The Java edition proxy is listening for connections.
+	HealthServiceBedrock = "bedrock" // The Geyser integration is listening for connections.
+	HealthServiceConnect = "connect" // The Connect integration is running.
+	HealthServiceLite    = "lite"    // Every Lite route has at least one reachable backend.
+)
+
+// healthCheckInterval is the interval in which component statuses
+// are refreshed and Lite backends are probed.
+const healthCheckInterval = 10 * time.Second
+
+// newHealthServer returns a new health server reporting every
+// component as not serving until it signals otherwise.
+func newHealthServer() *health.Server {
+	srv := health.NewServer()
+	srv.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	for _, svc := range []string{
+		HealthServiceJava,
+		HealthServiceBedrock,
+		HealthServiceConnect,
+		HealthServiceLite,
+	} {
+		srv.SetServingStatus(svc, healthpb.HealthCheckResponse_SERVICE_UNKNOWN)
+	}
+	return srv
+}
+
+func servingStatus(serving bool) healthpb.HealthCheckResponse_ServingStatus {
+	if serving {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
+
+// setupHealthService returns a runnable that keeps the component statuses
+// of the health server up to date and serves the grpc.health.v1.Health
+// service on the configured bind address when enabled.
+//
+// The proxy events are subscribed to before returning, since the runnable runs
+// in parallel to the Java proxy and could otherwise miss the only ReadyEvent.
+func setupHealthService(cfg *config.Config, eventMgr event.Manager, g *Gate) process.Runnable {
+	var javaReady atomic.Bool
+	update := func() {
+		ready := javaReady.Load()
+		g.health.SetServingStatus("", servingStatus(ready))
+		g.health.SetServingStatus(HealthServiceJava, servingStatus(ready))
+		if g.bedrockProxy != nil {
+			g.health.SetServingStatus(HealthServiceBedrock, servingStatus(g.bedrockProxy.Ready()))
+		}
+	}
+	proxyUnsubs := []func(){
+		event.Subscribe(eventMgr, 0, func(*jproxy.ReadyEvent) {
+			javaReady.Store(true)
+			update()
+		}),
+		event.Subscribe(eventMgr, 0, func(*jproxy.PreShutdownEvent) {
+			javaReady.Store(false)
+			// Report NOT_SERVING for all services from now on
+			// and ignore further status updates.
+			g.health.Shutdown()
+		}),
+	}
+
+	return process.RunnableFunc(func(ctx context.Context) error {
+		log := logr.FromContextOrDiscard(ctx).WithName("health")
+		ctx = logr.NewContext(ctx, log)
+
+		var (
+			mu                sync.Mutex
+			stop              context.CancelFunc
+			currentConfigHash []byte
+			currentConfig     atomic.Pointer[config.Config]
+		)
+		currentConfig.Store(cfg)
+
+		// probeLite dials the Lite backends and must not be called from event handlers.
+		probeLite := func() {
+			c := currentConfig.Load()
+			if !c.Config.Lite.Enabled {
+				g.health.SetServingStatus(HealthServiceLite, healthpb.HealthCheckResponse_SERVICE_UNKNOWN)
+				return
+			}
+			reachable := javaReady.Load() && liteBackendsReachable(ctx, c)
+			g.health.SetServingStatus(HealthServiceLite, servingStatus(reachable))
+		}
+
+		trigger := func(c *reload.ConfigUpdateEvent[config.Config]) {
+			currentConfig.Store(c.Config)
+
+			newConfigHash, err := hashutil.JsonHash(c.Config.HealthService)
+			if err != nil {
+				log.Error(err, "error hashing health service config")
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			// check if config changed
+			if bytes.Equal(newConfigHash, currentConfigHash) {
+				return // no change
+			}
+			currentConfigHash = newConfigHash
+
+			if stop != nil {
+				stop()
+				stop = nil
+			}
+
+			if c.Config.HealthService.Enabled {
+				var runCtx context.Context
+				runCtx, stop = context.WithCancel(ctx)
+				bind := c.Config.HealthService.Bind
+				go func() {
+					if err := serveHealth(runCtx, bind, g.health); err != nil {
+						log.Error(err, "failed to start health service", "bind", bind)
+						return
+					}
+					log.Info("health service stopped")
+				}()
+			}
+		}
+
+		unsubs := append(proxyUnsubs, reload.Subscribe(eventMgr, trigger))
+		defer func() {
+			for _, unsub := range unsubs {
+				unsub()
+			}
+		}()
+
+		trigger(&reload.ConfigUpdateEvent[config.Config]{
+			Config:     cfg,
+			PrevConfig: cfg,
+		})
+
+		ticker := time.NewTicker(healthCheckInterval)
+		defer ticker.Stop()
+		for {
+			update()
+			probeLite()
+			select {
+			case <-ctx.Done():
+				g.health.Shutdown()
+				return nil
+			case <-ticker.C:
+			}
+		}
+	})
+}
+
+// serveHealth serves the health service on the bind address until the context is canceled.
+func serveHealth(ctx context.Context, bind string, healthSrv healthpb.HealthServer) error {
+	var lc net.ListenConfig
+	ln, err := lc.Listen(ctx, "tcp", bind)
+	if err != nil {
+		return err
+	}
+
+	srv := grpc.NewServer()
+	healthpb.RegisterHealthServer(srv, healthSrv)
+	go func() { <-ctx.Done(); srv.Stop() }()
+
+	logr.FromContextOrDiscard(ctx).Info("serving health service", "bind", bind)
+	return srv.Serve(ln)
+}
+
+// liteBackendsReachable reports whether every Lite route has at least one backend
+// that accepts TCP connections within the configured connection timeout.
+// Routes and their backends are dialed concurrently.
+func liteBackendsReachable(ctx context.Context, cfg *config.Config) bool {
+	timeout := time.Duration(cfg.Config.ConnectionTimeout)
+	eg, ctx := errgroup.WithContext(ctx)
+	for _, route := range cfg.Config.Lite.Routes {
+		eg.Go(func() error {
+			if !anyReachable(ctx, timeout, route.Backend) {
+				return errUnreachable
+			}
+			return nil
+		})
+	}
+	return eg.Wait() == nil
+}
+
+var errUnreachable = errors.New("no backend reachable")
+
+// anyReachable dials all addrs concurrently and returns true on the first
+// successful dial, canceling the remaining dials.
+func anyReachable(ctx context.Context, timeout time.Duration, addrs []string) bool {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	var dialer net.Dialer
+	reachable := make(chan bool, len(addrs))
+	for _, addr := range addrs {
+		go func() {
+			conn, err := dialer.DialContext(ctx, "tcp", addr)
+			if err == nil {
+				_ = conn.Close()
+			}
+			reachable <- err == nil
+		}()
+	}
+	for range addrs {
+		if <-reachable {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package gate
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/robinbraemer/event"
+	"github.com/stretchr/testify/require"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	liteconfig "go.minekube.com/gate/pkg/edition/java/lite/config"
+	jproxy "go.minekube.com/gate/pkg/edition/java/proxy"
+	"go.minekube.com/gate/pkg/gate/config"
+)
+
+func TestNewHealthServer(t *testing.T) {
+	srv := newHealthServer()
+	ctx := context.Background()
+
+	res, err := srv.Check(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, res.GetStatus())
+
+	res, err = srv.Check(ctx, &healthpb.HealthCheckRequest{Service: HealthServiceBedrock})
+	require.NoError(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVICE_UNKNOWN, res.GetStatus())
+}
+
+func TestSetupHealthService_readyBeforeRun(t *testing.T) {
+	eventMgr := event.New()
+	g := &Gate{health: newHealthServer()}
+	cfg := config.DefaultConfig
+	_ = setupHealthService(&cfg, eventMgr, g)
+
+	// The proxy may be ready before the health runnable runs
+	eventMgr.Fire(&jproxy.ReadyEvent{})
+
+	res, err := g.health.Check(context.Background(), &healthpb.HealthCheckRequest{Service: HealthServiceJava})
+	require.NoError(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, res.GetStatus())
+}
+
+func TestLiteBackendsReachable(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.Close()
+		}
+	}()
+
+	// Grab a free port and close it so that dialing it fails.
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedAddr := closed.Addr().String()
+	require.NoError(t, closed.Close())
+
+	cfg := config.DefaultConfig
+	cfg.Config.Lite.Routes = []liteconfig.Route{
+		{Host: []string{"a.example.com"}, Backend: []string{closedAddr, ln.Addr().String()}},
+	}
+	require.True(t, liteBackendsReachable(context.Background(), &cfg))
+
+	cfg.Config.Lite.Routes = append(cfg.Config.Lite.Routes, liteconfig.Route{
+		Host: []string{"b.example.com"}, Backend: []string{closedAddr},
+	})
+	require.False(t, liteBackendsReachable(context.Background(), &cfg))
+}
@@ -0,0 +1,101 @@
+package gate
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/robinbraemer/event"
+
+	"go.minekube.com/gate/pkg/gate/config"
+	"go.minekube.com/gate/pkg/internal/metrics"
+	"go.minekube.com/gate/pkg/internal/reload"
+	"go.minekube.com/gate/pkg/runtime/process"
+)
+
+var errOTLPMetrics = errors.New("metrics are exported over OTLP while OTEL_METRICS_ENABLED is set, " +
+	"disable either OTEL_METRICS_ENABLED or the metrics endpoint")
+
+// setupMetrics returns a runnable that serves the Prometheus metrics
+// endpoint on the configured bind address when enabled.
+func setupMetrics(cfg *config.Config, eventMgr event.Manager) process.Runnable {
+	return process.RunnableFunc(func(ctx context.Context) error {
+		log := logr.FromContextOrDiscard(ctx).WithName("metrics")
+		ctx = logr.NewContext(ctx, log)
+
+		var (
+			mu      sync.Mutex
+			stop    context.CancelFunc
+			current *config.Metrics
+		)
+		trigger := func(c *reload.ConfigUpdateEvent[config.Config]) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			// check if config changed
+			if current != nil && *current == c.Config.Metrics {
+				return // no change
+			}
+			m := c.Config.Metrics
+			current = &m
+
+			if stop != nil {
+				stop()
+				stop = nil
+			}
+
+			if m.Enabled && os.Getenv("OTEL_METRICS_ENABLED") == "true" {
+				// The OTLP meter provider does not feed the endpoint's reader,
+				// so don't bind an endpoint without metrics.
+				log.Error(errOTLPMetrics, "not serving metrics endpoint", "bind", m.Bind)
+				return
+			}
+			if m.Enabled {
+				var runCtx context.Context
+				runCtx, stop = context.WithCancel(ctx)
+				go func() {
+					if err := serveMetrics(runCtx, m.Bind, m.Path, metrics.Handler(metrics.Reader)); err != nil {
+						log.Error(err, "failed to start metrics endpoint", "bind", m.Bind)
+						return
+					}
+					log.Info("metrics endpoint stopped")
+				}()
+			}
+		}
+		defer reload.Subscribe(eventMgr, trigger)()
+
+		trigger(&reload.ConfigUpdateEvent[config.Config]{
+			Config:     cfg,
+			PrevConfig: cfg,
+		})
+
+		<-ctx.Done()
+		return nil
+	})
+}
+
+// serveMetrics serves the metrics handler on the bind address
+// and path until the context is canceled.
+func serveMetrics(ctx context.Context, bind, path string, handler http.Handler) error {
+	var lc net.ListenConfig
+	ln, err := lc.Listen(ctx, "tcp", bind)
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(path, handler)
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() { <-ctx.Done(); _ = srv.Close() }()
+
+	logr.FromContextOrDiscard(ctx).Info("serving metrics endpoint", "bind", bind, "path", path)
+	if err = srv.Serve(ln); errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,150 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"go.minekube.com/gate/pkg/edition/java/proxy"
+	pb "go.minekube.com/gate/pkg/internal/api/gen/minekube/gate/v1"
+	"go.minekube.com/gate/pkg/util/access"
+)
+
+// accessStore returns the bans and allowlist or a FAILED_PRECONDITION error if they are disabled.
+func (s *Service) accessStore() (*access.Store, error) {
+	store := s.p.Access()
+	if store == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, proxy.ErrAccessDisabled)
+	}
+	return store, nil
+}
+
+func (s *Service) ListBans(ctx context.Context, c *connect.Request[pb.ListBansRequest]) (*connect.Response[pb.ListBansResponse], error) {
+	store, err := s.accessStore()
+	if err != nil {
+		return nil, err
+	}
+	bans := store.Bans()
+	res := &pb.ListBansResponse{Bans: make([]*pb.Ban, 0, len(bans))}
+	for i := range bans {
+		res.Bans = append(res.Bans, BanToProto(&bans[i]))
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *Service) AddBan(ctx context.Context, c *connect.Request[pb.AddBanRequest]) (*connect.Response[pb.AddBanResponse], error) {
+	if _, err := s.accessStore(); err != nil {
+		return nil, err
+	}
+	if _, _, err := access.ParseTarget(c.Msg.Target); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	b := access.Ban{
+		Target: c.Msg.Target,
+		Reason: c.Msg.Reason,
+		Source: c.Msg.Source,
+	}
+	if b.Source == "" {
+		b.Source = "API"
+	}
+	if c.Msg.Duration != nil {
+		if err := c.Msg.Duration.CheckValid(); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid duration: %w", err))
+		}
+		d := c.Msg.Duration.AsDuration()
+		if d <= 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("duration must be positive"))
+		}
+		b.Expires = time.Now().Add(d)
+	}
+
+	b, err := s.p.Ban(b)
+	if err != nil {
+		if errors.Is(err, proxy.ErrAccessDisabled) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.AddBanResponse{Ban: BanToProto(&b)}), nil
+}
+
+func (s *Service) RemoveBan(ctx context.Context, c *connect.Request[pb.RemoveBanRequest]) (*connect.Response[pb.RemoveBanResponse], error) {
+	store, err := s.accessStore()
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err = access.ParseTarget(c.Msg.Target); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	removed, err := store.Unban(c.Msg.Target)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !removed {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("target is not banned"))
+	}
+	return connect.NewResponse(&pb.RemoveBanResponse{}), nil
+}
+
+func (s *Service) GetAllowlist(ctx context.Context, c *connect.Request[pb.GetAllowlistRequest]) (*connect.Response[pb.GetAllowlistResponse], error) {
+	store, err := s.accessStore()
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pb.GetAllowlistResponse{
+		Enabled: store.AllowlistEnabled(),
+		Entries: store.Allowlist(),
+	}), nil
+}
+
+func (s *Service) SetAllowlistEnabled(ctx context.Context, c *connect.Request[pb.SetAllowlistEnabledRequest]) (*connect.Response[pb.SetAllowlistEnabledResponse], error) {
+	store, err := s.accessStore()
+	if err != nil {
+		return nil, err
+	}
+	if err = store.SetAllowlistEnabled(c.Msg.Enabled); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.SetAllowlistEnabledResponse{}), nil
+}
+
+func (s *Service) AddAllowlistEntry(ctx context.Context, c *connect.Request[pb.AddAllowlistEntryRequest]) (*connect.Response[pb.AddAllowlistEntryResponse], error) {
+	store, err := s.accessStore()
+	if err != nil {
+		return nil, err
+	}
+	if kind, _, err := access.ParseTarget(c.Msg.Entry); err != nil || kind == access.IPKind {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("invalid entry %q, must be a UUID or username", c.Msg.Entry))
+	}
+	added, err := store.Allow(c.Msg.Entry)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !added {
+		return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("entry is already allowlisted"))
+	}
+	return connect.NewResponse(&pb.AddAllowlistEntryResponse{}), nil
+}
+
+func (s *Service) RemoveAllowlistEntry(ctx context.Context, c *connect.Request[pb.RemoveAllowlistEntryRequest]) (*connect.Response[pb.RemoveAllowlistEntryResponse], error) {
+	store, err := s.accessStore()
+	if err != nil {
+		return nil, err
+	}
+	if kind, _, err := access.ParseTarget(c.Msg.Entry); err != nil || kind == access.IPKind {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("invalid entry %q, must be a UUID or username", c.Msg.Entry))
+	}
+	removed, err := store.Disallow(c.Msg.Entry)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !removed {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("entry is not allowlisted"))
+	}
+	return connect.NewResponse(&pb.RemoveAllowlistEntryResponse{}), nil
+}
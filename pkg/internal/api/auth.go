@@ -0,0 +1,184 @@
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"go.minekube.com/gate/pkg/internal/api/gen/minekube/gate/v1/gatev1connect"
+)
+
+// Scope is a group of RPCs a token or client certificate is allowed to call.
+type Scope string
+
+const (
+	// ScopeRead allows RPCs that only read proxy state.
+	ScopeRead Scope = "read"
+	// ScopeWrite allows RPCs that mutate proxy state or act on players.
+	ScopeWrite Scope = "write"
+)
+
+var scopesString = strings.Join([]string{string(ScopeRead), string(ScopeWrite)}, ",")
+
+// Valid reports whether the scope is known.
+func (s Scope) Valid() bool {
+	return s == ScopeRead || s == ScopeWrite
+}
+
+// procedureScopes maps procedures to the scope required to call them.
+// Procedures not listed require ScopeWrite.
+var procedureScopes = map[string]Scope{
+	gatev1connect.GateServiceGetPlayerProcedure:        ScopeRead,
+	gatev1connect.GateServiceListPlayersProcedure:      ScopeRead,
+	gatev1connect.GateServiceListServersProcedure:      ScopeRead,
+	gatev1connect.GateServiceWatchEventsProcedure:      ScopeRead,
+	gatev1connect.GateServiceListBansProcedure:         ScopeRead,
+	gatev1connect.GateServiceGetAllowlistProcedure:     ScopeRead,
+	gatev1connect.GateServiceListQueuesProcedure:       ScopeRead,
+	gatev1connect.GateServiceGetQueuePositionProcedure: ScopeRead,
+
+	gatev1connect.GateServiceRegisterServerProcedure:       ScopeWrite,
+	gatev1connect.GateServiceUnregisterServerProcedure:     ScopeWrite,
+	gatev1connect.GateServiceConnectPlayerProcedure:        ScopeWrite,
+	gatev1connect.GateServiceDisconnectPlayerProcedure:     ScopeWrite,
+	gatev1connect.GateServiceStoreCookieProcedure:          ScopeWrite,
+	gatev1connect.GateServiceRequestCookieProcedure:        ScopeWrite,
+	gatev1connect.GateServiceAddBanProcedure:               ScopeWrite,
+	gatev1connect.GateServiceRemoveBanProcedure:            ScopeWrite,
+	gatev1connect.GateServiceSetAllowlistEnabledProcedure:  ScopeWrite,
+	gatev1connect.GateServiceAddAllowlistEntryProcedure:    ScopeWrite,
+	gatev1connect.GateServiceRemoveAllowlistEntryProcedure: ScopeWrite,
+	gatev1connect.GateServiceAddToQueueProcedure:           ScopeWrite,
+	gatev1connect.GateServiceRemoveFromQueueProcedure:      ScopeWrite,
+}
+
+// requiredScope returns the scope required to call the procedure.
+func requiredScope(procedure string) Scope {
+	if scope, ok := procedureScopes[procedure]; ok {
+		return scope
+	}
+	return ScopeWrite
+}
+
+// authInterceptor authenticates requests by bearer token and/or
+// client certificate and authorizes them by the scope of the procedure.
+type authInterceptor struct {
+	tokens       []Token
+	clientScopes []Scope // nil if client certificates are not used
+}
+
+var _ connect.Interceptor = (*authInterceptor)(nil)
+
+// newAuthInterceptor returns an interceptor for the configuration
+// or nil if authentication is disabled.
+func newAuthInterceptor(cfg Config) *authInterceptor {
+	if !cfg.AuthEnabled() {
+		return nil
+	}
+	a := &authInterceptor{tokens: cfg.Tokens}
+	if cfg.TLS != nil && cfg.TLS.ClientCAFile != "" {
+		// The TLS handshake already requires a verified client certificate.
+		a.clientScopes = cfg.TLS.ClientScopes
+		if a.clientScopes == nil {
+			a.clientScopes = []Scope{}
+		}
+	}
+	return a
+}
+
+// authorize returns an UNAUTHENTICATED error if the request could not be
+// authenticated and a PERMISSION_DENIED error if it lacks the required scope.
+func (a *authInterceptor) authorize(procedure string, header interface{ Get(string) string }) error {
+	var scopes []Scope
+	if auth := header.Get("Authorization"); auth != "" {
+		token, ok := strings.CutPrefix(auth, "Bearer ")
+		if !ok {
+			return connect.NewError(connect.CodeUnauthenticated, errors.New("authorization header must use the Bearer scheme"))
+		}
+		t := a.lookup(token)
+		if t == nil {
+			return connect.NewError(connect.CodeUnauthenticated, errors.New("invalid bearer token"))
+		}
+		scopes = t.Scopes
+	} else if a.clientScopes != nil {
+		scopes = a.clientScopes
+	} else {
+		return connect.NewError(connect.CodeUnauthenticated, errors.New("missing bearer token"))
+	}
+
+	required := requiredScope(procedure)
+	if !slices.Contains(scopes, required) {
+		return connect.NewError(connect.CodePermissionDenied,
+			fmt.Errorf("scope %q is required to call %s", required, procedure))
+	}
+	return nil
+}
+
+// lookup returns the configured token in constant time or nil if not found.
+func (a *authInterceptor) lookup(token string) *Token {
+	var found *Token
+	for i := range a.tokens {
+		if subtle.ConstantTimeCompare([]byte(a.tokens[i].Token), []byte(token)) == 1 {
+			found = &a.tokens[i]
+		}
+	}
+	return found
+}
+
+func (a *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := a.authorize(req.Spec().Procedure, req.Header()); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (a *authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (a *authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := a.authorize(conn.Spec().Procedure, conn.RequestHeader()); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+// tlsConfig returns the server TLS configuration or nil if TLS is disabled.
+func tlsConfig(cfg *TLS) (*tls.Config, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("error loading api tls key pair: %w", err)
+	}
+	c := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if cfg.ClientCAFile != "" {
+		pem, err := os.ReadFile(cfg.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading api tls client ca file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in api tls client ca file %q", cfg.ClientCAFile)
+		}
+		c.ClientCAs = pool
+		c.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return c, nil
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/internal/api/gen/minekube/gate/v1/gatev1connect"
+)
+
+func TestAuthInterceptor_Authorize(t *testing.T) {
+	a := newAuthInterceptor(Config{Tokens: []Token{
+		{Token: "reader", Scopes: []Scope{ScopeRead}},
+		{Token: "admin", Scopes: []Scope{ScopeRead, ScopeWrite}},
+	}})
+	require.NotNil(t, a)
+
+	header := func(auth string) http.Header {
+		h := make(http.Header)
+		if auth != "" {
+			h.Set("Authorization", auth)
+		}
+		return h
+	}
+	code := func(err error) connect.Code {
+		if err == nil {
+			return 0
+		}
+		return connect.CodeOf(err)
+	}
+
+	tests := []struct {
+		name      string
+		procedure string
+		auth      string
+		want      connect.Code
+	}{
+		{"read allowed", gatev1connect.GateServiceListPlayersProcedure, "Bearer reader", 0},
+		{"write denied", gatev1connect.GateServiceDisconnectPlayerProcedure, "Bearer reader", connect.CodePermissionDenied},
+		{"write allowed", gatev1connect.GateServiceRegisterServerProcedure, "Bearer admin", 0},
+		{"unknown procedure requires write", "/minekube.gate.v1.GateService/Unknown", "Bearer reader", connect.CodePermissionDenied},
+		{"missing token", gatev1connect.GateServiceGetPlayerProcedure, "", connect.CodeUnauthenticated},
+		{"invalid token", gatev1connect.GateServiceGetPlayerProcedure, "Bearer nope", connect.CodeUnauthenticated},
+		{"wrong scheme", gatev1connect.GateServiceGetPlayerProcedure, "Basic reader", connect.CodeUnauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, code(a.authorize(tt.procedure, header(tt.auth))))
+		})
+	}
+}
+
+func TestAuthInterceptor_ClientScopes(t *testing.T) {
+	a := newAuthInterceptor(Config{TLS: &TLS{
+		CertFile:     "cert.pem",
+		KeyFile:      "key.pem",
+		ClientCAFile: "ca.pem",
+		ClientScopes: []Scope{ScopeRead},
+	}})
+	require.NotNil(t, a)
+
+	require.NoError(t, a.authorize(gatev1connect.GateServiceListServersProcedure, http.Header{}))
+	err := a.authorize(gatev1connect.GateServiceConnectPlayerProcedure, http.Header{})
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+}
+
+func TestConfig_Validate(t *testing.T) {
+	require.Nil(t, newAuthInterceptor(DefaultConfig))
+
+	warns, errs := Config{Bind: "0.0.0.0:8080"}.Validate()
+	require.Empty(t, errs)
+	require.Len(t, warns, 1)
+
+	_, errs = Config{Bind: "localhost:8080", Tokens: []Token{
+		{Token: "a", Scopes: []Scope{"admin"}},
+		{Token: "a"},
+		{Token: " ", Scopes: []Scope{ScopeRead}},
+	}}.Validate()
+	require.Len(t, errs, 4)
+
+	_, errs = Config{Bind: "localhost:8080", TLS: &TLS{ClientCAFile: "ca.pem"}}.Validate()
+	require.Len(t, errs, 1)
+}
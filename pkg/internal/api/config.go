@@ -3,6 +3,7 @@ package api
 import (
 	"errors"
 	"fmt"
+	"net"
 	"strings"
 
 	"go.minekube.com/gate/pkg/util/validation"
@@ -16,17 +17,97 @@ var DefaultConfig = Config{
 // Config is the configuration for the Gate API.
 type Config struct {
 	// Bind is the address to bind the API server to.
-	// Using a localhost address is recommended to avoid exposing the API to the public.
+	// Using a localhost address is recommended to avoid exposing the API to the public
+	// unless authentication is configured using Tokens and/or TLS client certificates.
 	Bind string `json:"bind,omitempty" yaml:"bind,omitempty"`
+	// TLS enables serving the API over TLS and optionally requires clients
+	// to present a certificate signed by a trusted CA (mTLS).
+	TLS *TLS `json:"tls,omitempty" yaml:"tls,omitempty"`
+	// Tokens are the bearer tokens accepted in the Authorization header.
+	Tokens []Token `json:"tokens,omitempty" yaml:"tokens,omitempty"`
+}
+
+// TLS is the TLS configuration of the API server.
+type TLS struct {
+	CertFile string `json:"certFile,omitempty" yaml:"certFile,omitempty"` // PEM encoded server certificate
+	KeyFile  string `json:"keyFile,omitempty" yaml:"keyFile,omitempty"`   // PEM encoded server private key
+	// ClientCAFile is a PEM encoded CA bundle used to verify client certificates.
+	// If set, clients are required to present a valid certificate.
+	ClientCAFile string `json:"clientCAFile,omitempty" yaml:"clientCAFile,omitempty"`
+	// ClientScopes are the scopes granted to requests authenticated
+	// by a client certificate that do not present a bearer token.
+	ClientScopes []Scope `json:"clientScopes,omitempty" yaml:"clientScopes,omitempty"`
+}
+
+// Token is a bearer token granting access to the RPCs of its scopes.
+type Token struct {
+	Token  string  `json:"token,omitempty" yaml:"token,omitempty"`
+	Scopes []Scope `json:"scopes,omitempty" yaml:"scopes,omitempty"`
+}
+
+// AuthEnabled reports whether requests must be authenticated.
+func (c Config) AuthEnabled() bool {
+	return len(c.Tokens) != 0 || (c.TLS != nil && c.TLS.ClientCAFile != "")
 }
 
 // Validate validates the API configuration.
 func (c Config) Validate() (warns []error, errs []error) {
+	e := func(m string, args ...any) { errs = append(errs, fmt.Errorf(m, args...)) }
+	w := func(m string, args ...any) { warns = append(warns, fmt.Errorf(m, args...)) }
+
 	if strings.TrimSpace(c.Bind) == "" {
 		return nil, []error{errors.New("bind address must not be empty")}
 	}
 	if err := validation.ValidHostPort(c.Bind); err != nil {
 		return nil, []error{fmt.Errorf("invalid bind %q: %v", c.Bind, err)}
 	}
-	return nil, nil
+
+	if c.TLS != nil {
+		if c.TLS.CertFile == "" || c.TLS.KeyFile == "" {
+			e("tls: certFile and keyFile must be set")
+		}
+		if c.TLS.ClientCAFile == "" && len(c.TLS.ClientScopes) != 0 {
+			w("tls: clientScopes are ignored without clientCAFile")
+		}
+		for _, scope := range c.TLS.ClientScopes {
+			if !scope.Valid() {
+				e("tls: unknown client scope %q, must be one of %s", scope, scopesString)
+			}
+		}
+	}
+
+	seen := make(map[string]bool, len(c.Tokens))
+	for i, t := range c.Tokens {
+		if strings.TrimSpace(t.Token) == "" {
+			e("tokens[%d]: token must not be empty", i)
+		} else if seen[t.Token] {
+			e("tokens[%d]: duplicate token", i)
+		}
+		seen[t.Token] = true
+		if len(t.Scopes) == 0 {
+			e("tokens[%d]: at least one scope is required", i)
+		}
+		for _, scope := range t.Scopes {
+			if !scope.Valid() {
+				e("tokens[%d]: unknown scope %q, must be one of %s", i, scope, scopesString)
+			}
+		}
+	}
+
+	if !c.AuthEnabled() {
+		if host, _, err := net.SplitHostPort(c.Bind); err == nil && !isLoopback(host) {
+			w("API is bound to non-local address %q without authentication, "+
+				"configure tokens or tls client certificates", c.Bind)
+		}
+	}
+
+	return warns, errs
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
 }
@@ -1,8 +1,15 @@
 package api
 
 import (
+	"strings"
+
+	"go.minekube.com/common/minecraft/component"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.minekube.com/gate/pkg/edition/java/proto/util"
 	"go.minekube.com/gate/pkg/edition/java/proxy"
 	pb "go.minekube.com/gate/pkg/internal/api/gen/minekube/gate/v1"
+	"go.minekube.com/gate/pkg/util/access"
 )
 
 func PlayersToProto(p []proxy.Player) []*pb.Player {
@@ -29,9 +36,85 @@ func ServersToProto(s []proxy.RegisteredServer) []*pb.Server {
 }
 
 func ServerToProto(s proxy.RegisteredServer) *pb.Server {
+	var health proxy.ServerHealth
+	if h, ok := s.(proxy.ServerHealthReporter); ok {
+		health = h.Health()
+	}
 	return &pb.Server{
-		Name:    s.ServerInfo().Name(),
-		Address: s.ServerInfo().Addr().String(),
-		Players: int32(s.Players().Len()),
+		Name:      s.ServerInfo().Name(),
+		Address:   s.ServerInfo().Addr().String(),
+		Players:   int32(s.Players().Len()),
+		Health:    HealthStatusToProto(health.Status),
+		LatencyMs: health.Latency.Milliseconds(),
+	}
+}
+
+// HealthStatusToProto converts a server health status to its protobuf representation.
+func HealthStatusToProto(s proxy.HealthStatus) pb.ServerHealthStatus {
+	switch s {
+	case proxy.HealthyHealthStatus:
+		return pb.ServerHealthStatus_SERVER_HEALTH_STATUS_HEALTHY
+	case proxy.UnhealthyHealthStatus:
+		return pb.ServerHealthStatus_SERVER_HEALTH_STATUS_UNHEALTHY
+	default:
+		return pb.ServerHealthStatus_SERVER_HEALTH_STATUS_UNSPECIFIED
+	}
+}
+
+// ComponentToProto returns the component as JSON text component or empty if nil.
+func ComponentToProto(c component.Component) string {
+	if c == nil {
+		return ""
+	}
+	b := new(strings.Builder)
+	if err := util.LatestJsonCodec().Marshal(b, c); err != nil {
+		return ""
+	}
+	return b.String()
+}
+
+// LoginStatusToProto returns the string representation of the login status.
+func LoginStatusToProto(s proxy.LoginStatus) string {
+	switch s {
+	case proxy.SuccessfulLoginStatus:
+		return "successful"
+	case proxy.ConflictingLoginStatus:
+		return "conflicting"
+	case proxy.CanceledByUserLoginStatus:
+		return "canceled_by_user"
+	case proxy.CanceledByProxyLoginStatus:
+		return "canceled_by_proxy"
+	case proxy.CanceledByUserBeforeCompleteLoginStatus:
+		return "canceled_by_user_before_complete"
+	}
+	return "unknown"
+}
+
+// BanToProto converts a ban to its protobuf representation.
+func BanToProto(b *access.Ban) *pb.Ban {
+	ban := &pb.Ban{
+		Kind:    BanKindToProto(b.Kind),
+		Target:  b.Target,
+		Reason:  b.Reason,
+		Source:  b.Source,
+		Created: timestamppb.New(b.Created),
+	}
+	if !b.Permanent() {
+		ban.Expires = timestamppb.New(b.Expires)
+	}
+	return ban
+}
+
+// BanKindToProto converts a ban kind to its protobuf representation.
+func BanKindToProto(k access.Kind) pb.BanKind {
+	switch k {
+	case access.UUIDKind:
+		return pb.BanKind_BAN_KIND_UUID
+	case access.NameKind:
+		return pb.BanKind_BAN_KIND_NAME
+	case access.IPKind:
+		return pb.BanKind_BAN_KIND_IP
+	default:
+		return pb.BanKind_BAN_KIND_UNSPECIFIED
 	}
 }
@@ -9,6 +9,8 @@ package gatev1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,35 +23,1987 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// EventType is the type of a proxy event.
+type EventType int32
+
+const (
+	EventType_EVENT_TYPE_UNSPECIFIED        EventType = 0
+	EventType_EVENT_TYPE_POST_LOGIN         EventType = 1
+	EventType_EVENT_TYPE_DISCONNECT         EventType = 2
+	EventType_EVENT_TYPE_SERVER_CONNECTED   EventType = 3
+	EventType_EVENT_TYPE_KICKED_FROM_SERVER EventType = 4
+	EventType_EVENT_TYPE_PLAYER_CHAT        EventType = 5
+	EventType_EVENT_TYPE_COMMAND_EXECUTE    EventType = 6
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0: "EVENT_TYPE_UNSPECIFIED",
+		1: "EVENT_TYPE_POST_LOGIN",
+		2: "EVENT_TYPE_DISCONNECT",
+		3: "EVENT_TYPE_SERVER_CONNECTED",
+		4: "EVENT_TYPE_KICKED_FROM_SERVER",
+		5: "EVENT_TYPE_PLAYER_CHAT",
+		6: "EVENT_TYPE_COMMAND_EXECUTE",
+	}
+	EventType_value = map[string]int32{
+		"EVENT_TYPE_UNSPECIFIED":        0,
+		"EVENT_TYPE_POST_LOGIN":         1,
+		"EVENT_TYPE_DISCONNECT":         2,
+		"EVENT_TYPE_SERVER_CONNECTED":   3,
+		"EVENT_TYPE_KICKED_FROM_SERVER": 4,
+		"EVENT_TYPE_PLAYER_CHAT":        5,
+		"EVENT_TYPE_COMMAND_EXECUTE":    6,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_minekube_gate_v1_gate_service_proto_enumTypes[0].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_minekube_gate_v1_gate_service_proto_enumTypes[0]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{0}
+}
+
+// ServerHealthStatus is the health status of a server.
+type ServerHealthStatus int32
+
+const (
+	ServerHealthStatus_SERVER_HEALTH_STATUS_UNSPECIFIED ServerHealthStatus = 0
+	ServerHealthStatus_SERVER_HEALTH_STATUS_HEALTHY     ServerHealthStatus = 1
+	ServerHealthStatus_SERVER_HEALTH_STATUS_UNHEALTHY   ServerHealthStatus = 2
+)
+
+// Enum value maps for ServerHealthStatus.
+var (
+	ServerHealthStatus_name = map[int32]string{
+		0: "SERVER_HEALTH_STATUS_UNSPECIFIED",
+		1: "SERVER_HEALTH_STATUS_HEALTHY",
+		2: "SERVER_HEALTH_STATUS_UNHEALTHY",
+	}
+	ServerHealthStatus_value = map[string]int32{
+		"SERVER_HEALTH_STATUS_UNSPECIFIED": 0,
+		"SERVER_HEALTH_STATUS_HEALTHY":     1,
+		"SERVER_HEALTH_STATUS_UNHEALTHY":   2,
+	}
+)
+
+func (x ServerHealthStatus) Enum() *ServerHealthStatus {
+	p := new(ServerHealthStatus)
+	*p = x
+	return p
+}
+
+func (x ServerHealthStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ServerHealthStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_minekube_gate_v1_gate_service_proto_enumTypes[1].Descriptor()
+}
+
+func (ServerHealthStatus) Type() protoreflect.EnumType {
+	return &file_minekube_gate_v1_gate_service_proto_enumTypes[1]
+}
+
+func (x ServerHealthStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ServerHealthStatus.Descriptor instead.
+func (ServerHealthStatus) EnumDescriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{1}
+}
+
+// BanKind is the kind of target a ban applies to.
+type BanKind int32
+
+const (
+	BanKind_BAN_KIND_UNSPECIFIED BanKind = 0
+	// A player UUID.
+	BanKind_BAN_KIND_UUID BanKind = 1
+	// A case-insensitive username.
+	BanKind_BAN_KIND_NAME BanKind = 2
+	// A single IP address or a CIDR range.
+	BanKind_BAN_KIND_IP BanKind = 3
+)
+
+// Enum value maps for BanKind.
+var (
+	BanKind_name = map[int32]string{
+		0: "BAN_KIND_UNSPECIFIED",
+		1: "BAN_KIND_UUID",
+		2: "BAN_KIND_NAME",
+		3: "BAN_KIND_IP",
+	}
+	BanKind_value = map[string]int32{
+		"BAN_KIND_UNSPECIFIED": 0,
+		"BAN_KIND_UUID":        1,
+		"BAN_KIND_NAME":        2,
+		"BAN_KIND_IP":          3,
+	}
+)
+
+func (x BanKind) Enum() *BanKind {
+	p := new(BanKind)
+	*p = x
+	return p
+}
+
+func (x BanKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BanKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_minekube_gate_v1_gate_service_proto_enumTypes[2].Descriptor()
+}
+
+func (BanKind) Type() protoreflect.EnumType {
+	return &file_minekube_gate_v1_gate_service_proto_enumTypes[2]
+}
+
+func (x BanKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BanKind.Descriptor instead.
+func (BanKind) EnumDescriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{2}
+}
+
+// WatchEventsRequest is the request for WatchEvents method.
+type WatchEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filter events by type.
+	// Optional, if empty events of all types are streamed.
+	Types []EventType `protobuf:"varint,1,rep,packed,name=types,proto3,enum=minekube.gate.v1.EventType" json:"types,omitempty"`
+	// Filter events by player usernames or IDs.
+	// Optional, if empty events of all players are streamed.
+	Players []string `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
+	// Filter events by server names.
+	// Optional, if empty events on all servers are streamed.
+	// If specified, events without a server (e.g. a PostLogin event) are not streamed.
+	Servers       []string `protobuf:"bytes,3,rep,name=servers,proto3" json:"servers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEventsRequest) Reset() {
+	*x = WatchEventsRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventsRequest) ProtoMessage() {}
+
+func (x *WatchEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchEventsRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WatchEventsRequest) GetTypes() []EventType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *WatchEventsRequest) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *WatchEventsRequest) GetServers() []string {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+// WatchEventsResponse is a single event streamed by the WatchEvents method.
+type WatchEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEventsResponse) Reset() {
+	*x = WatchEventsResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventsResponse) ProtoMessage() {}
+
+func (x *WatchEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventsResponse.ProtoReflect.Descriptor instead.
+func (*WatchEventsResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WatchEventsResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// Event is a proxy event.
+type Event struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The type of the event.
+	Type EventType `protobuf:"varint,1,opt,name=type,proto3,enum=minekube.gate.v1.EventType" json:"type,omitempty"`
+	// The time the event was fired.
+	Time *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	// The player the event is about.
+	// Not set for commands executed by a non-player source like the console.
+	Player *Player `protobuf:"bytes,3,opt,name=player,proto3" json:"player,omitempty"`
+	// The name of the server the event happened on.
+	// For ServerConnected and KickedFromServer events this is the target server,
+	// for other events the server the player was connected to, if any.
+	Server string `protobuf:"bytes,4,opt,name=server,proto3" json:"server,omitempty"`
+	// The event specific data.
+	//
+	// Types that are valid to be assigned to Data:
+	//
+	//	*Event_PostLogin
+	//	*Event_Disconnect
+	//	*Event_ServerConnected
+	//	*Event_KickedFromServer
+	//	*Event_PlayerChat
+	//	*Event_CommandExecute
+	Data          isEvent_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Event) GetType() EventType {
+	if x != nil {
+		return x.Type
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *Event) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *Event) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *Event) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *Event) GetData() isEvent_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Event) GetPostLogin() *PostLoginEvent {
+	if x != nil {
+		if x, ok := x.Data.(*Event_PostLogin); ok {
+			return x.PostLogin
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetDisconnect() *DisconnectEvent {
+	if x != nil {
+		if x, ok := x.Data.(*Event_Disconnect); ok {
+			return x.Disconnect
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetServerConnected() *ServerConnectedEvent {
+	if x != nil {
+		if x, ok := x.Data.(*Event_ServerConnected); ok {
+			return x.ServerConnected
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetKickedFromServer() *KickedFromServerEvent {
+	if x != nil {
+		if x, ok := x.Data.(*Event_KickedFromServer); ok {
+			return x.KickedFromServer
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetPlayerChat() *PlayerChatEvent {
+	if x != nil {
+		if x, ok := x.Data.(*Event_PlayerChat); ok {
+			return x.PlayerChat
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetCommandExecute() *CommandExecuteEvent {
+	if x != nil {
+		if x, ok := x.Data.(*Event_CommandExecute); ok {
+			return x.CommandExecute
+		}
+	}
+	return nil
+}
+
+type isEvent_Data interface {
+	isEvent_Data()
+}
+
+type Event_PostLogin struct {
+	PostLogin *PostLoginEvent `protobuf:"bytes,10,opt,name=post_login,json=postLogin,proto3,oneof"`
+}
+
+type Event_Disconnect struct {
+	Disconnect *DisconnectEvent `protobuf:"bytes,11,opt,name=disconnect,proto3,oneof"`
+}
+
+type Event_ServerConnected struct {
+	ServerConnected *ServerConnectedEvent `protobuf:"bytes,12,opt,name=server_connected,json=serverConnected,proto3,oneof"`
+}
+
+type Event_KickedFromServer struct {
+	KickedFromServer *KickedFromServerEvent `protobuf:"bytes,13,opt,name=kicked_from_server,json=kickedFromServer,proto3,oneof"`
+}
+
+type Event_PlayerChat struct {
+	PlayerChat *PlayerChatEvent `protobuf:"bytes,14,opt,name=player_chat,json=playerChat,proto3,oneof"`
+}
+
+type Event_CommandExecute struct {
+	CommandExecute *CommandExecuteEvent `protobuf:"bytes,15,opt,name=command_execute,json=commandExecute,proto3,oneof"`
+}
+
+func (*Event_PostLogin) isEvent_Data() {}
+
+func (*Event_Disconnect) isEvent_Data() {}
+
+func (*Event_ServerConnected) isEvent_Data() {}
+
+func (*Event_KickedFromServer) isEvent_Data() {}
+
+func (*Event_PlayerChat) isEvent_Data() {}
+
+func (*Event_CommandExecute) isEvent_Data() {}
+
+// PostLoginEvent is fired once a player has logged in to the proxy.
+type PostLoginEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostLoginEvent) Reset() {
+	*x = PostLoginEvent{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostLoginEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostLoginEvent) ProtoMessage() {}
+
+func (x *PostLoginEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostLoginEvent.ProtoReflect.Descriptor instead.
+func (*PostLoginEvent) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{3}
+}
+
+// DisconnectEvent is fired when a player disconnected from the proxy.
+type DisconnectEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The login status of the player when they disconnected
+	// (e.g. "successful", "conflicting", "canceled_by_user", "canceled_by_proxy").
+	LoginStatus   string `protobuf:"bytes,1,opt,name=login_status,json=loginStatus,proto3" json:"login_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectEvent) Reset() {
+	*x = DisconnectEvent{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectEvent) ProtoMessage() {}
+
+func (x *DisconnectEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectEvent.ProtoReflect.Descriptor instead.
+func (*DisconnectEvent) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DisconnectEvent) GetLoginStatus() string {
+	if x != nil {
+		return x.LoginStatus
+	}
+	return ""
+}
+
+// ServerConnectedEvent is fired once a player has connected to a server.
+type ServerConnectedEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the server the player was connected to before, if any.
+	PreviousServer string `protobuf:"bytes,1,opt,name=previous_server,json=previousServer,proto3" json:"previous_server,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ServerConnectedEvent) Reset() {
+	*x = ServerConnectedEvent{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerConnectedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerConnectedEvent) ProtoMessage() {}
+
+func (x *ServerConnectedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerConnectedEvent.ProtoReflect.Descriptor instead.
+func (*ServerConnectedEvent) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ServerConnectedEvent) GetPreviousServer() string {
+	if x != nil {
+		return x.PreviousServer
+	}
+	return ""
+}
+
+// KickedFromServerEvent is fired when a player was kicked from a server.
+type KickedFromServerEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The kick reason as JSON text component, may be empty.
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Whether the player was kicked while connecting to the server.
+	DuringServerConnect bool `protobuf:"varint,2,opt,name=during_server_connect,json=duringServerConnect,proto3" json:"during_server_connect,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *KickedFromServerEvent) Reset() {
+	*x = KickedFromServerEvent{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickedFromServerEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickedFromServerEvent) ProtoMessage() {}
+
+func (x *KickedFromServerEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickedFromServerEvent.ProtoReflect.Descriptor instead.
+func (*KickedFromServerEvent) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *KickedFromServerEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *KickedFromServerEvent) GetDuringServerConnect() bool {
+	if x != nil {
+		return x.DuringServerConnect
+	}
+	return false
+}
+
+// PlayerChatEvent is fired when a player sent a chat message.
+type PlayerChatEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The chat message.
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// Whether the message was allowed by the proxy.
+	Allowed       bool `protobuf:"varint,2,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerChatEvent) Reset() {
+	*x = PlayerChatEvent{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerChatEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerChatEvent) ProtoMessage() {}
+
+func (x *PlayerChatEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerChatEvent.ProtoReflect.Descriptor instead.
+func (*PlayerChatEvent) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PlayerChatEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PlayerChatEvent) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+// CommandExecuteEvent is fired when a command is executed.
+type CommandExecuteEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The command line without the leading slash.
+	Command string `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	// Whether the command was allowed by the proxy.
+	Allowed       bool `protobuf:"varint,2,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandExecuteEvent) Reset() {
+	*x = CommandExecuteEvent{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandExecuteEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandExecuteEvent) ProtoMessage() {}
+
+func (x *CommandExecuteEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandExecuteEvent.ProtoReflect.Descriptor instead.
+func (*CommandExecuteEvent) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CommandExecuteEvent) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *CommandExecuteEvent) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
 // StoreCookieRequest is the request for StoreCookie method.
 type StoreCookieRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The player's username or ID
-	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
-	// The key of the cookie in format `namespace:key`
-	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	// The payload to store.
-	// Passing an empty payload will remove the cookie.
-	Payload       []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	// The player's username or ID
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// The key of the cookie in format `namespace:key`
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// The payload to store.
+	// Passing an empty payload will remove the cookie.
+	Payload       []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreCookieRequest) Reset() {
+	*x = StoreCookieRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreCookieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreCookieRequest) ProtoMessage() {}
+
+func (x *StoreCookieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreCookieRequest.ProtoReflect.Descriptor instead.
+func (*StoreCookieRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StoreCookieRequest) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *StoreCookieRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StoreCookieRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// StoreCookieResponse is the response for StoreCookie method.
+type StoreCookieResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreCookieResponse) Reset() {
+	*x = StoreCookieResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreCookieResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreCookieResponse) ProtoMessage() {}
+
+func (x *StoreCookieResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreCookieResponse.ProtoReflect.Descriptor instead.
+func (*StoreCookieResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{10}
+}
+
+// RequestCookieRequest is the request for RequestCookie method.
+type RequestCookieRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The player's username or ID
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// The key of the cookie in format `namespace:key`
+	Key           string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestCookieRequest) Reset() {
+	*x = RequestCookieRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestCookieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestCookieRequest) ProtoMessage() {}
+
+func (x *RequestCookieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestCookieRequest.ProtoReflect.Descriptor instead.
+func (*RequestCookieRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RequestCookieRequest) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *RequestCookieRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// RequestCookieResponse is the response for RequestCookie method.
+type RequestCookieResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The payload of the cookie.
+	// May be empty if the cookie is not found.
+	Payload       []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestCookieResponse) Reset() {
+	*x = RequestCookieResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestCookieResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestCookieResponse) ProtoMessage() {}
+
+func (x *RequestCookieResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestCookieResponse.ProtoReflect.Descriptor instead.
+func (*RequestCookieResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RequestCookieResponse) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// DisconnectPlayerRequest is the request for DisconnectPlayer method.
+type DisconnectPlayerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The player's username or ID to disconnect
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// The reason displayed to the player when they are disconnected.
+	//
+	// Formats:
+	//
+	// - `{"text":"Hello, world!"}` - JSON text component. See https://wiki.vg/Text_formatting for details.
+	//
+	// - `§aHello,\n§bworld!` - Simple color codes. See https://wiki.vg/Text_formatting#Colors
+	//
+	// Optional, if empty no reason will be shown.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectPlayerRequest) Reset() {
+	*x = DisconnectPlayerRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectPlayerRequest) ProtoMessage() {}
+
+func (x *DisconnectPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectPlayerRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DisconnectPlayerRequest) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *DisconnectPlayerRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// DisconnectPlayerResponse is the response for DisconnectPlayer method.
+type DisconnectPlayerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectPlayerResponse) Reset() {
+	*x = DisconnectPlayerResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectPlayerResponse) ProtoMessage() {}
+
+func (x *DisconnectPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectPlayerResponse.ProtoReflect.Descriptor instead.
+func (*DisconnectPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{14}
+}
+
+// ConnectPlayerRequest is the request for ConnectPlayer method.
+type ConnectPlayerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The player's username or ID to connect
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// The target server name to connect the player to
+	Server        string `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectPlayerRequest) Reset() {
+	*x = ConnectPlayerRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectPlayerRequest) ProtoMessage() {}
+
+func (x *ConnectPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectPlayerRequest.ProtoReflect.Descriptor instead.
+func (*ConnectPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ConnectPlayerRequest) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *ConnectPlayerRequest) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+// ConnectPlayerResponse is the response for ConnectPlayer method.
+type ConnectPlayerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectPlayerResponse) Reset() {
+	*x = ConnectPlayerResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectPlayerResponse) ProtoMessage() {}
+
+func (x *ConnectPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectPlayerResponse.ProtoReflect.Descriptor instead.
+func (*ConnectPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{16}
+}
+
+// RegisterServerRequest is the request for RegisterServer method.
+type RegisterServerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique name of the server
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The network address of the server (e.g. "localhost:25565")
+	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterServerRequest) Reset() {
+	*x = RegisterServerRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterServerRequest) ProtoMessage() {}
+
+func (x *RegisterServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterServerRequest.ProtoReflect.Descriptor instead.
+func (*RegisterServerRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RegisterServerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegisterServerRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// RegisterServerResponse is the response for RegisterServer method.
+type RegisterServerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterServerResponse) Reset() {
+	*x = RegisterServerResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterServerResponse) ProtoMessage() {}
+
+func (x *RegisterServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterServerResponse.ProtoReflect.Descriptor instead.
+func (*RegisterServerResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{18}
+}
+
+// UnregisterServerRequest is the request for UnregisterServer method.
+type UnregisterServerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the server.
+	// Optional, if not set, the address will be used to match servers.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The address of the server.
+	// Optional, if not set, the name will be used to match servers.
+	// If both name and address are set, only the server that matches both properties exactly will be unregistered.
+	// If only the address is set, the first server matching that address will be unregistered.
+	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregisterServerRequest) Reset() {
+	*x = UnregisterServerRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterServerRequest) ProtoMessage() {}
+
+func (x *UnregisterServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterServerRequest.ProtoReflect.Descriptor instead.
+func (*UnregisterServerRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UnregisterServerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UnregisterServerRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// UnregisterServerResponse is the response for UnregisterServer method.
+type UnregisterServerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregisterServerResponse) Reset() {
+	*x = UnregisterServerResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterServerResponse) ProtoMessage() {}
+
+func (x *UnregisterServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterServerResponse.ProtoReflect.Descriptor instead.
+func (*UnregisterServerResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{20}
+}
+
+// ListServersRequest is the request for ListServers method.
+type ListServersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServersRequest) Reset() {
+	*x = ListServersRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServersRequest) ProtoMessage() {}
+
+func (x *ListServersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServersRequest.ProtoReflect.Descriptor instead.
+func (*ListServersRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{21}
+}
+
+// ListServersResponse is the response for ListServers method.
+type ListServersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Servers       []*Server              `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServersResponse) Reset() {
+	*x = ListServersResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServersResponse) ProtoMessage() {}
+
+func (x *ListServersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServersResponse.ProtoReflect.Descriptor instead.
+func (*ListServersResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListServersResponse) GetServers() []*Server {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+// Server represents a backend server where Gate can connect players to.
+type Server struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique name of the server.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The network address of the server.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// The number of players currently on the server.
+	Players int32 `protobuf:"varint,3,opt,name=players,proto3" json:"players,omitempty"`
+	// The health status of the server determined by health checks.
+	// Unspecified if health checks are disabled or the server was not checked yet.
+	Health ServerHealthStatus `protobuf:"varint,4,opt,name=health,proto3,enum=minekube.gate.v1.ServerHealthStatus" json:"health,omitempty"`
+	// The round-trip time of the last successful health check in milliseconds, 0 if none.
+	LatencyMs     int64 `protobuf:"varint,5,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server) Reset() {
+	*x = Server{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server) ProtoMessage() {}
+
+func (x *Server) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server.ProtoReflect.Descriptor instead.
+func (*Server) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Server) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Server) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Server) GetPlayers() int32 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+func (x *Server) GetHealth() ServerHealthStatus {
+	if x != nil {
+		return x.Health
+	}
+	return ServerHealthStatus_SERVER_HEALTH_STATUS_UNSPECIFIED
+}
+
+func (x *Server) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+// GetPlayerRequest is the request for GetPlayer method.
+type GetPlayerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Gets the player by their Minecraft UUID.
+	// Optional, if not set the username will be used.
+	// If both id and username are set, the id will be used.
+	// Must be a valid Minecraft UUID format (e.g. "550e8400-e29b-41d4-a716-446655440000")
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Gets the player by their username.
+	// Optional, if not set the id will be used.
+	// Case-sensitive.
+	Username      string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayerRequest) Reset() {
+	*x = GetPlayerRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerRequest) ProtoMessage() {}
+
+func (x *GetPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetPlayerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetPlayerRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// GetPlayerResponse is the response for GetPlayer method.
+type GetPlayerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The player matching the request criteria
+	Player        *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayerResponse) Reset() {
+	*x = GetPlayerResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerResponse) ProtoMessage() {}
+
+func (x *GetPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetPlayerResponse) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+// ListPlayersRequest is the request for ListPlayers method.
+type ListPlayersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filter players by server names.
+	// Optional, if empty all online players are returned.
+	// If specified, only returns players on the listed servers.
+	Servers       []string `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlayersRequest) Reset() {
+	*x = ListPlayersRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlayersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlayersRequest) ProtoMessage() {}
+
+func (x *ListPlayersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlayersRequest.ProtoReflect.Descriptor instead.
+func (*ListPlayersRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListPlayersRequest) GetServers() []string {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+// ListPlayersResponse is the response for ListPlayers method.
+type ListPlayersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Players       []*Player              `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlayersResponse) Reset() {
+	*x = ListPlayersResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlayersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlayersResponse) ProtoMessage() {}
+
+func (x *ListPlayersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlayersResponse.ProtoReflect.Descriptor instead.
+func (*ListPlayersResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListPlayersResponse) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+// Player represents an online player on the proxy.
+type Player struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The player's Minecraft UUID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The player's username
+	Username      string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Player) Reset() {
+	*x = Player{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Player) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Player) ProtoMessage() {}
+
+func (x *Player) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Player.ProtoReflect.Descriptor instead.
+func (*Player) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Player) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Player) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// Ban bans a player UUID, username, IP address or CIDR range from joining the proxy.
+type Ban struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The kind of the target.
+	Kind BanKind `protobuf:"varint,1,opt,name=kind,proto3,enum=minekube.gate.v1.BanKind" json:"kind,omitempty"`
+	// The normalized target: a UUID, lowercase username, IP address or CIDR range.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// The reason shown to banned players, as JSON text component or with simple color codes.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Who created the ban.
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	// The time the ban was created.
+	Created *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty"`
+	// The time the ban expires.
+	// Not set for permanent bans.
+	Expires       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires,proto3" json:"expires,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ban) Reset() {
+	*x = Ban{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ban) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ban) ProtoMessage() {}
+
+func (x *Ban) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ban.ProtoReflect.Descriptor instead.
+func (*Ban) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Ban) GetKind() BanKind {
+	if x != nil {
+		return x.Kind
+	}
+	return BanKind_BAN_KIND_UNSPECIFIED
+}
+
+func (x *Ban) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Ban) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Ban) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Ban) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Ban) GetExpires() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expires
+	}
+	return nil
+}
+
+// ListBansRequest is the request for ListBans method.
+type ListBansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBansRequest) Reset() {
+	*x = ListBansRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBansRequest) ProtoMessage() {}
+
+func (x *ListBansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBansRequest.ProtoReflect.Descriptor instead.
+func (*ListBansRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{30}
+}
+
+// ListBansResponse is the response for ListBans method.
+type ListBansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bans          []*Ban                 `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBansResponse) Reset() {
+	*x = ListBansResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBansResponse) ProtoMessage() {}
+
+func (x *ListBansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBansResponse.ProtoReflect.Descriptor instead.
+func (*ListBansResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListBansResponse) GetBans() []*Ban {
+	if x != nil {
+		return x.Bans
+	}
+	return nil
+}
+
+// AddBanRequest is the request for AddBan method.
+type AddBanRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The player UUID, username, IP address or CIDR range to ban.
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// The reason shown to banned players.
+	//
+	// Formats:
+	//
+	// - `{"text":"Hello, world!"}` - JSON text component. See https://wiki.vg/Text_formatting for details.
+	//
+	// - `§aHello,\n§bworld!` - Simple color codes. See https://wiki.vg/Text_formatting#Colors
+	//
+	// Optional, if empty no reason will be shown.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Who creates the ban.
+	// Optional, defaults to "API".
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	// How long the ban lasts.
+	// Optional, if not set the ban is permanent.
+	Duration      *durationpb.Duration `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StoreCookieRequest) Reset() {
-	*x = StoreCookieRequest{}
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[0]
+func (x *AddBanRequest) Reset() {
+	*x = AddBanRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StoreCookieRequest) String() string {
+func (x *AddBanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StoreCookieRequest) ProtoMessage() {}
+func (*AddBanRequest) ProtoMessage() {}
 
-func (x *StoreCookieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[0]
+func (x *AddBanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -60,54 +2014,63 @@ func (x *StoreCookieRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StoreCookieRequest.ProtoReflect.Descriptor instead.
-func (*StoreCookieRequest) Descriptor() ([]byte, []int) {
-	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use AddBanRequest.ProtoReflect.Descriptor instead.
+func (*AddBanRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *StoreCookieRequest) GetPlayer() string {
+func (x *AddBanRequest) GetTarget() string {
 	if x != nil {
-		return x.Player
+		return x.Target
 	}
 	return ""
 }
 
-func (x *StoreCookieRequest) GetKey() string {
+func (x *AddBanRequest) GetReason() string {
 	if x != nil {
-		return x.Key
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *StoreCookieRequest) GetPayload() []byte {
+func (x *AddBanRequest) GetSource() string {
 	if x != nil {
-		return x.Payload
+		return x.Source
+	}
+	return ""
+}
+
+func (x *AddBanRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
 	}
 	return nil
 }
 
-// StoreCookieResponse is the response for StoreCookie method.
-type StoreCookieResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// AddBanResponse is the response for AddBan method.
+type AddBanResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created ban.
+	Ban           *Ban `protobuf:"bytes,1,opt,name=ban,proto3" json:"ban,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StoreCookieResponse) Reset() {
-	*x = StoreCookieResponse{}
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[1]
+func (x *AddBanResponse) Reset() {
+	*x = AddBanResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StoreCookieResponse) String() string {
+func (x *AddBanResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StoreCookieResponse) ProtoMessage() {}
+func (*AddBanResponse) ProtoMessage() {}
 
-func (x *StoreCookieResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[1]
+func (x *AddBanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -118,37 +2081,42 @@ func (x *StoreCookieResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StoreCookieResponse.ProtoReflect.Descriptor instead.
-func (*StoreCookieResponse) Descriptor() ([]byte, []int) {
-	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use AddBanResponse.ProtoReflect.Descriptor instead.
+func (*AddBanResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{33}
 }
 
-// RequestCookieRequest is the request for RequestCookie method.
-type RequestCookieRequest struct {
+func (x *AddBanResponse) GetBan() *Ban {
+	if x != nil {
+		return x.Ban
+	}
+	return nil
+}
+
+// RemoveBanRequest is the request for RemoveBan method.
+type RemoveBanRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The player's username or ID
-	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
-	// The key of the cookie in format `namespace:key`
-	Key           string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// The banned player UUID, username, IP address or CIDR range.
+	Target        string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RequestCookieRequest) Reset() {
-	*x = RequestCookieRequest{}
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[2]
+func (x *RemoveBanRequest) Reset() {
+	*x = RemoveBanRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RequestCookieRequest) String() string {
+func (x *RemoveBanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestCookieRequest) ProtoMessage() {}
+func (*RemoveBanRequest) ProtoMessage() {}
 
-func (x *RequestCookieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[2]
+func (x *RemoveBanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -159,50 +2127,40 @@ func (x *RequestCookieRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestCookieRequest.ProtoReflect.Descriptor instead.
-func (*RequestCookieRequest) Descriptor() ([]byte, []int) {
-	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *RequestCookieRequest) GetPlayer() string {
-	if x != nil {
-		return x.Player
-	}
-	return ""
+// Deprecated: Use RemoveBanRequest.ProtoReflect.Descriptor instead.
+func (*RemoveBanRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *RequestCookieRequest) GetKey() string {
+func (x *RemoveBanRequest) GetTarget() string {
 	if x != nil {
-		return x.Key
+		return x.Target
 	}
 	return ""
 }
 
-// RequestCookieResponse is the response for RequestCookie method.
-type RequestCookieResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The payload of the cookie.
-	// May be empty if the cookie is not found.
-	Payload       []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+// RemoveBanResponse is the response for RemoveBan method.
+type RemoveBanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RequestCookieResponse) Reset() {
-	*x = RequestCookieResponse{}
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[3]
+func (x *RemoveBanResponse) Reset() {
+	*x = RemoveBanResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RequestCookieResponse) String() string {
+func (x *RemoveBanResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestCookieResponse) ProtoMessage() {}
+func (*RemoveBanResponse) ProtoMessage() {}
 
-func (x *RequestCookieResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[3]
+func (x *RemoveBanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -213,52 +2171,33 @@ func (x *RequestCookieResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestCookieResponse.ProtoReflect.Descriptor instead.
-func (*RequestCookieResponse) Descriptor() ([]byte, []int) {
-	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *RequestCookieResponse) GetPayload() []byte {
-	if x != nil {
-		return x.Payload
-	}
-	return nil
+// Deprecated: Use RemoveBanResponse.ProtoReflect.Descriptor instead.
+func (*RemoveBanResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{35}
 }
 
-// DisconnectPlayerRequest is the request for DisconnectPlayer method.
-type DisconnectPlayerRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The player's username or ID to disconnect
-	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
-	// The reason displayed to the player when they are disconnected.
-	//
-	// Formats:
-	//
-	// - `{"text":"Hello, world!"}` - JSON text component. See https://wiki.vg/Text_formatting for details.
-	//
-	// - `§aHello,\n§bworld!` - Simple color codes. See https://wiki.vg/Text_formatting#Colors
-	//
-	// Optional, if empty no reason will be shown.
-	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+// GetAllowlistRequest is the request for GetAllowlist method.
+type GetAllowlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DisconnectPlayerRequest) Reset() {
-	*x = DisconnectPlayerRequest{}
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[4]
+func (x *GetAllowlistRequest) Reset() {
+	*x = GetAllowlistRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DisconnectPlayerRequest) String() string {
+func (x *GetAllowlistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisconnectPlayerRequest) ProtoMessage() {}
+func (*GetAllowlistRequest) ProtoMessage() {}
 
-func (x *DisconnectPlayerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[4]
+func (x *GetAllowlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -269,47 +2208,37 @@ func (x *DisconnectPlayerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisconnectPlayerRequest.ProtoReflect.Descriptor instead.
-func (*DisconnectPlayerRequest) Descriptor() ([]byte, []int) {
-	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *DisconnectPlayerRequest) GetPlayer() string {
-	if x != nil {
-		return x.Player
-	}
-	return ""
-}
-
-func (x *DisconnectPlayerRequest) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
+// Deprecated: Use GetAllowlistRequest.ProtoReflect.Descriptor instead.
+func (*GetAllowlistRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{36}
 }
 
-// DisconnectPlayerResponse is the response for DisconnectPlayer method.
-type DisconnectPlayerResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// GetAllowlistResponse is the response for GetAllowlist method.
+type GetAllowlistResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether only allowlisted players may join.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The allowlisted player UUIDs and lowercase usernames.
+	Entries       []string `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DisconnectPlayerResponse) Reset() {
-	*x = DisconnectPlayerResponse{}
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[5]
+func (x *GetAllowlistResponse) Reset() {
+	*x = GetAllowlistResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DisconnectPlayerResponse) String() string {
+func (x *GetAllowlistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisconnectPlayerResponse) ProtoMessage() {}
+func (*GetAllowlistResponse) ProtoMessage() {}
 
-func (x *DisconnectPlayerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[5]
+func (x *GetAllowlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -320,37 +2249,49 @@ func (x *DisconnectPlayerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisconnectPlayerResponse.ProtoReflect.Descriptor instead.
-func (*DisconnectPlayerResponse) Descriptor() ([]byte, []int) {
-	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetAllowlistResponse.ProtoReflect.Descriptor instead.
+func (*GetAllowlistResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{37}
 }
 
-// ConnectPlayerRequest is the request for ConnectPlayer method.
-type ConnectPlayerRequest struct {
+func (x *GetAllowlistResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *GetAllowlistResponse) GetEntries() []string {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// SetAllowlistEnabledRequest is the request for SetAllowlistEnabled method.
+type SetAllowlistEnabledRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The player's username or ID to connect
-	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
-	// The target server name to connect the player to
-	Server        string `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	// Whether only allowlisted players may join.
+	Enabled       bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConnectPlayerRequest) Reset() {
-	*x = ConnectPlayerRequest{}
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[6]
+func (x *SetAllowlistEnabledRequest) Reset() {
+	*x = SetAllowlistEnabledRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConnectPlayerRequest) String() string {
+func (x *SetAllowlistEnabledRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectPlayerRequest) ProtoMessage() {}
+func (*SetAllowlistEnabledRequest) ProtoMessage() {}
 
-func (x *ConnectPlayerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[6]
+func (x *SetAllowlistEnabledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -361,47 +2302,40 @@ func (x *ConnectPlayerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectPlayerRequest.ProtoReflect.Descriptor instead.
-func (*ConnectPlayerRequest) Descriptor() ([]byte, []int) {
-	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *ConnectPlayerRequest) GetPlayer() string {
-	if x != nil {
-		return x.Player
-	}
-	return ""
+// Deprecated: Use SetAllowlistEnabledRequest.ProtoReflect.Descriptor instead.
+func (*SetAllowlistEnabledRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ConnectPlayerRequest) GetServer() string {
+func (x *SetAllowlistEnabledRequest) GetEnabled() bool {
 	if x != nil {
-		return x.Server
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-// ConnectPlayerResponse is the response for ConnectPlayer method.
-type ConnectPlayerResponse struct {
+// SetAllowlistEnabledResponse is the response for SetAllowlistEnabled method.
+type SetAllowlistEnabledResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConnectPlayerResponse) Reset() {
-	*x = ConnectPlayerResponse{}
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[7]
+func (x *SetAllowlistEnabledResponse) Reset() {
+	*x = SetAllowlistEnabledResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConnectPlayerResponse) String() string {
+func (x *SetAllowlistEnabledResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectPlayerResponse) ProtoMessage() {}
+func (*SetAllowlistEnabledResponse) ProtoMessage() {}
 
-func (x *ConnectPlayerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[7]
+func (x *SetAllowlistEnabledResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -412,37 +2346,35 @@ func (x *ConnectPlayerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectPlayerResponse.ProtoReflect.Descriptor instead.
-func (*ConnectPlayerResponse) Descriptor() ([]byte, []int) {
-	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use SetAllowlistEnabledResponse.ProtoReflect.Descriptor instead.
+func (*SetAllowlistEnabledResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{39}
 }
 
-// RegisterServerRequest is the request for RegisterServer method.
-type RegisterServerRequest struct {
+// AddAllowlistEntryRequest is the request for AddAllowlistEntry method.
+type AddAllowlistEntryRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The unique name of the server
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The network address of the server (e.g. "localhost:25565")
-	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// The player UUID or username to allowlist.
+	Entry         string `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterServerRequest) Reset() {
-	*x = RegisterServerRequest{}
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[8]
+func (x *AddAllowlistEntryRequest) Reset() {
+	*x = AddAllowlistEntryRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterServerRequest) String() string {
+func (x *AddAllowlistEntryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterServerRequest) ProtoMessage() {}
+func (*AddAllowlistEntryRequest) ProtoMessage() {}
 
-func (x *RegisterServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[8]
+func (x *AddAllowlistEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -453,47 +2385,40 @@ func (x *RegisterServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterServerRequest.ProtoReflect.Descriptor instead.
-func (*RegisterServerRequest) Descriptor() ([]byte, []int) {
-	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *RegisterServerRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use AddAllowlistEntryRequest.ProtoReflect.Descriptor instead.
+func (*AddAllowlistEntryRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *RegisterServerRequest) GetAddress() string {
+func (x *AddAllowlistEntryRequest) GetEntry() string {
 	if x != nil {
-		return x.Address
+		return x.Entry
 	}
 	return ""
 }
 
-// RegisterServerResponse is the response for RegisterServer method.
-type RegisterServerResponse struct {
+// AddAllowlistEntryResponse is the response for AddAllowlistEntry method.
+type AddAllowlistEntryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterServerResponse) Reset() {
-	*x = RegisterServerResponse{}
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[9]
+func (x *AddAllowlistEntryResponse) Reset() {
+	*x = AddAllowlistEntryResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterServerResponse) String() string {
+func (x *AddAllowlistEntryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterServerResponse) ProtoMessage() {}
+func (*AddAllowlistEntryResponse) ProtoMessage() {}
 
-func (x *RegisterServerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[9]
+func (x *AddAllowlistEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -504,41 +2429,35 @@ func (x *RegisterServerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterServerResponse.ProtoReflect.Descriptor instead.
-func (*RegisterServerResponse) Descriptor() ([]byte, []int) {
-	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use AddAllowlistEntryResponse.ProtoReflect.Descriptor instead.
+func (*AddAllowlistEntryResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{41}
 }
 
-// UnregisterServerRequest is the request for UnregisterServer method.
-type UnregisterServerRequest struct {
+// RemoveAllowlistEntryRequest is the request for RemoveAllowlistEntry method.
+type RemoveAllowlistEntryRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the server.
-	// Optional, if not set, the address will be used to match servers.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The address of the server.
-	// Optional, if not set, the name will be used to match servers.
-	// If both name and address are set, only the server that matches both properties exactly will be unregistered.
-	// If only the address is set, the first server matching that address will be unregistered.
-	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// The allowlisted player UUID or username.
+	Entry         string `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UnregisterServerRequest) Reset() {
-	*x = UnregisterServerRequest{}
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[10]
+}
+
+func (x *RemoveAllowlistEntryRequest) Reset() {
+	*x = RemoveAllowlistEntryRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnregisterServerRequest) String() string {
+func (x *RemoveAllowlistEntryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnregisterServerRequest) ProtoMessage() {}
+func (*RemoveAllowlistEntryRequest) ProtoMessage() {}
 
-func (x *UnregisterServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[10]
+func (x *RemoveAllowlistEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -549,47 +2468,40 @@ func (x *UnregisterServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnregisterServerRequest.ProtoReflect.Descriptor instead.
-func (*UnregisterServerRequest) Descriptor() ([]byte, []int) {
-	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *UnregisterServerRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use RemoveAllowlistEntryRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAllowlistEntryRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *UnregisterServerRequest) GetAddress() string {
+func (x *RemoveAllowlistEntryRequest) GetEntry() string {
 	if x != nil {
-		return x.Address
+		return x.Entry
 	}
 	return ""
 }
 
-// UnregisterServerResponse is the response for UnregisterServer method.
-type UnregisterServerResponse struct {
+// RemoveAllowlistEntryResponse is the response for RemoveAllowlistEntry method.
+type RemoveAllowlistEntryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnregisterServerResponse) Reset() {
-	*x = UnregisterServerResponse{}
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[11]
+func (x *RemoveAllowlistEntryResponse) Reset() {
+	*x = RemoveAllowlistEntryResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnregisterServerResponse) String() string {
+func (x *RemoveAllowlistEntryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnregisterServerResponse) ProtoMessage() {}
+func (*RemoveAllowlistEntryResponse) ProtoMessage() {}
 
-func (x *UnregisterServerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[11]
+func (x *RemoveAllowlistEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -600,33 +2512,36 @@ func (x *UnregisterServerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnregisterServerResponse.ProtoReflect.Descriptor instead.
-func (*UnregisterServerResponse) Descriptor() ([]byte, []int) {
-	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use RemoveAllowlistEntryResponse.ProtoReflect.Descriptor instead.
+func (*RemoveAllowlistEntryResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{43}
 }
 
-// ListServersRequest is the request for ListServers method.
-type ListServersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// ListQueuesRequest is the request for ListQueues method.
+type ListQueuesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filter queues by server names.
+	// Optional, if empty the queues of all servers are returned.
+	Servers       []string `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListServersRequest) Reset() {
-	*x = ListServersRequest{}
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[12]
+func (x *ListQueuesRequest) Reset() {
+	*x = ListQueuesRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListServersRequest) String() string {
+func (x *ListQueuesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListServersRequest) ProtoMessage() {}
+func (*ListQueuesRequest) ProtoMessage() {}
 
-func (x *ListServersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[12]
+func (x *ListQueuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -637,34 +2552,41 @@ func (x *ListServersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListServersRequest.ProtoReflect.Descriptor instead.
-func (*ListServersRequest) Descriptor() ([]byte, []int) {
-	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListQueuesRequest.ProtoReflect.Descriptor instead.
+func (*ListQueuesRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{44}
 }
 
-// ListServersResponse is the response for ListServers method.
-type ListServersResponse struct {
+func (x *ListQueuesRequest) GetServers() []string {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+// ListQueuesResponse is the response for ListQueues method.
+type ListQueuesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Servers       []*Server              `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	Queues        []*ServerQueue         `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListServersResponse) Reset() {
-	*x = ListServersResponse{}
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[13]
+func (x *ListQueuesResponse) Reset() {
+	*x = ListQueuesResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListServersResponse) String() string {
+func (x *ListQueuesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListServersResponse) ProtoMessage() {}
+func (*ListQueuesResponse) ProtoMessage() {}
 
-func (x *ListServersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[13]
+func (x *ListQueuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -675,46 +2597,44 @@ func (x *ListServersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListServersResponse.ProtoReflect.Descriptor instead.
-func (*ListServersResponse) Descriptor() ([]byte, []int) {
-	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ListQueuesResponse.ProtoReflect.Descriptor instead.
+func (*ListQueuesResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ListServersResponse) GetServers() []*Server {
+func (x *ListQueuesResponse) GetQueues() []*ServerQueue {
 	if x != nil {
-		return x.Servers
+		return x.Queues
 	}
 	return nil
 }
 
-// Server represents a backend server where Gate can connect players to.
-type Server struct {
+// ServerQueue is the queue of a full or unavailable server.
+type ServerQueue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The unique name of the server.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The network address of the server.
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	// The number of players currently on the server.
-	Players       int32 `protobuf:"varint,3,opt,name=players,proto3" json:"players,omitempty"`
+	// The name of the server.
+	Server string `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	// The queued players in queue order.
+	Players       []*Player `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Server) Reset() {
-	*x = Server{}
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[14]
+func (x *ServerQueue) Reset() {
+	*x = ServerQueue{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Server) String() string {
+func (x *ServerQueue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Server) ProtoMessage() {}
+func (*ServerQueue) ProtoMessage() {}
 
-func (x *Server) ProtoReflect() protoreflect.Message {
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[14]
+func (x *ServerQueue) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,63 +2645,49 @@ func (x *Server) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Server.ProtoReflect.Descriptor instead.
-func (*Server) Descriptor() ([]byte, []int) {
-	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *Server) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use ServerQueue.ProtoReflect.Descriptor instead.
+func (*ServerQueue) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *Server) GetAddress() string {
+func (x *ServerQueue) GetServer() string {
 	if x != nil {
-		return x.Address
+		return x.Server
 	}
 	return ""
 }
 
-func (x *Server) GetPlayers() int32 {
+func (x *ServerQueue) GetPlayers() []*Player {
 	if x != nil {
 		return x.Players
 	}
-	return 0
+	return nil
 }
 
-// GetPlayerRequest is the request for GetPlayer method.
-type GetPlayerRequest struct {
+// GetQueuePositionRequest is the request for GetQueuePosition method.
+type GetQueuePositionRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Gets the player by their Minecraft UUID.
-	// Optional, if not set the username will be used.
-	// If both id and username are set, the id will be used.
-	// Must be a valid Minecraft UUID format (e.g. "550e8400-e29b-41d4-a716-446655440000")
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Gets the player by their username.
-	// Optional, if not set the id will be used.
-	// Case-sensitive.
-	Username      string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// The player's username or ID
+	Player        string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPlayerRequest) Reset() {
-	*x = GetPlayerRequest{}
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[15]
+func (x *GetQueuePositionRequest) Reset() {
+	*x = GetQueuePositionRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPlayerRequest) String() string {
+func (x *GetQueuePositionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlayerRequest) ProtoMessage() {}
+func (*GetQueuePositionRequest) ProtoMessage() {}
 
-func (x *GetPlayerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[15]
+func (x *GetQueuePositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -792,49 +2698,46 @@ func (x *GetPlayerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlayerRequest.ProtoReflect.Descriptor instead.
-func (*GetPlayerRequest) Descriptor() ([]byte, []int) {
-	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *GetPlayerRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use GetQueuePositionRequest.ProtoReflect.Descriptor instead.
+func (*GetQueuePositionRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetPlayerRequest) GetUsername() string {
+func (x *GetQueuePositionRequest) GetPlayer() string {
 	if x != nil {
-		return x.Username
+		return x.Player
 	}
 	return ""
 }
 
-// GetPlayerResponse is the response for GetPlayer method.
-type GetPlayerResponse struct {
+// GetQueuePositionResponse is the response for GetQueuePosition method.
+type GetQueuePositionResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The player matching the request criteria
-	Player        *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// The name of the server the player is queued for.
+	Server string `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	// The player's position in the queue starting at 1.
+	Position int32 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	// The number of players in the queue.
+	Total         int32 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPlayerResponse) Reset() {
-	*x = GetPlayerResponse{}
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[16]
+func (x *GetQueuePositionResponse) Reset() {
+	*x = GetQueuePositionResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPlayerResponse) String() string {
+func (x *GetQueuePositionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlayerResponse) ProtoMessage() {}
+func (*GetQueuePositionResponse) ProtoMessage() {}
 
-func (x *GetPlayerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[16]
+func (x *GetQueuePositionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -845,44 +2748,58 @@ func (x *GetPlayerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlayerResponse.ProtoReflect.Descriptor instead.
-func (*GetPlayerResponse) Descriptor() ([]byte, []int) {
-	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetQueuePositionResponse.ProtoReflect.Descriptor instead.
+func (*GetQueuePositionResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GetPlayerResponse) GetPlayer() *Player {
+func (x *GetQueuePositionResponse) GetServer() string {
 	if x != nil {
-		return x.Player
+		return x.Server
 	}
-	return nil
+	return ""
 }
 
-// ListPlayersRequest is the request for ListPlayers method.
-type ListPlayersRequest struct {
+func (x *GetQueuePositionResponse) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *GetQueuePositionResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// AddToQueueRequest is the request for AddToQueue method.
+type AddToQueueRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Filter players by server names.
-	// Optional, if empty all online players are returned.
-	// If specified, only returns players on the listed servers.
-	Servers       []string `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	// The player's username or ID to queue
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// The server name to queue the player for
+	Server        string `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPlayersRequest) Reset() {
-	*x = ListPlayersRequest{}
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[17]
+func (x *AddToQueueRequest) Reset() {
+	*x = AddToQueueRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPlayersRequest) String() string {
+func (x *AddToQueueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPlayersRequest) ProtoMessage() {}
+func (*AddToQueueRequest) ProtoMessage() {}
 
-func (x *ListPlayersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[17]
+func (x *AddToQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -893,41 +2810,49 @@ func (x *ListPlayersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPlayersRequest.ProtoReflect.Descriptor instead.
-func (*ListPlayersRequest) Descriptor() ([]byte, []int) {
-	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use AddToQueueRequest.ProtoReflect.Descriptor instead.
+func (*AddToQueueRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ListPlayersRequest) GetServers() []string {
+func (x *AddToQueueRequest) GetPlayer() string {
 	if x != nil {
-		return x.Servers
+		return x.Player
 	}
-	return nil
+	return ""
 }
 
-// ListPlayersResponse is the response for ListPlayers method.
-type ListPlayersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Players       []*Player              `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+func (x *AddToQueueRequest) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+// AddToQueueResponse is the response for AddToQueue method.
+type AddToQueueResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The player's position in the queue starting at 1.
+	Position      int32 `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPlayersResponse) Reset() {
-	*x = ListPlayersResponse{}
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[18]
+func (x *AddToQueueResponse) Reset() {
+	*x = AddToQueueResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPlayersResponse) String() string {
+func (x *AddToQueueResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPlayersResponse) ProtoMessage() {}
+func (*AddToQueueResponse) ProtoMessage() {}
 
-func (x *ListPlayersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[18]
+func (x *AddToQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -938,44 +2863,42 @@ func (x *ListPlayersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPlayersResponse.ProtoReflect.Descriptor instead.
-func (*ListPlayersResponse) Descriptor() ([]byte, []int) {
-	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use AddToQueueResponse.ProtoReflect.Descriptor instead.
+func (*AddToQueueResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ListPlayersResponse) GetPlayers() []*Player {
+func (x *AddToQueueResponse) GetPosition() int32 {
 	if x != nil {
-		return x.Players
+		return x.Position
 	}
-	return nil
+	return 0
 }
 
-// Player represents an online player on the proxy.
-type Player struct {
+// RemoveFromQueueRequest is the request for RemoveFromQueue method.
+type RemoveFromQueueRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The player's Minecraft UUID
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The player's username
-	Username      string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// The player's username or ID to remove from the queue
+	Player        string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Player) Reset() {
-	*x = Player{}
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[19]
+func (x *RemoveFromQueueRequest) Reset() {
+	*x = RemoveFromQueueRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Player) String() string {
+func (x *RemoveFromQueueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Player) ProtoMessage() {}
+func (*RemoveFromQueueRequest) ProtoMessage() {}
 
-func (x *Player) ProtoReflect() protoreflect.Message {
-	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[19]
+func (x *RemoveFromQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -986,30 +2909,97 @@ func (x *Player) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Player.ProtoReflect.Descriptor instead.
-func (*Player) Descriptor() ([]byte, []int) {
-	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use RemoveFromQueueRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFromQueueRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *Player) GetId() string {
+func (x *RemoveFromQueueRequest) GetPlayer() string {
 	if x != nil {
-		return x.Id
+		return x.Player
 	}
 	return ""
 }
 
-func (x *Player) GetUsername() string {
+// RemoveFromQueueResponse is the response for RemoveFromQueue method.
+type RemoveFromQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromQueueResponse) Reset() {
+	*x = RemoveFromQueueResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromQueueResponse) ProtoMessage() {}
+
+func (x *RemoveFromQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[52]
 	if x != nil {
-		return x.Username
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromQueueResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFromQueueResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{52}
 }
 
 var File_minekube_gate_v1_gate_service_proto protoreflect.FileDescriptor
 
 const file_minekube_gate_v1_gate_service_proto_rawDesc = "" +
 	"\n" +
-	"#minekube/gate/v1/gate_service.proto\x12\x10minekube.gate.v1\"X\n" +
+	"#minekube/gate/v1/gate_service.proto\x12\x10minekube.gate.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"{\n" +
+	"\x12WatchEventsRequest\x121\n" +
+	"\x05types\x18\x01 \x03(\x0e2\x1b.minekube.gate.v1.EventTypeR\x05types\x12\x18\n" +
+	"\aplayers\x18\x02 \x03(\tR\aplayers\x12\x18\n" +
+	"\aservers\x18\x03 \x03(\tR\aservers\"D\n" +
+	"\x13WatchEventsResponse\x12-\n" +
+	"\x05event\x18\x01 \x01(\v2\x17.minekube.gate.v1.EventR\x05event\"\x88\x05\n" +
+	"\x05Event\x12/\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1b.minekube.gate.v1.EventTypeR\x04type\x12.\n" +
+	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x120\n" +
+	"\x06player\x18\x03 \x01(\v2\x18.minekube.gate.v1.PlayerR\x06player\x12\x16\n" +
+	"\x06server\x18\x04 \x01(\tR\x06server\x12A\n" +
+	"\n" +
+	"post_login\x18\n" +
+	" \x01(\v2 .minekube.gate.v1.PostLoginEventH\x00R\tpostLogin\x12C\n" +
+	"\n" +
+	"disconnect\x18\v \x01(\v2!.minekube.gate.v1.DisconnectEventH\x00R\n" +
+	"disconnect\x12S\n" +
+	"\x10server_connected\x18\f \x01(\v2&.minekube.gate.v1.ServerConnectedEventH\x00R\x0fserverConnected\x12W\n" +
+	"\x12kicked_from_server\x18\r \x01(\v2'.minekube.gate.v1.KickedFromServerEventH\x00R\x10kickedFromServer\x12D\n" +
+	"\vplayer_chat\x18\x0e \x01(\v2!.minekube.gate.v1.PlayerChatEventH\x00R\n" +
+	"playerChat\x12P\n" +
+	"\x0fcommand_execute\x18\x0f \x01(\v2%.minekube.gate.v1.CommandExecuteEventH\x00R\x0ecommandExecuteB\x06\n" +
+	"\x04data\"\x10\n" +
+	"\x0ePostLoginEvent\"4\n" +
+	"\x0fDisconnectEvent\x12!\n" +
+	"\flogin_status\x18\x01 \x01(\tR\vloginStatus\"?\n" +
+	"\x14ServerConnectedEvent\x12'\n" +
+	"\x0fprevious_server\x18\x01 \x01(\tR\x0epreviousServer\"c\n" +
+	"\x15KickedFromServerEvent\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\x122\n" +
+	"\x15during_server_connect\x18\x02 \x01(\bR\x13duringServerConnect\"E\n" +
+	"\x0fPlayerChatEvent\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\aallowed\x18\x02 \x01(\bR\aallowed\"I\n" +
+	"\x13CommandExecuteEvent\x12\x18\n" +
+	"\acommand\x18\x01 \x01(\tR\acommand\x12\x18\n" +
+	"\aallowed\x18\x02 \x01(\bR\aallowed\"X\n" +
 	"\x12StoreCookieRequest\x12\x16\n" +
 	"\x06player\x18\x01 \x01(\tR\x06player\x12\x10\n" +
 	"\x03key\x18\x02 \x01(\tR\x03key\x12\x18\n" +
@@ -1038,11 +3028,14 @@ const file_minekube_gate_v1_gate_service_proto_rawDesc = "" +
 	"\x18UnregisterServerResponse\"\x14\n" +
 	"\x12ListServersRequest\"I\n" +
 	"\x13ListServersResponse\x122\n" +
-	"\aservers\x18\x01 \x03(\v2\x18.minekube.gate.v1.ServerR\aservers\"P\n" +
+	"\aservers\x18\x01 \x03(\v2\x18.minekube.gate.v1.ServerR\aservers\"\xad\x01\n" +
 	"\x06Server\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x18\n" +
-	"\aplayers\x18\x03 \x01(\x05R\aplayers\">\n" +
+	"\aplayers\x18\x03 \x01(\x05R\aplayers\x12<\n" +
+	"\x06health\x18\x04 \x01(\x0e2$.minekube.gate.v1.ServerHealthStatusR\x06health\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\x05 \x01(\x03R\tlatencyMs\">\n" +
 	"\x10GetPlayerRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\"E\n" +
@@ -1054,7 +3047,78 @@ const file_minekube_gate_v1_gate_service_proto_rawDesc = "" +
 	"\aplayers\x18\x01 \x03(\v2\x18.minekube.gate.v1.PlayerR\aplayers\"4\n" +
 	"\x06Player\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
-	"\busername\x18\x02 \x01(\tR\busername2\xf6\x06\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"\xe8\x01\n" +
+	"\x03Ban\x12-\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x19.minekube.gate.v1.BanKindR\x04kind\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x124\n" +
+	"\acreated\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\acreated\x124\n" +
+	"\aexpires\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aexpires\"\x11\n" +
+	"\x0fListBansRequest\"=\n" +
+	"\x10ListBansResponse\x12)\n" +
+	"\x04bans\x18\x01 \x03(\v2\x15.minekube.gate.v1.BanR\x04bans\"\x8e\x01\n" +
+	"\rAddBanRequest\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x125\n" +
+	"\bduration\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bduration\"9\n" +
+	"\x0eAddBanResponse\x12'\n" +
+	"\x03ban\x18\x01 \x01(\v2\x15.minekube.gate.v1.BanR\x03ban\"*\n" +
+	"\x10RemoveBanRequest\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\"\x13\n" +
+	"\x11RemoveBanResponse\"\x15\n" +
+	"\x13GetAllowlistRequest\"J\n" +
+	"\x14GetAllowlistResponse\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x18\n" +
+	"\aentries\x18\x02 \x03(\tR\aentries\"6\n" +
+	"\x1aSetAllowlistEnabledRequest\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\"\x1d\n" +
+	"\x1bSetAllowlistEnabledResponse\"0\n" +
+	"\x18AddAllowlistEntryRequest\x12\x14\n" +
+	"\x05entry\x18\x01 \x01(\tR\x05entry\"\x1b\n" +
+	"\x19AddAllowlistEntryResponse\"3\n" +
+	"\x1bRemoveAllowlistEntryRequest\x12\x14\n" +
+	"\x05entry\x18\x01 \x01(\tR\x05entry\"\x1e\n" +
+	"\x1cRemoveAllowlistEntryResponse\"-\n" +
+	"\x11ListQueuesRequest\x12\x18\n" +
+	"\aservers\x18\x01 \x03(\tR\aservers\"K\n" +
+	"\x12ListQueuesResponse\x125\n" +
+	"\x06queues\x18\x01 \x03(\v2\x1d.minekube.gate.v1.ServerQueueR\x06queues\"Y\n" +
+	"\vServerQueue\x12\x16\n" +
+	"\x06server\x18\x01 \x01(\tR\x06server\x122\n" +
+	"\aplayers\x18\x02 \x03(\v2\x18.minekube.gate.v1.PlayerR\aplayers\"1\n" +
+	"\x17GetQueuePositionRequest\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\tR\x06player\"d\n" +
+	"\x18GetQueuePositionResponse\x12\x16\n" +
+	"\x06server\x18\x01 \x01(\tR\x06server\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\"C\n" +
+	"\x11AddToQueueRequest\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\tR\x06player\x12\x16\n" +
+	"\x06server\x18\x02 \x01(\tR\x06server\"0\n" +
+	"\x12AddToQueueResponse\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\x05R\bposition\"0\n" +
+	"\x16RemoveFromQueueRequest\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\tR\x06player\"\x19\n" +
+	"\x17RemoveFromQueueResponse*\xdd\x01\n" +
+	"\tEventType\x12\x1a\n" +
+	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15EVENT_TYPE_POST_LOGIN\x10\x01\x12\x19\n" +
+	"\x15EVENT_TYPE_DISCONNECT\x10\x02\x12\x1f\n" +
+	"\x1bEVENT_TYPE_SERVER_CONNECTED\x10\x03\x12!\n" +
+	"\x1dEVENT_TYPE_KICKED_FROM_SERVER\x10\x04\x12\x1a\n" +
+	"\x16EVENT_TYPE_PLAYER_CHAT\x10\x05\x12\x1e\n" +
+	"\x1aEVENT_TYPE_COMMAND_EXECUTE\x10\x06*\x80\x01\n" +
+	"\x12ServerHealthStatus\x12$\n" +
+	" SERVER_HEALTH_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cSERVER_HEALTH_STATUS_HEALTHY\x10\x01\x12\"\n" +
+	"\x1eSERVER_HEALTH_STATUS_UNHEALTHY\x10\x02*Z\n" +
+	"\aBanKind\x12\x18\n" +
+	"\x14BAN_KIND_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rBAN_KIND_UUID\x10\x01\x12\x11\n" +
+	"\rBAN_KIND_NAME\x10\x02\x12\x0f\n" +
+	"\vBAN_KIND_IP\x10\x032\x87\x10\n" +
 	"\vGateService\x12T\n" +
 	"\tGetPlayer\x12\".minekube.gate.v1.GetPlayerRequest\x1a#.minekube.gate.v1.GetPlayerResponse\x12Z\n" +
 	"\vListPlayers\x12$.minekube.gate.v1.ListPlayersRequest\x1a%.minekube.gate.v1.ListPlayersResponse\x12Z\n" +
@@ -1064,7 +3128,21 @@ const file_minekube_gate_v1_gate_service_proto_rawDesc = "" +
 	"\rConnectPlayer\x12&.minekube.gate.v1.ConnectPlayerRequest\x1a'.minekube.gate.v1.ConnectPlayerResponse\x12i\n" +
 	"\x10DisconnectPlayer\x12).minekube.gate.v1.DisconnectPlayerRequest\x1a*.minekube.gate.v1.DisconnectPlayerResponse\x12Z\n" +
 	"\vStoreCookie\x12$.minekube.gate.v1.StoreCookieRequest\x1a%.minekube.gate.v1.StoreCookieResponse\x12`\n" +
-	"\rRequestCookie\x12&.minekube.gate.v1.RequestCookieRequest\x1a'.minekube.gate.v1.RequestCookieResponseB\xcd\x01\n" +
+	"\rRequestCookie\x12&.minekube.gate.v1.RequestCookieRequest\x1a'.minekube.gate.v1.RequestCookieResponse\x12\\\n" +
+	"\vWatchEvents\x12$.minekube.gate.v1.WatchEventsRequest\x1a%.minekube.gate.v1.WatchEventsResponse0\x01\x12Q\n" +
+	"\bListBans\x12!.minekube.gate.v1.ListBansRequest\x1a\".minekube.gate.v1.ListBansResponse\x12K\n" +
+	"\x06AddBan\x12\x1f.minekube.gate.v1.AddBanRequest\x1a .minekube.gate.v1.AddBanResponse\x12T\n" +
+	"\tRemoveBan\x12\".minekube.gate.v1.RemoveBanRequest\x1a#.minekube.gate.v1.RemoveBanResponse\x12]\n" +
+	"\fGetAllowlist\x12%.minekube.gate.v1.GetAllowlistRequest\x1a&.minekube.gate.v1.GetAllowlistResponse\x12r\n" +
+	"\x13SetAllowlistEnabled\x12,.minekube.gate.v1.SetAllowlistEnabledRequest\x1a-.minekube.gate.v1.SetAllowlistEnabledResponse\x12l\n" +
+	"\x11AddAllowlistEntry\x12*.minekube.gate.v1.AddAllowlistEntryRequest\x1a+.minekube.gate.v1.AddAllowlistEntryResponse\x12u\n" +
+	"\x14RemoveAllowlistEntry\x12-.minekube.gate.v1.RemoveAllowlistEntryRequest\x1a..minekube.gate.v1.RemoveAllowlistEntryResponse\x12W\n" +
+	"\n" +
+	"ListQueues\x12#.minekube.gate.v1.ListQueuesRequest\x1a$.minekube.gate.v1.ListQueuesResponse\x12i\n" +
+	"\x10GetQueuePosition\x12).minekube.gate.v1.GetQueuePositionRequest\x1a*.minekube.gate.v1.GetQueuePositionResponse\x12W\n" +
+	"\n" +
+	"AddToQueue\x12#.minekube.gate.v1.AddToQueueRequest\x1a$.minekube.gate.v1.AddToQueueResponse\x12f\n" +
+	"\x0fRemoveFromQueue\x12(.minekube.gate.v1.RemoveFromQueueRequest\x1a).minekube.gate.v1.RemoveFromQueueResponseB\xcd\x01\n" +
 	"\x14com.minekube.gate.v1B\x10GateServiceProtoP\x01ZAgo.minekube.com/gate/pkg/internal/api/gen/minekube/gate/v1;gatev1\xa2\x02\x03MGX\xaa\x02\x10Minekube.Gate.V1\xca\x02\x10Minekube\\Gate\\V1\xe2\x02\x1cMinekube\\Gate\\V1\\GPBMetadata\xea\x02\x12Minekube::Gate::V1b\x06proto3"
 
 var (
@@ -1079,56 +3157,139 @@ func file_minekube_gate_v1_gate_service_proto_rawDescGZIP() []byte {
 	return file_minekube_gate_v1_gate_service_proto_rawDescData
 }
 
-var file_minekube_gate_v1_gate_service_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_minekube_gate_v1_gate_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_minekube_gate_v1_gate_service_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
 var file_minekube_gate_v1_gate_service_proto_goTypes = []any{
-	(*StoreCookieRequest)(nil),       // 0: minekube.gate.v1.StoreCookieRequest
-	(*StoreCookieResponse)(nil),      // 1: minekube.gate.v1.StoreCookieResponse
-	(*RequestCookieRequest)(nil),     // 2: minekube.gate.v1.RequestCookieRequest
-	(*RequestCookieResponse)(nil),    // 3: minekube.gate.v1.RequestCookieResponse
-	(*DisconnectPlayerRequest)(nil),  // 4: minekube.gate.v1.DisconnectPlayerRequest
-	(*DisconnectPlayerResponse)(nil), // 5: minekube.gate.v1.DisconnectPlayerResponse
-	(*ConnectPlayerRequest)(nil),     // 6: minekube.gate.v1.ConnectPlayerRequest
-	(*ConnectPlayerResponse)(nil),    // 7: minekube.gate.v1.ConnectPlayerResponse
-	(*RegisterServerRequest)(nil),    // 8: minekube.gate.v1.RegisterServerRequest
-	(*RegisterServerResponse)(nil),   // 9: minekube.gate.v1.RegisterServerResponse
-	(*UnregisterServerRequest)(nil),  // 10: minekube.gate.v1.UnregisterServerRequest
-	(*UnregisterServerResponse)(nil), // 11: minekube.gate.v1.UnregisterServerResponse
-	(*ListServersRequest)(nil),       // 12: minekube.gate.v1.ListServersRequest
-	(*ListServersResponse)(nil),      // 13: minekube.gate.v1.ListServersResponse
-	(*Server)(nil),                   // 14: minekube.gate.v1.Server
-	(*GetPlayerRequest)(nil),         // 15: minekube.gate.v1.GetPlayerRequest
-	(*GetPlayerResponse)(nil),        // 16: minekube.gate.v1.GetPlayerResponse
-	(*ListPlayersRequest)(nil),       // 17: minekube.gate.v1.ListPlayersRequest
-	(*ListPlayersResponse)(nil),      // 18: minekube.gate.v1.ListPlayersResponse
-	(*Player)(nil),                   // 19: minekube.gate.v1.Player
+	(EventType)(0),                       // 0: minekube.gate.v1.EventType
+	(ServerHealthStatus)(0),              // 1: minekube.gate.v1.ServerHealthStatus
+	(BanKind)(0),                         // 2: minekube.gate.v1.BanKind
+	(*WatchEventsRequest)(nil),           // 3: minekube.gate.v1.WatchEventsRequest
+	(*WatchEventsResponse)(nil),          // 4: minekube.gate.v1.WatchEventsResponse
+	(*Event)(nil),                        // 5: minekube.gate.v1.Event
+	(*PostLoginEvent)(nil),               // 6: minekube.gate.v1.PostLoginEvent
+	(*DisconnectEvent)(nil),              // 7: minekube.gate.v1.DisconnectEvent
+	(*ServerConnectedEvent)(nil),         // 8: minekube.gate.v1.ServerConnectedEvent
+	(*KickedFromServerEvent)(nil),        // 9: minekube.gate.v1.KickedFromServerEvent
+	(*PlayerChatEvent)(nil),              // 10: minekube.gate.v1.PlayerChatEvent
+	(*CommandExecuteEvent)(nil),          // 11: minekube.gate.v1.CommandExecuteEvent
+	(*StoreCookieRequest)(nil),           // 12: minekube.gate.v1.StoreCookieRequest
+	(*StoreCookieResponse)(nil),          // 13: minekube.gate.v1.StoreCookieResponse
+	(*RequestCookieRequest)(nil),         // 14: minekube.gate.v1.RequestCookieRequest
+	(*RequestCookieResponse)(nil),        // 15: minekube.gate.v1.RequestCookieResponse
+	(*DisconnectPlayerRequest)(nil),      // 16: minekube.gate.v1.DisconnectPlayerRequest
+	(*DisconnectPlayerResponse)(nil),     // 17: minekube.gate.v1.DisconnectPlayerResponse
+	(*ConnectPlayerRequest)(nil),         // 18: minekube.gate.v1.ConnectPlayerRequest
+	(*ConnectPlayerResponse)(nil),        // 19: minekube.gate.v1.ConnectPlayerResponse
+	(*RegisterServerRequest)(nil),        // 20: minekube.gate.v1.RegisterServerRequest
+	(*RegisterServerResponse)(nil),       // 21: minekube.gate.v1.RegisterServerResponse
+	(*UnregisterServerRequest)(nil),      // 22: minekube.gate.v1.UnregisterServerRequest
+	(*UnregisterServerResponse)(nil),     // 23: minekube.gate.v1.UnregisterServerResponse
+	(*ListServersRequest)(nil),           // 24: minekube.gate.v1.ListServersRequest
+	(*ListServersResponse)(nil),          // 25: minekube.gate.v1.ListServersResponse
+	(*Server)(nil),                       // 26: minekube.gate.v1.Server
+	(*GetPlayerRequest)(nil),             // 27: minekube.gate.v1.GetPlayerRequest
+	(*GetPlayerResponse)(nil),            // 28: minekube.gate.v1.GetPlayerResponse
+	(*ListPlayersRequest)(nil),           // 29: minekube.gate.v1.ListPlayersRequest
+	(*ListPlayersResponse)(nil),          // 30: minekube.gate.v1.ListPlayersResponse
+	(*Player)(nil),                       // 31: minekube.gate.v1.Player
+	(*Ban)(nil),                          // 32: minekube.gate.v1.Ban
+	(*ListBansRequest)(nil),              // 33: minekube.gate.v1.ListBansRequest
+	(*ListBansResponse)(nil),             // 34: minekube.gate.v1.ListBansResponse
+	(*AddBanRequest)(nil),                // 35: minekube.gate.v1.AddBanRequest
+	(*AddBanResponse)(nil),               // 36: minekube.gate.v1.AddBanResponse
+	(*RemoveBanRequest)(nil),             // 37: minekube.gate.v1.RemoveBanRequest
+	(*RemoveBanResponse)(nil),            // 38: minekube.gate.v1.RemoveBanResponse
+	(*GetAllowlistRequest)(nil),          // 39: minekube.gate.v1.GetAllowlistRequest
+	(*GetAllowlistResponse)(nil),         // 40: minekube.gate.v1.GetAllowlistResponse
+	(*SetAllowlistEnabledRequest)(nil),   // 41: minekube.gate.v1.SetAllowlistEnabledRequest
+	(*SetAllowlistEnabledResponse)(nil),  // 42: minekube.gate.v1.SetAllowlistEnabledResponse
+	(*AddAllowlistEntryRequest)(nil),     // 43: minekube.gate.v1.AddAllowlistEntryRequest
+	(*AddAllowlistEntryResponse)(nil),    // 44: minekube.gate.v1.AddAllowlistEntryResponse
+	(*RemoveAllowlistEntryRequest)(nil),  // 45: minekube.gate.v1.RemoveAllowlistEntryRequest
+	(*RemoveAllowlistEntryResponse)(nil), // 46: minekube.gate.v1.RemoveAllowlistEntryResponse
+	(*ListQueuesRequest)(nil),            // 47: minekube.gate.v1.ListQueuesRequest
+	(*ListQueuesResponse)(nil),           // 48: minekube.gate.v1.ListQueuesResponse
+	(*ServerQueue)(nil),                  // 49: minekube.gate.v1.ServerQueue
+	(*GetQueuePositionRequest)(nil),      // 50: minekube.gate.v1.GetQueuePositionRequest
+	(*GetQueuePositionResponse)(nil),     // 51: minekube.gate.v1.GetQueuePositionResponse
+	(*AddToQueueRequest)(nil),            // 52: minekube.gate.v1.AddToQueueRequest
+	(*AddToQueueResponse)(nil),           // 53: minekube.gate.v1.AddToQueueResponse
+	(*RemoveFromQueueRequest)(nil),       // 54: minekube.gate.v1.RemoveFromQueueRequest
+	(*RemoveFromQueueResponse)(nil),      // 55: minekube.gate.v1.RemoveFromQueueResponse
+	(*timestamppb.Timestamp)(nil),        // 56: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),          // 57: google.protobuf.Duration
 }
 var file_minekube_gate_v1_gate_service_proto_depIdxs = []int32{
-	14, // 0: minekube.gate.v1.ListServersResponse.servers:type_name -> minekube.gate.v1.Server
-	19, // 1: minekube.gate.v1.GetPlayerResponse.player:type_name -> minekube.gate.v1.Player
-	19, // 2: minekube.gate.v1.ListPlayersResponse.players:type_name -> minekube.gate.v1.Player
-	15, // 3: minekube.gate.v1.GateService.GetPlayer:input_type -> minekube.gate.v1.GetPlayerRequest
-	17, // 4: minekube.gate.v1.GateService.ListPlayers:input_type -> minekube.gate.v1.ListPlayersRequest
-	12, // 5: minekube.gate.v1.GateService.ListServers:input_type -> minekube.gate.v1.ListServersRequest
-	8,  // 6: minekube.gate.v1.GateService.RegisterServer:input_type -> minekube.gate.v1.RegisterServerRequest
-	10, // 7: minekube.gate.v1.GateService.UnregisterServer:input_type -> minekube.gate.v1.UnregisterServerRequest
-	6,  // 8: minekube.gate.v1.GateService.ConnectPlayer:input_type -> minekube.gate.v1.ConnectPlayerRequest
-	4,  // 9: minekube.gate.v1.GateService.DisconnectPlayer:input_type -> minekube.gate.v1.DisconnectPlayerRequest
-	0,  // 10: minekube.gate.v1.GateService.StoreCookie:input_type -> minekube.gate.v1.StoreCookieRequest
-	2,  // 11: minekube.gate.v1.GateService.RequestCookie:input_type -> minekube.gate.v1.RequestCookieRequest
-	16, // 12: minekube.gate.v1.GateService.GetPlayer:output_type -> minekube.gate.v1.GetPlayerResponse
-	18, // 13: minekube.gate.v1.GateService.ListPlayers:output_type -> minekube.gate.v1.ListPlayersResponse
-	13, // 14: minekube.gate.v1.GateService.ListServers:output_type -> minekube.gate.v1.ListServersResponse
-	9,  // 15: minekube.gate.v1.GateService.RegisterServer:output_type -> minekube.gate.v1.RegisterServerResponse
-	11, // 16: minekube.gate.v1.GateService.UnregisterServer:output_type -> minekube.gate.v1.UnregisterServerResponse
-	7,  // 17: minekube.gate.v1.GateService.ConnectPlayer:output_type -> minekube.gate.v1.ConnectPlayerResponse
-	5,  // 18: minekube.gate.v1.GateService.DisconnectPlayer:output_type -> minekube.gate.v1.DisconnectPlayerResponse
-	1,  // 19: minekube.gate.v1.GateService.StoreCookie:output_type -> minekube.gate.v1.StoreCookieResponse
-	3,  // 20: minekube.gate.v1.GateService.RequestCookie:output_type -> minekube.gate.v1.RequestCookieResponse
-	12, // [12:21] is the sub-list for method output_type
-	3,  // [3:12] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	0,  // 0: minekube.gate.v1.WatchEventsRequest.types:type_name -> minekube.gate.v1.EventType
+	5,  // 1: minekube.gate.v1.WatchEventsResponse.event:type_name -> minekube.gate.v1.Event
+	0,  // 2: minekube.gate.v1.Event.type:type_name -> minekube.gate.v1.EventType
+	56, // 3: minekube.gate.v1.Event.time:type_name -> google.protobuf.Timestamp
+	31, // 4: minekube.gate.v1.Event.player:type_name -> minekube.gate.v1.Player
+	6,  // 5: minekube.gate.v1.Event.post_login:type_name -> minekube.gate.v1.PostLoginEvent
+	7,  // 6: minekube.gate.v1.Event.disconnect:type_name -> minekube.gate.v1.DisconnectEvent
+	8,  // 7: minekube.gate.v1.Event.server_connected:type_name -> minekube.gate.v1.ServerConnectedEvent
+	9,  // 8: minekube.gate.v1.Event.kicked_from_server:type_name -> minekube.gate.v1.KickedFromServerEvent
+	10, // 9: minekube.gate.v1.Event.player_chat:type_name -> minekube.gate.v1.PlayerChatEvent
+	11, // 10: minekube.gate.v1.Event.command_execute:type_name -> minekube.gate.v1.CommandExecuteEvent
+	26, // 11: minekube.gate.v1.ListServersResponse.servers:type_name -> minekube.gate.v1.Server
+	1,  // 12: minekube.gate.v1.Server.health:type_name -> minekube.gate.v1.ServerHealthStatus
+	31, // 13: minekube.gate.v1.GetPlayerResponse.player:type_name -> minekube.gate.v1.Player
+	31, // 14: minekube.gate.v1.ListPlayersResponse.players:type_name -> minekube.gate.v1.Player
+	2,  // 15: minekube.gate.v1.Ban.kind:type_name -> minekube.gate.v1.BanKind
+	56, // 16: minekube.gate.v1.Ban.created:type_name -> google.protobuf.Timestamp
+	56, // 17: minekube.gate.v1.Ban.expires:type_name -> google.protobuf.Timestamp
+	32, // 18: minekube.gate.v1.ListBansResponse.bans:type_name -> minekube.gate.v1.Ban
+	57, // 19: minekube.gate.v1.AddBanRequest.duration:type_name -> google.protobuf.Duration
+	32, // 20: minekube.gate.v1.AddBanResponse.ban:type_name -> minekube.gate.v1.Ban
+	49, // 21: minekube.gate.v1.ListQueuesResponse.queues:type_name -> minekube.gate.v1.ServerQueue
+	31, // 22: minekube.gate.v1.ServerQueue.players:type_name -> minekube.gate.v1.Player
+	27, // 23: minekube.gate.v1.GateService.GetPlayer:input_type -> minekube.gate.v1.GetPlayerRequest
+	29, // 24: minekube.gate.v1.GateService.ListPlayers:input_type -> minekube.gate.v1.ListPlayersRequest
+	24, // 25: minekube.gate.v1.GateService.ListServers:input_type -> minekube.gate.v1.ListServersRequest
+	20, // 26: minekube.gate.v1.GateService.RegisterServer:input_type -> minekube.gate.v1.RegisterServerRequest
+	22, // 27: minekube.gate.v1.GateService.UnregisterServer:input_type -> minekube.gate.v1.UnregisterServerRequest
+	18, // 28: minekube.gate.v1.GateService.ConnectPlayer:input_type -> minekube.gate.v1.ConnectPlayerRequest
+	16, // 29: minekube.gate.v1.GateService.DisconnectPlayer:input_type -> minekube.gate.v1.DisconnectPlayerRequest
+	12, // 30: minekube.gate.v1.GateService.StoreCookie:input_type -> minekube.gate.v1.StoreCookieRequest
+	14, // 31: minekube.gate.v1.GateService.RequestCookie:input_type -> minekube.gate.v1.RequestCookieRequest
+	3,  // 32: minekube.gate.v1.GateService.WatchEvents:input_type -> minekube.gate.v1.WatchEventsRequest
+	33, // 33: minekube.gate.v1.GateService.ListBans:input_type -> minekube.gate.v1.ListBansRequest
+	35, // 34: minekube.gate.v1.GateService.AddBan:input_type -> minekube.gate.v1.AddBanRequest
+	37, // 35: minekube.gate.v1.GateService.RemoveBan:input_type -> minekube.gate.v1.RemoveBanRequest
+	39, // 36: minekube.gate.v1.GateService.GetAllowlist:input_type -> minekube.gate.v1.GetAllowlistRequest
+	41, // 37: minekube.gate.v1.GateService.SetAllowlistEnabled:input_type -> minekube.gate.v1.SetAllowlistEnabledRequest
+	43, // 38: minekube.gate.v1.GateService.AddAllowlistEntry:input_type -> minekube.gate.v1.AddAllowlistEntryRequest
+	45, // 39: minekube.gate.v1.GateService.RemoveAllowlistEntry:input_type -> minekube.gate.v1.RemoveAllowlistEntryRequest
+	47, // 40: minekube.gate.v1.GateService.ListQueues:input_type -> minekube.gate.v1.ListQueuesRequest
+	50, // 41: minekube.gate.v1.GateService.GetQueuePosition:input_type -> minekube.gate.v1.GetQueuePositionRequest
+	52, // 42: minekube.gate.v1.GateService.AddToQueue:input_type -> minekube.gate.v1.AddToQueueRequest
+	54, // 43: minekube.gate.v1.GateService.RemoveFromQueue:input_type -> minekube.gate.v1.RemoveFromQueueRequest
+	28, // 44: minekube.gate.v1.GateService.GetPlayer:output_type -> minekube.gate.v1.GetPlayerResponse
+	30, // 45: minekube.gate.v1.GateService.ListPlayers:output_type -> minekube.gate.v1.ListPlayersResponse
+	25, // 46: minekube.gate.v1.GateService.ListServers:output_type -> minekube.gate.v1.ListServersResponse
+	21, // 47: minekube.gate.v1.GateService.RegisterServer:output_type -> minekube.gate.v1.RegisterServerResponse
+	23, // 48: minekube.gate.v1.GateService.UnregisterServer:output_type -> minekube.gate.v1.UnregisterServerResponse
+	19, // 49: minekube.gate.v1.GateService.ConnectPlayer:output_type -> minekube.gate.v1.ConnectPlayerResponse
+	17, // 50: minekube.gate.v1.GateService.DisconnectPlayer:output_type -> minekube.gate.v1.DisconnectPlayerResponse
+	13, // 51: minekube.gate.v1.GateService.StoreCookie:output_type -> minekube.gate.v1.StoreCookieResponse
+	15, // 52: minekube.gate.v1.GateService.RequestCookie:output_type -> minekube.gate.v1.RequestCookieResponse
+	4,  // 53: minekube.gate.v1.GateService.WatchEvents:output_type -> minekube.gate.v1.WatchEventsResponse
+	34, // 54: minekube.gate.v1.GateService.ListBans:output_type -> minekube.gate.v1.ListBansResponse
+	36, // 55: minekube.gate.v1.GateService.AddBan:output_type -> minekube.gate.v1.AddBanResponse
+	38, // 56: minekube.gate.v1.GateService.RemoveBan:output_type -> minekube.gate.v1.RemoveBanResponse
+	40, // 57: minekube.gate.v1.GateService.GetAllowlist:output_type -> minekube.gate.v1.GetAllowlistResponse
+	42, // 58: minekube.gate.v1.GateService.SetAllowlistEnabled:output_type -> minekube.gate.v1.SetAllowlistEnabledResponse
+	44, // 59: minekube.gate.v1.GateService.AddAllowlistEntry:output_type -> minekube.gate.v1.AddAllowlistEntryResponse
+	46, // 60: minekube.gate.v1.GateService.RemoveAllowlistEntry:output_type -> minekube.gate.v1.RemoveAllowlistEntryResponse
+	48, // 61: minekube.gate.v1.GateService.ListQueues:output_type -> minekube.gate.v1.ListQueuesResponse
+	51, // 62: minekube.gate.v1.GateService.GetQueuePosition:output_type -> minekube.gate.v1.GetQueuePositionResponse
+	53, // 63: minekube.gate.v1.GateService.AddToQueue:output_type -> minekube.gate.v1.AddToQueueResponse
+	55, // 64: minekube.gate.v1.GateService.RemoveFromQueue:output_type -> minekube.gate.v1.RemoveFromQueueResponse
+	44, // [44:65] is the sub-list for method output_type
+	23, // [23:44] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_minekube_gate_v1_gate_service_proto_init() }
@@ -1136,18 +3297,27 @@ func file_minekube_gate_v1_gate_service_proto_init() {
 	if File_minekube_gate_v1_gate_service_proto != nil {
 		return
 	}
+	file_minekube_gate_v1_gate_service_proto_msgTypes[2].OneofWrappers = []any{
+		(*Event_PostLogin)(nil),
+		(*Event_Disconnect)(nil),
+		(*Event_ServerConnected)(nil),
+		(*Event_KickedFromServer)(nil),
+		(*Event_PlayerChat)(nil),
+		(*Event_CommandExecute)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_minekube_gate_v1_gate_service_proto_rawDesc), len(file_minekube_gate_v1_gate_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   20,
+			NumEnums:      3,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_minekube_gate_v1_gate_service_proto_goTypes,
 		DependencyIndexes: file_minekube_gate_v1_gate_service_proto_depIdxs,
+		EnumInfos:         file_minekube_gate_v1_gate_service_proto_enumTypes,
 		MessageInfos:      file_minekube_gate_v1_gate_service_proto_msgTypes,
 	}.Build()
 	File_minekube_gate_v1_gate_service_proto = out.File
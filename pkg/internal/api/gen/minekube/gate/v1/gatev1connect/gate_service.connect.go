@@ -56,6 +56,36 @@ const (
 	// GateServiceRequestCookieProcedure is the fully-qualified name of the GateService's RequestCookie
 	// RPC.
 	GateServiceRequestCookieProcedure = "/minekube.gate.v1.GateService/RequestCookie"
+	// GateServiceWatchEventsProcedure is the fully-qualified name of the GateService's WatchEvents RPC.
+	GateServiceWatchEventsProcedure = "/minekube.gate.v1.GateService/WatchEvents"
+	// GateServiceListBansProcedure is the fully-qualified name of the GateService's ListBans RPC.
+	GateServiceListBansProcedure = "/minekube.gate.v1.GateService/ListBans"
+	// GateServiceAddBanProcedure is the fully-qualified name of the GateService's AddBan RPC.
+	GateServiceAddBanProcedure = "/minekube.gate.v1.GateService/AddBan"
+	// GateServiceRemoveBanProcedure is the fully-qualified name of the GateService's RemoveBan RPC.
+	GateServiceRemoveBanProcedure = "/minekube.gate.v1.GateService/RemoveBan"
+	// GateServiceGetAllowlistProcedure is the fully-qualified name of the GateService's GetAllowlist
+	// RPC.
+	GateServiceGetAllowlistProcedure = "/minekube.gate.v1.GateService/GetAllowlist"
+	// GateServiceSetAllowlistEnabledProcedure is the fully-qualified name of the GateService's
+	// SetAllowlistEnabled RPC.
+	GateServiceSetAllowlistEnabledProcedure = "/minekube.gate.v1.GateService/SetAllowlistEnabled"
+	// GateServiceAddAllowlistEntryProcedure is the fully-qualified name of the GateService's
+	// AddAllowlistEntry RPC.
+	GateServiceAddAllowlistEntryProcedure = "/minekube.gate.v1.GateService/AddAllowlistEntry"
+	// GateServiceRemoveAllowlistEntryProcedure is the fully-qualified name of the GateService's
+	// RemoveAllowlistEntry RPC.
+	GateServiceRemoveAllowlistEntryProcedure = "/minekube.gate.v1.GateService/RemoveAllowlistEntry"
+	// GateServiceListQueuesProcedure is the fully-qualified name of the GateService's ListQueues RPC.
+	GateServiceListQueuesProcedure = "/minekube.gate.v1.GateService/ListQueues"
+	// GateServiceGetQueuePositionProcedure is the fully-qualified name of the GateService's
+	// GetQueuePosition RPC.
+	GateServiceGetQueuePositionProcedure = "/minekube.gate.v1.GateService/GetQueuePosition"
+	// GateServiceAddToQueueProcedure is the fully-qualified name of the GateService's AddToQueue RPC.
+	GateServiceAddToQueueProcedure = "/minekube.gate.v1.GateService/AddToQueue"
+	// GateServiceRemoveFromQueueProcedure is the fully-qualified name of the GateService's
+	// RemoveFromQueue RPC.
+	GateServiceRemoveFromQueueProcedure = "/minekube.gate.v1.GateService/RemoveFromQueue"
 )
 
 // GateServiceClient is a client for the minekube.gate.v1.GateService service.
@@ -92,6 +122,59 @@ type GateServiceClient interface {
 	// RequestCookie requests a cookie from a player's client.
 	// The payload in RequestCookieResponse may be empty if the cookie is not found.
 	RequestCookie(context.Context, *connect.Request[v1.RequestCookieRequest]) (*connect.Response[v1.RequestCookieResponse], error)
+	// WatchEvents streams proxy events as they happen.
+	// Events can be filtered by type, player and server.
+	// The stream stays open until the client cancels it or the proxy shuts down.
+	// Returns RESOURCE_EXHAUSTED if the client does not keep up with the event rate.
+	WatchEvents(context.Context, *connect.Request[v1.WatchEventsRequest]) (*connect.ServerStreamForClient[v1.WatchEventsResponse], error)
+	// ListBans returns all active bans.
+	// Returns FAILED_PRECONDITION if bans and allowlist are disabled.
+	ListBans(context.Context, *connect.Request[v1.ListBansRequest]) (*connect.Response[v1.ListBansResponse], error)
+	// AddBan bans a player UUID, username, IP address or CIDR range
+	// and disconnects online players matching the ban.
+	// An existing ban of the same target is replaced.
+	// Returns INVALID_ARGUMENT if the target or duration is invalid.
+	// Returns FAILED_PRECONDITION if bans and allowlist are disabled.
+	AddBan(context.Context, *connect.Request[v1.AddBanRequest]) (*connect.Response[v1.AddBanResponse], error)
+	// RemoveBan removes the ban of a target.
+	// Returns NOT_FOUND if the target is not banned.
+	// Returns INVALID_ARGUMENT if the target is invalid.
+	// Returns FAILED_PRECONDITION if bans and allowlist are disabled.
+	RemoveBan(context.Context, *connect.Request[v1.RemoveBanRequest]) (*connect.Response[v1.RemoveBanResponse], error)
+	// GetAllowlist returns whether the allowlist is enabled and its entries.
+	// Returns FAILED_PRECONDITION if bans and allowlist are disabled.
+	GetAllowlist(context.Context, *connect.Request[v1.GetAllowlistRequest]) (*connect.Response[v1.GetAllowlistResponse], error)
+	// SetAllowlistEnabled turns the allowlist on or off.
+	// Players already online are not disconnected when turning it on.
+	// Returns FAILED_PRECONDITION if bans and allowlist are disabled.
+	SetAllowlistEnabled(context.Context, *connect.Request[v1.SetAllowlistEnabledRequest]) (*connect.Response[v1.SetAllowlistEnabledResponse], error)
+	// AddAllowlistEntry adds a player UUID or username to the allowlist.
+	// Returns ALREADY_EXISTS if the entry is already allowlisted.
+	// Returns INVALID_ARGUMENT if the entry is invalid.
+	// Returns FAILED_PRECONDITION if bans and allowlist are disabled.
+	AddAllowlistEntry(context.Context, *connect.Request[v1.AddAllowlistEntryRequest]) (*connect.Response[v1.AddAllowlistEntryResponse], error)
+	// RemoveAllowlistEntry removes a player UUID or username from the allowlist.
+	// Returns NOT_FOUND if the entry is not allowlisted.
+	// Returns INVALID_ARGUMENT if the entry is invalid.
+	// Returns FAILED_PRECONDITION if bans and allowlist are disabled.
+	RemoveAllowlistEntry(context.Context, *connect.Request[v1.RemoveAllowlistEntryRequest]) (*connect.Response[v1.RemoveAllowlistEntryResponse], error)
+	// ListQueues returns the players waiting in the queues of full or unavailable servers.
+	// Servers without queued players are omitted.
+	// Returns FAILED_PRECONDITION if the queue is disabled.
+	ListQueues(context.Context, *connect.Request[v1.ListQueuesRequest]) (*connect.Response[v1.ListQueuesResponse], error)
+	// GetQueuePosition returns the server a player is queued for and the player's position.
+	// Returns NOT_FOUND if the player doesn't exist or is not queued.
+	// Returns FAILED_PRECONDITION if the queue is disabled.
+	GetQueuePosition(context.Context, *connect.Request[v1.GetQueuePositionRequest]) (*connect.Response[v1.GetQueuePositionResponse], error)
+	// AddToQueue queues a player for a server.
+	// A player can only be queued for one server at a time and is moved from any other queue.
+	// Returns NOT_FOUND if either the player or server doesn't exist.
+	// Returns FAILED_PRECONDITION if the queue is disabled.
+	AddToQueue(context.Context, *connect.Request[v1.AddToQueueRequest]) (*connect.Response[v1.AddToQueueResponse], error)
+	// RemoveFromQueue removes a player from the queue.
+	// Returns NOT_FOUND if the player doesn't exist or is not queued.
+	// Returns FAILED_PRECONDITION if the queue is disabled.
+	RemoveFromQueue(context.Context, *connect.Request[v1.RemoveFromQueueRequest]) (*connect.Response[v1.RemoveFromQueueResponse], error)
 }
 
 // NewGateServiceClient constructs a client for the minekube.gate.v1.GateService service. By
@@ -159,20 +242,104 @@ func NewGateServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(gateServiceMethods.ByName("RequestCookie")),
 			connect.WithClientOptions(opts...),
 		),
+		watchEvents: connect.NewClient[v1.WatchEventsRequest, v1.WatchEventsResponse](
+			httpClient,
+			baseURL+GateServiceWatchEventsProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("WatchEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		listBans: connect.NewClient[v1.ListBansRequest, v1.ListBansResponse](
+			httpClient,
+			baseURL+GateServiceListBansProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("ListBans")),
+			connect.WithClientOptions(opts...),
+		),
+		addBan: connect.NewClient[v1.AddBanRequest, v1.AddBanResponse](
+			httpClient,
+			baseURL+GateServiceAddBanProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("AddBan")),
+			connect.WithClientOptions(opts...),
+		),
+		removeBan: connect.NewClient[v1.RemoveBanRequest, v1.RemoveBanResponse](
+			httpClient,
+			baseURL+GateServiceRemoveBanProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("RemoveBan")),
+			connect.WithClientOptions(opts...),
+		),
+		getAllowlist: connect.NewClient[v1.GetAllowlistRequest, v1.GetAllowlistResponse](
+			httpClient,
+			baseURL+GateServiceGetAllowlistProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("GetAllowlist")),
+			connect.WithClientOptions(opts...),
+		),
+		setAllowlistEnabled: connect.NewClient[v1.SetAllowlistEnabledRequest, v1.SetAllowlistEnabledResponse](
+			httpClient,
+			baseURL+GateServiceSetAllowlistEnabledProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("SetAllowlistEnabled")),
+			connect.WithClientOptions(opts...),
+		),
+		addAllowlistEntry: connect.NewClient[v1.AddAllowlistEntryRequest, v1.AddAllowlistEntryResponse](
+			httpClient,
+			baseURL+GateServiceAddAllowlistEntryProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("AddAllowlistEntry")),
+			connect.WithClientOptions(opts...),
+		),
+		removeAllowlistEntry: connect.NewClient[v1.RemoveAllowlistEntryRequest, v1.RemoveAllowlistEntryResponse](
+			httpClient,
+			baseURL+GateServiceRemoveAllowlistEntryProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("RemoveAllowlistEntry")),
+			connect.WithClientOptions(opts...),
+		),
+		listQueues: connect.NewClient[v1.ListQueuesRequest, v1.ListQueuesResponse](
+			httpClient,
+			baseURL+GateServiceListQueuesProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("ListQueues")),
+			connect.WithClientOptions(opts...),
+		),
+		getQueuePosition: connect.NewClient[v1.GetQueuePositionRequest, v1.GetQueuePositionResponse](
+			httpClient,
+			baseURL+GateServiceGetQueuePositionProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("GetQueuePosition")),
+			connect.WithClientOptions(opts...),
+		),
+		addToQueue: connect.NewClient[v1.AddToQueueRequest, v1.AddToQueueResponse](
+			httpClient,
+			baseURL+GateServiceAddToQueueProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("AddToQueue")),
+			connect.WithClientOptions(opts...),
+		),
+		removeFromQueue: connect.NewClient[v1.RemoveFromQueueRequest, v1.RemoveFromQueueResponse](
+			httpClient,
+			baseURL+GateServiceRemoveFromQueueProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("RemoveFromQueue")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // gateServiceClient implements GateServiceClient.
 type gateServiceClient struct {
-	getPlayer        *connect.Client[v1.GetPlayerRequest, v1.GetPlayerResponse]
-	listPlayers      *connect.Client[v1.ListPlayersRequest, v1.ListPlayersResponse]
-	listServers      *connect.Client[v1.ListServersRequest, v1.ListServersResponse]
-	registerServer   *connect.Client[v1.RegisterServerRequest, v1.RegisterServerResponse]
-	unregisterServer *connect.Client[v1.UnregisterServerRequest, v1.UnregisterServerResponse]
-	connectPlayer    *connect.Client[v1.ConnectPlayerRequest, v1.ConnectPlayerResponse]
-	disconnectPlayer *connect.Client[v1.DisconnectPlayerRequest, v1.DisconnectPlayerResponse]
-	storeCookie      *connect.Client[v1.StoreCookieRequest, v1.StoreCookieResponse]
-	requestCookie    *connect.Client[v1.RequestCookieRequest, v1.RequestCookieResponse]
+	getPlayer            *connect.Client[v1.GetPlayerRequest, v1.GetPlayerResponse]
+	listPlayers          *connect.Client[v1.ListPlayersRequest, v1.ListPlayersResponse]
+	listServers          *connect.Client[v1.ListServersRequest, v1.ListServersResponse]
+	registerServer       *connect.Client[v1.RegisterServerRequest, v1.RegisterServerResponse]
+	unregisterServer     *connect.Client[v1.UnregisterServerRequest, v1.UnregisterServerResponse]
+	connectPlayer        *connect.Client[v1.ConnectPlayerRequest, v1.ConnectPlayerResponse]
+	disconnectPlayer     *connect.Client[v1.DisconnectPlayerRequest, v1.DisconnectPlayerResponse]
+	storeCookie          *connect.Client[v1.StoreCookieRequest, v1.StoreCookieResponse]
+	requestCookie        *connect.Client[v1.RequestCookieRequest, v1.RequestCookieResponse]
+	watchEvents          *connect.Client[v1.WatchEventsRequest, v1.WatchEventsResponse]
+	listBans             *connect.Client[v1.ListBansRequest, v1.ListBansResponse]
+	addBan               *connect.Client[v1.AddBanRequest, v1.AddBanResponse]
+	removeBan            *connect.Client[v1.RemoveBanRequest, v1.RemoveBanResponse]
+	getAllowlist         *connect.Client[v1.GetAllowlistRequest, v1.GetAllowlistResponse]
+	setAllowlistEnabled  *connect.Client[v1.SetAllowlistEnabledRequest, v1.SetAllowlistEnabledResponse]
+	addAllowlistEntry    *connect.Client[v1.AddAllowlistEntryRequest, v1.AddAllowlistEntryResponse]
+	removeAllowlistEntry *connect.Client[v1.RemoveAllowlistEntryRequest, v1.RemoveAllowlistEntryResponse]
+	listQueues           *connect.Client[v1.ListQueuesRequest, v1.ListQueuesResponse]
+	getQueuePosition     *connect.Client[v1.GetQueuePositionRequest, v1.GetQueuePositionResponse]
+	addToQueue           *connect.Client[v1.AddToQueueRequest, v1.AddToQueueResponse]
+	removeFromQueue      *connect.Client[v1.RemoveFromQueueRequest, v1.RemoveFromQueueResponse]
 }
 
 // GetPlayer calls minekube.gate.v1.GateService.GetPlayer.
@@ -220,6 +387,66 @@ func (c *gateServiceClient) RequestCookie(ctx context.Context, req *connect.Requ
 	return c.requestCookie.CallUnary(ctx, req)
 }
 
+// WatchEvents calls minekube.gate.v1.GateService.WatchEvents.
+func (c *gateServiceClient) WatchEvents(ctx context.Context, req *connect.Request[v1.WatchEventsRequest]) (*connect.ServerStreamForClient[v1.WatchEventsResponse], error) {
+	return c.watchEvents.CallServerStream(ctx, req)
+}
+
+// ListBans calls minekube.gate.v1.GateService.ListBans.
+func (c *gateServiceClient) ListBans(ctx context.Context, req *connect.Request[v1.ListBansRequest]) (*connect.Response[v1.ListBansResponse], error) {
+	return c.listBans.CallUnary(ctx, req)
+}
+
+// AddBan calls minekube.gate.v1.GateService.AddBan.
+func (c *gateServiceClient) AddBan(ctx context.Context, req *connect.Request[v1.AddBanRequest]) (*connect.Response[v1.AddBanResponse], error) {
+	return c.addBan.CallUnary(ctx, req)
+}
+
+// RemoveBan calls minekube.gate.v1.GateService.RemoveBan.
+func (c *gateServiceClient) RemoveBan(ctx context.Context, req *connect.Request[v1.RemoveBanRequest]) (*connect.Response[v1.RemoveBanResponse], error) {
+	return c.removeBan.CallUnary(ctx, req)
+}
+
+// GetAllowlist calls minekube.gate.v1.GateService.GetAllowlist.
+func (c *gateServiceClient) GetAllowlist(ctx context.Context, req *connect.Request[v1.GetAllowlistRequest]) (*connect.Response[v1.GetAllowlistResponse], error) {
+	return c.getAllowlist.CallUnary(ctx, req)
+}
+
+// SetAllowlistEnabled calls minekube.gate.v1.GateService.SetAllowlistEnabled.
+func (c *gateServiceClient) SetAllowlistEnabled(ctx context.Context, req *connect.Request[v1.SetAllowlistEnabledRequest]) (*connect.Response[v1.SetAllowlistEnabledResponse], error) {
+	return c.setAllowlistEnabled.CallUnary(ctx, req)
+}
+
+// AddAllowlistEntry calls minekube.gate.v1.GateService.AddAllowlistEntry.
+func (c *gateServiceClient) AddAllowlistEntry(ctx context.Context, req *connect.Request[v1.AddAllowlistEntryRequest]) (*connect.Response[v1.AddAllowlistEntryResponse], error) {
+	return c.addAllowlistEntry.CallUnary(ctx, req)
+}
+
+// RemoveAllowlistEntry calls minekube.gate.v1.GateService.RemoveAllowlistEntry.
+func (c *gateServiceClient) RemoveAllowlistEntry(ctx context.Context, req *connect.Request[v1.RemoveAllowlistEntryRequest]) (*connect.Response[v1.RemoveAllowlistEntryResponse], error) {
+	return c.removeAllowlistEntry.CallUnary(ctx, req)
+}
+
+// ListQueues calls minekube.gate.v1.GateService.ListQueues.
+func (c *gateServiceClient) ListQueues(ctx context.Context, req *connect.Request[v1.ListQueuesRequest]) (*connect.Response[v1.ListQueuesResponse], error) {
+	return c.listQueues.CallUnary(ctx, req)
+}
+
+// GetQueuePosition calls minekube.gate.v1.GateService.GetQueuePosition.
+func (c *gateServiceClient) GetQueuePosition(ctx context.Context, req *connect.Request[v1.GetQueuePositionRequest]) (*connect.Response[v1.GetQueuePositionResponse], error) {
+	return c.getQueuePosition.CallUnary(ctx, req)
+}
+
+// AddToQueue calls minekube.gate.v1.GateService.AddToQueue.
+func (c *gateServiceClient) AddToQueue(ctx context.Context, req *connect.Request[v1.AddToQueueRequest]) (*connect.Response[v1.AddToQueueResponse], error) {
+	return c.addToQueue.CallUnary(ctx, req)
+}
+
+// RemoveFromQueue calls minekube.gate.v1.GateService.RemoveFromQueue.
+func (c *gateServiceClient) RemoveFromQueue(ctx context.Context, req *connect.Request[v1.RemoveFromQueueRequest]) (*connect.Response[v1.RemoveFromQueueResponse], error) {
+	return c.removeFromQueue.CallUnary(ctx, req)
+}
+
 // GateServiceHandler is an implementation of the minekube.gate.v1.GateService service.
 type GateServiceHandler interface {
 	// GetPlayer returns the player by the given id or username.
@@ -254,6 +481,59 @@ type GateServiceHandler interface {
 	// RequestCookie requests a cookie from a player's client.
 	// The payload in RequestCookieResponse may be empty if the cookie is not found.
 	RequestCookie(context.Context, *connect.Request[v1.RequestCookieRequest]) (*connect.Response[v1.RequestCookieResponse], error)
+	// WatchEvents streams proxy events as they happen.
+	// Events can be filtered by type, player and server.
+	// The stream stays open until the client cancels it or the proxy shuts down.
+	// Returns RESOURCE_EXHAUSTED if the client does not keep up with the event rate.
+	WatchEvents(context.Context, *connect.Request[v1.WatchEventsRequest], *connect.ServerStream[v1.WatchEventsResponse]) error
+	// ListBans returns all active bans.
+	// Returns FAILED_PRECONDITION if bans and allowlist are disabled.
+	ListBans(context.Context, *connect.Request[v1.ListBansRequest]) (*connect.Response[v1.ListBansResponse], error)
+	// AddBan bans a player UUID, username, IP address or CIDR range
+	// and disconnects online players matching the ban.
+	// An existing ban of the same target is replaced.
+	// Returns INVALID_ARGUMENT if the target or duration is invalid.
+	// Returns FAILED_PRECONDITION if bans and allowlist are disabled.
+	AddBan(context.Context, *connect.Request[v1.AddBanRequest]) (*connect.Response[v1.AddBanResponse], error)
+	// RemoveBan removes the ban of a target.
+	// Returns NOT_FOUND if the target is not banned.
+	// Returns INVALID_ARGUMENT if the target is invalid.
+	// Returns FAILED_PRECONDITION if bans and allowlist are disabled.
+	RemoveBan(context.Context, *connect.Request[v1.RemoveBanRequest]) (*connect.Response[v1.RemoveBanResponse], error)
+	// GetAllowlist returns whether the allowlist is enabled and its entries.
+	// Returns FAILED_PRECONDITION if bans and allowlist are disabled.
+	GetAllowlist(context.Context, *connect.Request[v1.GetAllowlistRequest]) (*connect.Response[v1.GetAllowlistResponse], error)
+	// SetAllowlistEnabled turns the allowlist on or off.
+	// Players already online are not disconnected when turning it on.
+	// Returns FAILED_PRECONDITION if bans and allowlist are disabled.
+	SetAllowlistEnabled(context.Context, *connect.Request[v1.SetAllowlistEnabledRequest]) (*connect.Response[v1.SetAllowlistEnabledResponse], error)
+	// AddAllowlistEntry adds a player UUID or username to the allowlist.
+	// Returns ALREADY_EXISTS if the entry is already allowlisted.
+	// Returns INVALID_ARGUMENT if the entry is invalid.
+	// Returns FAILED_PRECONDITION if bans and allowlist are disabled.
+	AddAllowlistEntry(context.Context, *connect.Request[v1.AddAllowlistEntryRequest]) (*connect.Response[v1.AddAllowlistEntryResponse], error)
+	// RemoveAllowlistEntry removes a player UUID or username from the allowlist.
+	// Returns NOT_FOUND if the entry is not allowlisted.
+	// Returns INVALID_ARGUMENT if the entry is invalid.
+	// Returns FAILED_PRECONDITION if bans and allowlist are disabled.
+	RemoveAllowlistEntry(context.Context, *connect.Request[v1.RemoveAllowlistEntryRequest]) (*connect.Response[v1.RemoveAllowlistEntryResponse], error)
+	// ListQueues returns the players waiting in the queues of full or unavailable servers.
+	// Servers without queued players are omitted.
+	// Returns FAILED_PRECONDITION if the queue is disabled.
+	ListQueues(context.Context, *connect.Request[v1.ListQueuesRequest]) (*connect.Response[v1.ListQueuesResponse], error)
+	// GetQueuePosition returns the server a player is queued for and the player's position.
+	// Returns NOT_FOUND if the player doesn't exist or is not queued.
+	// Returns FAILED_PRECONDITION if the queue is disabled.
+	GetQueuePosition(context.Context, *connect.Request[v1.GetQueuePositionRequest]) (*connect.Response[v1.GetQueuePositionResponse], error)
+	// AddToQueue queues a player for a server.
+	// A player can only be queued for one server at a time and is moved from any other queue.
+	// Returns NOT_FOUND if either the player or server doesn't exist.
+	// Returns FAILED_PRECONDITION if the queue is disabled.
+	AddToQueue(context.Context, *connect.Request[v1.AddToQueueRequest]) (*connect.Response[v1.AddToQueueResponse], error)
+	// RemoveFromQueue removes a player from the queue.
+	// Returns NOT_FOUND if the player doesn't exist or is not queued.
+	// Returns FAILED_PRECONDITION if the queue is disabled.
+	RemoveFromQueue(context.Context, *connect.Request[v1.RemoveFromQueueRequest]) (*connect.Response[v1.RemoveFromQueueResponse], error)
 }
 
 // NewGateServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -317,6 +597,78 @@ func NewGateServiceHandler(svc GateServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(gateServiceMethods.ByName("RequestCookie")),
 		connect.WithHandlerOptions(opts...),
 	)
+	gateServiceWatchEventsHandler := connect.NewServerStreamHandler(
+		GateServiceWatchEventsProcedure,
+		svc.WatchEvents,
+		connect.WithSchema(gateServiceMethods.ByName("WatchEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceListBansHandler := connect.NewUnaryHandler(
+		GateServiceListBansProcedure,
+		svc.ListBans,
+		connect.WithSchema(gateServiceMethods.ByName("ListBans")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceAddBanHandler := connect.NewUnaryHandler(
+		GateServiceAddBanProcedure,
+		svc.AddBan,
+		connect.WithSchema(gateServiceMethods.ByName("AddBan")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceRemoveBanHandler := connect.NewUnaryHandler(
+		GateServiceRemoveBanProcedure,
+		svc.RemoveBan,
+		connect.WithSchema(gateServiceMethods.ByName("RemoveBan")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceGetAllowlistHandler := connect.NewUnaryHandler(
+		GateServiceGetAllowlistProcedure,
+		svc.GetAllowlist,
+		connect.WithSchema(gateServiceMethods.ByName("GetAllowlist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceSetAllowlistEnabledHandler := connect.NewUnaryHandler(
+		GateServiceSetAllowlistEnabledProcedure,
+		svc.SetAllowlistEnabled,
+		connect.WithSchema(gateServiceMethods.ByName("SetAllowlistEnabled")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceAddAllowlistEntryHandler := connect.NewUnaryHandler(
+		GateServiceAddAllowlistEntryProcedure,
+		svc.AddAllowlistEntry,
+		connect.WithSchema(gateServiceMethods.ByName("AddAllowlistEntry")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceRemoveAllowlistEntryHandler := connect.NewUnaryHandler(
+		GateServiceRemoveAllowlistEntryProcedure,
+		svc.RemoveAllowlistEntry,
+		connect.WithSchema(gateServiceMethods.ByName("RemoveAllowlistEntry")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceListQueuesHandler := connect.NewUnaryHandler(
+		GateServiceListQueuesProcedure,
+		svc.ListQueues,
+		connect.WithSchema(gateServiceMethods.ByName("ListQueues")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceGetQueuePositionHandler := connect.NewUnaryHandler(
+		GateServiceGetQueuePositionProcedure,
+		svc.GetQueuePosition,
+		connect.WithSchema(gateServiceMethods.ByName("GetQueuePosition")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceAddToQueueHandler := connect.NewUnaryHandler(
+		GateServiceAddToQueueProcedure,
+		svc.AddToQueue,
+		connect.WithSchema(gateServiceMethods.ByName("AddToQueue")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceRemoveFromQueueHandler := connect.NewUnaryHandler(
+		GateServiceRemoveFromQueueProcedure,
+		svc.RemoveFromQueue,
+		connect.WithSchema(gateServiceMethods.ByName("RemoveFromQueue")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/minekube.gate.v1.GateService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case GateServiceGetPlayerProcedure:
@@ -337,6 +689,30 @@ func NewGateServiceHandler(svc GateServiceHandler, opts ...connect.HandlerOption
 			gateServiceStoreCookieHandler.ServeHTTP(w, r)
 		case GateServiceRequestCookieProcedure:
 			gateServiceRequestCookieHandler.ServeHTTP(w, r)
+		case GateServiceWatchEventsProcedure:
+			gateServiceWatchEventsHandler.ServeHTTP(w, r)
+		case GateServiceListBansProcedure:
+			gateServiceListBansHandler.ServeHTTP(w, r)
+		case GateServiceAddBanProcedure:
+			gateServiceAddBanHandler.ServeHTTP(w, r)
+		case GateServiceRemoveBanProcedure:
+			gateServiceRemoveBanHandler.ServeHTTP(w, r)
+		case GateServiceGetAllowlistProcedure:
+			gateServiceGetAllowlistHandler.ServeHTTP(w, r)
+		case GateServiceSetAllowlistEnabledProcedure:
+			gateServiceSetAllowlistEnabledHandler.ServeHTTP(w, r)
+		case GateServiceAddAllowlistEntryProcedure:
+			gateServiceAddAllowlistEntryHandler.ServeHTTP(w, r)
+		case GateServiceRemoveAllowlistEntryProcedure:
+			gateServiceRemoveAllowlistEntryHandler.ServeHTTP(w, r)
+		case GateServiceListQueuesProcedure:
+			gateServiceListQueuesHandler.ServeHTTP(w, r)
+		case GateServiceGetQueuePositionProcedure:
+			gateServiceGetQueuePositionHandler.ServeHTTP(w, r)
+		case GateServiceAddToQueueProcedure:
+			gateServiceAddToQueueHandler.ServeHTTP(w, r)
+		case GateServiceRemoveFromQueueProcedure:
+			gateServiceRemoveFromQueueHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -381,3 +757,51 @@ func (UnimplementedGateServiceHandler) StoreCookie(context.Context, *connect.Req
 func (UnimplementedGateServiceHandler) RequestCookie(context.Context, *connect.Request[v1.RequestCookieRequest]) (*connect.Response[v1.RequestCookieResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.RequestCookie is not implemented"))
 }
+
+func (UnimplementedGateServiceHandler) WatchEvents(context.Context, *connect.Request[v1.WatchEventsRequest], *connect.ServerStream[v1.WatchEventsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.WatchEvents is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) ListBans(context.Context, *connect.Request[v1.ListBansRequest]) (*connect.Response[v1.ListBansResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.ListBans is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) AddBan(context.Context, *connect.Request[v1.AddBanRequest]) (*connect.Response[v1.AddBanResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.AddBan is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) RemoveBan(context.Context, *connect.Request[v1.RemoveBanRequest]) (*connect.Response[v1.RemoveBanResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.RemoveBan is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) GetAllowlist(context.Context, *connect.Request[v1.GetAllowlistRequest]) (*connect.Response[v1.GetAllowlistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.GetAllowlist is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) SetAllowlistEnabled(context.Context, *connect.Request[v1.SetAllowlistEnabledRequest]) (*connect.Response[v1.SetAllowlistEnabledResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.SetAllowlistEnabled is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) AddAllowlistEntry(context.Context, *connect.Request[v1.AddAllowlistEntryRequest]) (*connect.Response[v1.AddAllowlistEntryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.AddAllowlistEntry is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) RemoveAllowlistEntry(context.Context, *connect.Request[v1.RemoveAllowlistEntryRequest]) (*connect.Response[v1.RemoveAllowlistEntryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.RemoveAllowlistEntry is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) ListQueues(context.Context, *connect.Request[v1.ListQueuesRequest]) (*connect.Response[v1.ListQueuesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.ListQueues is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) GetQueuePosition(context.Context, *connect.Request[v1.GetQueuePositionRequest]) (*connect.Response[v1.GetQueuePositionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.GetQueuePosition is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) AddToQueue(context.Context, *connect.Request[v1.AddToQueueRequest]) (*connect.Response[v1.AddToQueueResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.AddToQueue is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) RemoveFromQueue(context.Context, *connect.Request[v1.RemoveFromQueueRequest]) (*connect.Response[v1.RemoveFromQueueResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.RemoveFromQueue is not implemented"))
+}
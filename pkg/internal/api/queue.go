@@ -0,0 +1,118 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"go.minekube.com/gate/pkg/edition/java/proxy"
+	pb "go.minekube.com/gate/pkg/internal/api/gen/minekube/gate/v1"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+// queue returns the queue or a FAILED_PRECONDITION error if it is disabled.
+func (s *Service) queue() (*proxy.Queue, error) {
+	q := s.p.Queue()
+	if !q.Enabled() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, proxy.ErrQueueDisabled)
+	}
+	return q, nil
+}
+
+// player returns the player by username or ID or a NOT_FOUND error.
+func (s *Service) player(player string) (proxy.Player, error) {
+	var p proxy.Player
+	if id, err := uuid.Parse(player); err == nil {
+		p = s.p.Player(id)
+	} else {
+		p = s.p.PlayerByName(player)
+	}
+	if p == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("player not found"))
+	}
+	return p, nil
+}
+
+func (s *Service) ListQueues(ctx context.Context, c *connect.Request[pb.ListQueuesRequest]) (*connect.Response[pb.ListQueuesResponse], error) {
+	q, err := s.queue()
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.ListQueuesResponse{}
+	for _, server := range q.Servers() {
+		name := server.ServerInfo().Name()
+		if len(c.Msg.Servers) != 0 && !slices.ContainsFunc(c.Msg.Servers, func(s string) bool {
+			return strings.EqualFold(s, name)
+		}) {
+			continue
+		}
+		players := q.Players(server)
+		if len(players) == 0 {
+			continue
+		}
+		res.Queues = append(res.Queues, &pb.ServerQueue{
+			Server:  name,
+			Players: PlayersToProto(players),
+		})
+	}
+	slices.SortFunc(res.Queues, func(a, b *pb.ServerQueue) int {
+		return strings.Compare(a.Server, b.Server)
+	})
+	return connect.NewResponse(res), nil
+}
+
+func (s *Service) GetQueuePosition(ctx context.Context, c *connect.Request[pb.GetQueuePositionRequest]) (*connect.Response[pb.GetQueuePositionResponse], error) {
+	q, err := s.queue()
+	if err != nil {
+		return nil, err
+	}
+	player, err := s.player(c.Msg.Player)
+	if err != nil {
+		return nil, err
+	}
+	server, position := q.Position(player)
+	if server == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("player is not queued"))
+	}
+	return connect.NewResponse(&pb.GetQueuePositionResponse{
+		Server:   server.ServerInfo().Name(),
+		Position: int32(position),
+		Total:    int32(len(q.Players(server))),
+	}), nil
+}
+
+func (s *Service) AddToQueue(ctx context.Context, c *connect.Request[pb.AddToQueueRequest]) (*connect.Response[pb.AddToQueueResponse], error) {
+	q, err := s.queue()
+	if err != nil {
+		return nil, err
+	}
+	player, err := s.player(c.Msg.Player)
+	if err != nil {
+		return nil, err
+	}
+	server := s.p.Server(c.Msg.Server)
+	if server == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("server not found"))
+	}
+	return connect.NewResponse(&pb.AddToQueueResponse{
+		Position: int32(q.Add(player, server)),
+	}), nil
+}
+
+func (s *Service) RemoveFromQueue(ctx context.Context, c *connect.Request[pb.RemoveFromQueueRequest]) (*connect.Response[pb.RemoveFromQueueResponse], error) {
+	q, err := s.queue()
+	if err != nil {
+		return nil, err
+	}
+	player, err := s.player(c.Msg.Player)
+	if err != nil {
+		return nil, err
+	}
+	if !q.Remove(player) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("player is not queued"))
+	}
+	return connect.NewResponse(&pb.RemoveFromQueueResponse{}), nil
+}
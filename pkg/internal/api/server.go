@@ -38,8 +38,23 @@ func (s *Server) Start(ctx context.Context) error {
 		return err
 	}
 
+	interceptors := []connect.Interceptor{otelInterceptor}
+	if auth := newAuthInterceptor(s.cfg); auth != nil {
+		interceptors = append(interceptors, auth)
+	} else {
+		log.Info("api authentication is disabled, all requests are allowed")
+	}
+
+	tlsCfg, err := tlsConfig(s.cfg.TLS)
+	if err != nil {
+		return err
+	}
+
+	path, handler := gatev1connect.NewGateServiceHandler(s.h, connect.WithInterceptors(interceptors...))
 	mux := http.NewServeMux()
-	mux.Handle(gatev1connect.NewGateServiceHandler(s.h, connect.WithInterceptors(otelInterceptor)))
+	mux.Handle(path, handler)
+	// Event streams are long-lived and must not be cut off by the server's write timeout.
+	mux.Handle(gatev1connect.GateServiceWatchEventsProcedure, withoutWriteDeadline(handler))
 
 	hs := &http.Server{
 		Addr: s.cfg.Bind,
@@ -51,6 +66,7 @@ func (s *Server) Start(ctx context.Context) error {
 		WriteTimeout:      time.Second * 10,
 		IdleTimeout:       time.Second * 30,
 		BaseContext:       func(net.Listener) context.Context { return ctx },
+		TLSConfig:         tlsCfg,
 	}
 
 	eg, ctx := errgroup.WithContext(ctx)
@@ -61,11 +77,25 @@ func (s *Server) Start(ctx context.Context) error {
 		defer cancel()
 		return hs.Shutdown(stopCtx)
 	})
-	eg.Go(func() error { return ignoreClosed(hs.ListenAndServe()) })
+	eg.Go(func() error {
+		if tlsCfg != nil {
+			// Certificates are already loaded into the TLS config.
+			return ignoreClosed(hs.ListenAndServeTLS("", ""))
+		}
+		return ignoreClosed(hs.ListenAndServe())
+	})
 
 	return eg.Wait()
 }
 
+// withoutWriteDeadline clears the write deadline of the response before calling next.
+func withoutWriteDeadline(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+		next.ServeHTTP(w, r)
+	})
+}
+
 func ignoreClosed(err error) error {
 	if errors.Is(err, http.ErrServerClosed) {
 		return nil
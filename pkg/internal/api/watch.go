@@ -0,0 +1,211 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+
+	"connectrpc.com/connect"
+	"github.com/robinbraemer/event"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.minekube.com/gate/pkg/edition/java/proxy"
+	pb "go.minekube.com/gate/pkg/internal/api/gen/minekube/gate/v1"
+)
+
+// watchEventsBuffer is the number of events buffered per WatchEvents
+// stream before the stream is closed for not keeping up.
+const watchEventsBuffer = 256
+
+// watchEventsPriority is the lowest priority so that streamed
+// events reflect the results of all other subscribers.
+const watchEventsPriority = math.MinInt + 100
+
+func (s *Service) WatchEvents(ctx context.Context, c *connect.Request[pb.WatchEventsRequest], stream *connect.ServerStream[pb.WatchEventsResponse]) error {
+	f, err := newEventFilter(c.Msg)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var (
+		events   = make(chan *pb.Event, watchEventsBuffer)
+		overflow = make(chan struct{})
+		once     sync.Once
+	)
+	send := func(e *pb.Event) {
+		if !f.match(e) {
+			return
+		}
+		select {
+		case events <- e:
+		default:
+			once.Do(func() { close(overflow) })
+		}
+	}
+
+	unsub := subscribeEvents(s.p.Event(), f.types, send)
+	defer unsub()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-overflow:
+			return connect.NewError(connect.CodeResourceExhausted,
+				errors.New("event stream closed because the client is not keeping up"))
+		case e := <-events:
+			if err := stream.Send(&pb.WatchEventsResponse{Event: e}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// eventFilter matches events against the filters of a WatchEventsRequest.
+type eventFilter struct {
+	types   []pb.EventType
+	players []string
+	servers []string
+}
+
+func newEventFilter(req *pb.WatchEventsRequest) (*eventFilter, error) {
+	f := &eventFilter{
+		types:   slices.Compact(slices.Sorted(slices.Values(req.GetTypes()))),
+		players: req.GetPlayers(),
+		servers: req.GetServers(),
+	}
+	for _, t := range f.types {
+		if _, ok := eventSubscribers[t]; !ok {
+			return nil, fmt.Errorf("unsupported event type %s", t)
+		}
+	}
+	if len(f.types) == 0 {
+		for t := range eventSubscribers {
+			f.types = append(f.types, t)
+		}
+	}
+	return f, nil
+}
+
+func (f *eventFilter) match(e *pb.Event) bool {
+	if len(f.players) != 0 {
+		p := e.GetPlayer()
+		if p == nil || !slices.ContainsFunc(f.players, func(s string) bool {
+			return s == p.GetId() || strings.EqualFold(s, p.GetUsername())
+		}) {
+			return false
+		}
+	}
+	if len(f.servers) != 0 && !slices.Contains(f.servers, e.GetServer()) {
+		return false
+	}
+	return true
+}
+
+// subscribeEvents subscribes to the proxy events of the given types
+// and returns a function to unsubscribe from all of them.
+func subscribeEvents(mgr event.Manager, types []pb.EventType, send func(*pb.Event)) func() {
+	unsubs := make([]func(), 0, len(types))
+	for _, t := range types {
+		unsubs = append(unsubs, eventSubscribers[t](mgr, send))
+	}
+	return func() {
+		for _, unsub := range unsubs {
+			unsub()
+		}
+	}
+}
+
+// eventSubscribers subscribes to proxy events of a type and
+// sends them converted to protobuf.
+var eventSubscribers = map[pb.EventType]func(mgr event.Manager, send func(*pb.Event)) func(){
+	pb.EventType_EVENT_TYPE_POST_LOGIN: func(mgr event.Manager, send func(*pb.Event)) func() {
+		return event.Subscribe(mgr, watchEventsPriority, func(e *proxy.PostLoginEvent) {
+			ev := newEvent(pb.EventType_EVENT_TYPE_POST_LOGIN, e.Player(), currentServer(e.Player()))
+			ev.Data = &pb.Event_PostLogin{PostLogin: &pb.PostLoginEvent{}}
+			send(ev)
+		})
+	},
+	pb.EventType_EVENT_TYPE_DISCONNECT: func(mgr event.Manager, send func(*pb.Event)) func() {
+		return event.Subscribe(mgr, watchEventsPriority, func(e *proxy.DisconnectEvent) {
+			ev := newEvent(pb.EventType_EVENT_TYPE_DISCONNECT, e.Player(), currentServer(e.Player()))
+			ev.Data = &pb.Event_Disconnect{Disconnect: &pb.DisconnectEvent{
+				LoginStatus: LoginStatusToProto(e.LoginStatus()),
+			}}
+			send(ev)
+		})
+	},
+	pb.EventType_EVENT_TYPE_SERVER_CONNECTED: func(mgr event.Manager, send func(*pb.Event)) func() {
+		return event.Subscribe(mgr, watchEventsPriority, func(e *proxy.ServerConnectedEvent) {
+			var previous string
+			if prev := e.PreviousServer(); prev != nil {
+				previous = prev.ServerInfo().Name()
+			}
+			ev := newEvent(pb.EventType_EVENT_TYPE_SERVER_CONNECTED, e.Player(), e.Server().ServerInfo().Name())
+			ev.Data = &pb.Event_ServerConnected{ServerConnected: &pb.ServerConnectedEvent{
+				PreviousServer: previous,
+			}}
+			send(ev)
+		})
+	},
+	pb.EventType_EVENT_TYPE_KICKED_FROM_SERVER: func(mgr event.Manager, send func(*pb.Event)) func() {
+		return event.Subscribe(mgr, watchEventsPriority, func(e *proxy.KickedFromServerEvent) {
+			ev := newEvent(pb.EventType_EVENT_TYPE_KICKED_FROM_SERVER, e.Player(), e.Server().ServerInfo().Name())
+			ev.Data = &pb.Event_KickedFromServer{KickedFromServer: &pb.KickedFromServerEvent{
+				Reason:              ComponentToProto(e.OriginalReason()),
+				DuringServerConnect: e.KickedDuringServerConnect(),
+			}}
+			send(ev)
+		})
+	},
+	pb.EventType_EVENT_TYPE_PLAYER_CHAT: func(mgr event.Manager, send func(*pb.Event)) func() {
+		return event.Subscribe(mgr, watchEventsPriority, func(e *proxy.PlayerChatEvent) {
+			ev := newEvent(pb.EventType_EVENT_TYPE_PLAYER_CHAT, e.Player(), currentServer(e.Player()))
+			ev.Data = &pb.Event_PlayerChat{PlayerChat: &pb.PlayerChatEvent{
+				Message: e.Message(),
+				Allowed: e.Allowed(),
+			}}
+			send(ev)
+		})
+	},
+	pb.EventType_EVENT_TYPE_COMMAND_EXECUTE: func(mgr event.Manager, send func(*pb.Event)) func() {
+		return event.Subscribe(mgr, watchEventsPriority, func(e *proxy.CommandExecuteEvent) {
+			var server string
+			player, _ := e.Source().(proxy.Player)
+			if player != nil {
+				server = currentServer(player)
+			}
+			ev := newEvent(pb.EventType_EVENT_TYPE_COMMAND_EXECUTE, player, server)
+			ev.Data = &pb.Event_CommandExecute{CommandExecute: &pb.CommandExecuteEvent{
+				Command: e.Command(),
+				Allowed: e.Allowed(),
+			}}
+			send(ev)
+		})
+	},
+}
+
+// newEvent returns a new event without event specific data.
+func newEvent(typ pb.EventType, player proxy.Player, server string) *pb.Event {
+	e := &pb.Event{
+		Type:   typ,
+		Time:   timestamppb.Now(),
+		Server: server,
+	}
+	if player != nil {
+		e.Player = PlayerToProto(player)
+	}
+	return e
+}
+
+// currentServer returns the name of the server the player is connected to or empty if none.
+func currentServer(player proxy.Player) string {
+	if conn := player.CurrentServer(); conn != nil {
+		return conn.Server().ServerInfo().Name()
+	}
+	return ""
+}
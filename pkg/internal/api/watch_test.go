@@ -0,0 +1,41 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "go.minekube.com/gate/pkg/internal/api/gen/minekube/gate/v1"
+)
+
+func TestEventFilter(t *testing.T) {
+	_, err := newEventFilter(&pb.WatchEventsRequest{Types: []pb.EventType{pb.EventType_EVENT_TYPE_UNSPECIFIED}})
+	require.Error(t, err)
+
+	f, err := newEventFilter(&pb.WatchEventsRequest{})
+	require.NoError(t, err)
+	require.Len(t, f.types, len(eventSubscribers))
+
+	f, err = newEventFilter(&pb.WatchEventsRequest{
+		Types: []pb.EventType{
+			pb.EventType_EVENT_TYPE_PLAYER_CHAT,
+			pb.EventType_EVENT_TYPE_PLAYER_CHAT,
+		},
+		Players: []string{"Alice"},
+		Servers: []string{"lobby"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []pb.EventType{pb.EventType_EVENT_TYPE_PLAYER_CHAT}, f.types)
+
+	alice := &pb.Player{Id: "00000000-0000-0000-0000-000000000001", Username: "alice"}
+	bob := &pb.Player{Id: "00000000-0000-0000-0000-000000000002", Username: "bob"}
+	require.True(t, f.match(&pb.Event{Player: alice, Server: "lobby"}))
+	require.False(t, f.match(&pb.Event{Player: alice, Server: "survival"}))
+	require.False(t, f.match(&pb.Event{Player: alice}))
+	require.False(t, f.match(&pb.Event{Player: bob, Server: "lobby"}))
+	require.False(t, f.match(&pb.Event{Server: "lobby"}))
+
+	f, err = newEventFilter(&pb.WatchEventsRequest{Players: []string{bob.Id}})
+	require.NoError(t, err)
+	require.True(t, f.match(&pb.Event{Player: bob}))
+}
@@ -0,0 +1,11 @@
+//go:build !(linux || darwin || dragonfly || freebsd || netbsd || openbsd)
+
+package console
+
+import "errors"
+
+func isTerminal(int) bool { return false }
+
+func makeRaw(int) (func(), error) {
+	return nil, errors.New("raw terminal mode is not supported on this platform")
+}
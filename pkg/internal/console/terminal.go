@@ -0,0 +1,386 @@
+package console
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrInterrupt is returned by Terminal.ReadLine when the user pressed Ctrl+C.
+var ErrInterrupt = errors.New("interrupt")
+
+// CompleteFunc returns completion candidates for the line left of the cursor.
+// The candidates replace the line starting at byte offset start.
+type CompleteFunc func(line string) (start int, candidates []string)
+
+// historySize is the maximum number of remembered lines.
+const historySize = 500
+
+// Terminal is a line editor reading lines from an input file.
+//
+// If the input is a terminal, it is switched to raw mode while reading to
+// support editing, history and tab completion. Output written to the Terminal
+// is printed above the input line, so that log lines don't garble the input.
+// Otherwise, lines are read as is and output is written through.
+type Terminal struct {
+	in     *bufio.Reader
+	fd     int
+	out    io.Writer
+	prompt string
+
+	mu       sync.Mutex // Protects following fields
+	complete CompleteFunc
+	raw      bool   // Whether the input line is currently shown
+	restore  func() // Restores the terminal mode while raw
+	line     []rune
+	pos      int // cursor position in line
+	history  []string
+	histIdx  int    // index in history while browsing, len(history) if not
+	pending  string // line being edited before browsing history
+}
+
+// NewTerminal returns a new Terminal reading from in and writing to out.
+// The complete func may be nil to disable tab completion.
+func NewTerminal(in *os.File, out io.Writer, prompt string, complete CompleteFunc) *Terminal {
+	fd := int(in.Fd())
+	if !isTerminal(fd) {
+		fd = -1
+	}
+	return &Terminal{
+		in:       bufio.NewReader(in),
+		fd:       fd,
+		out:      out,
+		prompt:   prompt,
+		complete: complete,
+	}
+}
+
+// IsTerminal reports whether the input is an interactive terminal.
+func (t *Terminal) IsTerminal() bool {
+	return t.fd >= 0
+}
+
+// SetComplete sets the func used for tab completion.
+func (t *Terminal) SetComplete(complete CompleteFunc) {
+	t.mu.Lock()
+	t.complete = complete
+	t.mu.Unlock()
+}
+
+// Write writes p above the input line.
+func (t *Terminal) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.raw {
+		return t.out.Write(p)
+	}
+	buf := new(bytes.Buffer)
+	buf.WriteString("\r\x1b[K")
+	buf.Write(p)
+	if len(p) != 0 && p[len(p)-1] != '\n' {
+		buf.WriteByte('\n')
+	}
+	t.writeLine(buf)
+	if _, err := t.out.Write(buf.Bytes()); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// ReadLine reads the next line without the trailing newline.
+// It returns io.EOF if the input is closed and ErrInterrupt on Ctrl+C.
+func (t *Terminal) ReadLine() (string, error) {
+	if !t.IsTerminal() {
+		line, err := t.in.ReadString('\n')
+		if err != nil && (line == "" || !errors.Is(err, io.EOF)) {
+			return "", err
+		}
+		return strings.TrimRight(line, "\r\n"), nil
+	}
+
+	restore, err := makeRaw(t.fd)
+	if err != nil {
+		return "", fmt.Errorf("error switching terminal to raw mode: %w", err)
+	}
+
+	t.mu.Lock()
+	t.raw = true
+	t.restore = restore
+	t.line, t.pos = t.line[:0], 0
+	t.histIdx = len(t.history)
+	t.redraw()
+	t.mu.Unlock()
+
+	defer t.Close()
+
+	for {
+		r, _, err := t.in.ReadRune()
+		if err != nil {
+			return "", err
+		}
+		line, done, err := t.handleKey(r)
+		if done || err != nil {
+			return line, err
+		}
+	}
+}
+
+// Close restores the terminal mode if it is switched to raw mode by a pending ReadLine.
+// It must be called before exiting while a ReadLine call may be blocking.
+func (t *Terminal) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.restore != nil {
+		t.restore()
+		t.restore = nil
+	}
+	t.raw = false
+	return nil
+}
+
+// handleKey handles a key press and returns the line when it is done.
+func (t *Terminal) handleKey(r rune) (line string, done bool, err error) {
+	// Escape sequences need further reads and must not hold the lock.
+	if r == 0x1b {
+		r = t.readEscape()
+	}
+	// Completion calls back into the command manager which may log
+	// through Write and must not hold the lock either.
+	if r == '\t' {
+		t.completeLine()
+		return "", false, nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	switch r {
+	case '\r', '\n':
+		line = string(t.line)
+		t.line, t.pos = t.line[:0], 0
+		t.print("\r\n")
+		t.addHistory(line)
+		return line, true, nil
+	case 3: // Ctrl+C
+		t.print("^C\r\n")
+		t.line, t.pos = t.line[:0], 0
+		return "", true, ErrInterrupt
+	case 4: // Ctrl+D
+		if len(t.line) == 0 {
+			t.print("\r\n")
+			return "", true, io.EOF
+		}
+		t.delete()
+	case 127, 8: // Backspace
+		if t.pos > 0 {
+			t.pos--
+			t.delete()
+		}
+	case 1, keyHome: // Ctrl+A
+		t.pos = 0
+	case 5, keyEnd: // Ctrl+E
+		t.pos = len(t.line)
+	case 21: // Ctrl+U
+		t.line, t.pos = t.line[:0], 0
+	case keyLeft:
+		if t.pos > 0 {
+			t.pos--
+		}
+	case keyRight:
+		if t.pos < len(t.line) {
+			t.pos++
+		}
+	case keyUp:
+		t.browseHistory(-1)
+	case keyDown:
+		t.browseHistory(1)
+	case keyDelete:
+		t.delete()
+	default:
+		if !unicode.IsPrint(r) {
+			return "", false, nil
+		}
+		t.line = append(t.line, 0)
+		copy(t.line[t.pos+1:], t.line[t.pos:])
+		t.line[t.pos] = r
+		t.pos++
+	}
+	t.redraw()
+	return "", false, nil
+}
+
+// Special keys are mapped to private use runes.
+const (
+	keyUnknown rune = 0xe000 + iota
+	keyUp
+	keyDown
+	keyRight
+	keyLeft
+	keyHome
+	keyEnd
+	keyDelete
+)
+
+// readEscape reads the rest of an ANSI escape sequence.
+func (t *Terminal) readEscape() rune {
+	b, err := t.in.ReadByte()
+	if err != nil || (b != '[' && b != 'O') {
+		return keyUnknown
+	}
+	b, err = t.in.ReadByte()
+	if err != nil {
+		return keyUnknown
+	}
+	switch b {
+	case 'A':
+		return keyUp
+	case 'B':
+		return keyDown
+	case 'C':
+		return keyRight
+	case 'D':
+		return keyLeft
+	case 'H':
+		return keyHome
+	case 'F':
+		return keyEnd
+	}
+	if b < '0' || b > '9' {
+		return keyUnknown
+	}
+	// Sequences like "\x1b[3~" end with a tilde.
+	seq := []byte{b}
+	for {
+		b, err = t.in.ReadByte()
+		if err != nil {
+			return keyUnknown
+		}
+		if b == '~' {
+			break
+		}
+		seq = append(seq, b)
+	}
+	switch string(seq) {
+	case "1", "7":
+		return keyHome
+	case "4", "8":
+		return keyEnd
+	case "3":
+		return keyDelete
+	}
+	return keyUnknown
+}
+
+// delete deletes the rune at the cursor.
+func (t *Terminal) delete() {
+	if t.pos < len(t.line) {
+		t.line = append(t.line[:t.pos], t.line[t.pos+1:]...)
+	}
+}
+
+func (t *Terminal) addHistory(line string) {
+	if strings.TrimSpace(line) == "" {
+		return
+	}
+	if n := len(t.history); n != 0 && t.history[n-1] == line {
+		return
+	}
+	t.history = append(t.history, line)
+	if len(t.history) > historySize {
+		t.history = t.history[len(t.history)-historySize:]
+	}
+}
+
+func (t *Terminal) browseHistory(delta int) {
+	idx := t.histIdx + delta
+	if idx < 0 || idx > len(t.history) {
+		return
+	}
+	if t.histIdx == len(t.history) {
+		t.pending = string(t.line)
+	}
+	t.histIdx = idx
+	line := t.pending
+	if idx < len(t.history) {
+		line = t.history[idx]
+	}
+	t.line = []rune(line)
+	t.pos = len(t.line)
+}
+
+// completeLine completes the word at the cursor. If there are multiple
+// candidates, it completes their common prefix or lists them.
+// The complete func is called without holding the lock.
+func (t *Terminal) completeLine() {
+	t.mu.Lock()
+	complete := t.complete
+	left := string(t.line[:t.pos])
+	t.mu.Unlock()
+	if complete == nil {
+		return
+	}
+	start, candidates := complete(left)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	defer t.redraw()
+	if len(candidates) == 0 || start < 0 || start > len(left) ||
+		t.pos > len(t.line) || string(t.line[:t.pos]) != left {
+		return
+	}
+	word := left[start:]
+	completion := candidates[0]
+	for _, c := range candidates[1:] {
+		completion = commonPrefix(completion, c)
+	}
+	if len(candidates) > 1 && completion == word {
+		t.print("\r\x1b[K" + strings.Join(candidates, "  ") + "\r\n")
+		return
+	}
+	if len(candidates) == 1 {
+		completion += " "
+	}
+	rest := t.line[t.pos:]
+	line := append([]rune(left[:start]), []rune(completion)...)
+	t.pos = len(line)
+	t.line = append(line, rest...)
+}
+
+func commonPrefix(a, b string) string {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	for i > 0 && i < len(a) && !utf8.RuneStart(a[i]) {
+		i--
+	}
+	return a[:i]
+}
+
+// redraw redraws the input line and moves the cursor to its position.
+func (t *Terminal) redraw() {
+	buf := new(bytes.Buffer)
+	buf.WriteString("\r\x1b[K")
+	t.writeLine(buf)
+	_, _ = t.out.Write(buf.Bytes())
+}
+
+// writeLine writes the prompt and input line to buf.
+func (t *Terminal) writeLine(buf *bytes.Buffer) {
+	buf.WriteString(t.prompt)
+	buf.WriteString(string(t.line))
+	if back := len(t.line) - t.pos; back > 0 {
+		_, _ = fmt.Fprintf(buf, "\x1b[%dD", back)
+	}
+}
+
+func (t *Terminal) print(s string) {
+	_, _ = io.WriteString(t.out, s)
+}
@@ -0,0 +1,59 @@
+package console
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTerminal_ReadLine(t *testing.T) {
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	term := NewTerminal(r, io.Discard, "> ", nil)
+	require.False(t, term.IsTerminal())
+
+	_, err = w.WriteString("glist all\r\nsend alice lobby")
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	line, err := term.ReadLine()
+	require.NoError(t, err)
+	require.Equal(t, "glist all", line)
+	line, err = term.ReadLine()
+	require.NoError(t, err)
+	require.Equal(t, "send alice lobby", line)
+	_, err = term.ReadLine()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestTerminal_completeLine(t *testing.T) {
+	term := &Terminal{out: io.Discard}
+	term.SetComplete(func(line string) (int, []string) {
+		require.Equal(t, "server lo", line)
+		return 7, []string{"lobby", "lobster"}
+	})
+	term.line, term.pos = []rune("server lo x"), 9
+	term.completeLine()
+	require.Equal(t, "server lob x", string(term.line))
+	require.Equal(t, 10, term.pos)
+
+	term.SetComplete(func(string) (int, []string) { return 7, []string{"lobby"} })
+	term.completeLine()
+	require.Equal(t, "server lobby  x", string(term.line))
+	require.Equal(t, 13, term.pos)
+}
+
+func TestTerminal_completeLineWrites(t *testing.T) {
+	term := &Terminal{out: io.Discard, raw: true}
+	// Completions may log through the terminal while completing.
+	term.SetComplete(func(string) (int, []string) {
+		_, err := term.Write([]byte("suggesting\n"))
+		require.NoError(t, err)
+		return 0, []string{"glist"}
+	})
+	term.line, term.pos = []rune("gl"), 2
+	term.completeLine()
+	require.Equal(t, "glist ", string(term.line))
+}
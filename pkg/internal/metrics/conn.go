@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"context"
+	"io"
+	"net"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// CountConn returns a net.Conn adding the bytes read from and written to
+// conn to the received and sent counters as they are transferred.
+func CountConn(conn net.Conn, received, sent metric.Int64Counter, opts ...metric.AddOption) net.Conn {
+	return &countingConn{
+		Conn:     conn,
+		received: countingWriter{counter: received, opts: opts},
+		sent:     countingWriter{Writer: conn, counter: sent, opts: opts},
+	}
+}
+
+// CountWriter returns an io.Writer adding the bytes written to w to the counter as they are written.
+func CountWriter(w io.Writer, counter metric.Int64Counter, opts ...metric.AddOption) io.Writer {
+	return &countingWriter{Writer: w, counter: counter, opts: opts}
+}
+
+type countingConn struct {
+	net.Conn
+	received, sent countingWriter
+}
+
+func (c *countingConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.received.add(n)
+	return n, err
+}
+
+func (c *countingConn) Write(b []byte) (int, error) {
+	return c.sent.Write(b)
+}
+
+type countingWriter struct {
+	io.Writer
+	counter metric.Int64Counter
+	opts    []metric.AddOption
+}
+
+func (w *countingWriter) Write(b []byte) (int, error) {
+	n, err := w.Writer.Write(b)
+	w.add(n)
+	return n, err
+}
+
+func (w *countingWriter) add(n int) {
+	if n > 0 {
+		w.counter.Add(context.Background(), int64(n), w.opts...)
+	}
+}
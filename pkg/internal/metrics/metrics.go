@@ -0,0 +1,202 @@
+// Package metrics exposes the metrics recorded through the OpenTelemetry meter provider
+// in the Prometheus text exposition format for scraping without an OpenTelemetry collector.
+//
+// See https://prometheus.io/docs/instrumenting/exposition_formats/ for the format.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// ContentType is the content type of the text exposition format.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Reader is the reader of the global meter provider the metrics endpoint collects from.
+var Reader = sdkmetric.NewManualReader()
+
+// Must returns the instrument and panics if err is not nil.
+// It is meant for package level instruments.
+func Must[T any](instrument T, err error) T {
+	if err != nil {
+		panic(fmt.Sprintf("metrics: %v", err))
+	}
+	return instrument
+}
+
+// Handler returns a http.Handler serving the metrics collected
+// by the reader in the text exposition format.
+func Handler(reader sdkmetric.Reader) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var rm metricdata.ResourceMetrics
+		if err := reader.Collect(r.Context(), &rm); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", ContentType)
+		_ = WriteText(w, &rm)
+	})
+}
+
+// WriteText writes the metrics in the text exposition format sorted by name.
+// Metric names are converted to Prometheus names, e.g. the counter "gate.sent" with
+// unit "By" is written as "gate_sent_bytes_total". Exponential histograms and summaries are skipped.
+func WriteText(w io.Writer, rm *metricdata.ResourceMetrics) error {
+	var metrics []metricdata.Metrics
+	for _, sm := range rm.ScopeMetrics {
+		metrics = append(metrics, sm.Metrics...)
+	}
+	slices.SortFunc(metrics, func(a, b metricdata.Metrics) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	bw := bufio.NewWriter(w)
+	for _, m := range metrics {
+		name := promName(m.Name, m.Unit)
+		switch data := m.Data.(type) {
+		case metricdata.Gauge[int64]:
+			writePoints(bw, name, m.Description, "gauge", data.DataPoints)
+		case metricdata.Gauge[float64]:
+			writePoints(bw, name, m.Description, "gauge", data.DataPoints)
+		case metricdata.Sum[int64]:
+			writeSum(bw, name, m.Description, data)
+		case metricdata.Sum[float64]:
+			writeSum(bw, name, m.Description, data)
+		case metricdata.Histogram[int64]:
+			writeHistogram(bw, name, m.Description, data)
+		case metricdata.Histogram[float64]:
+			writeHistogram(bw, name, m.Description, data)
+		}
+	}
+	return bw.Flush()
+}
+
+func writeHeader(w *bufio.Writer, name, help, typ string) {
+	_, _ = fmt.Fprintf(w, "# HELP %s %s\n", name, escapeHelp(help))
+	_, _ = fmt.Fprintf(w, "# TYPE %s %s\n", name, typ)
+}
+
+func writeSum[N int64 | float64](w *bufio.Writer, name, help string, sum metricdata.Sum[N]) {
+	if !sum.IsMonotonic {
+		writePoints(w, name, help, "gauge", sum.DataPoints)
+		return
+	}
+	if !strings.HasSuffix(name, "_total") {
+		name += "_total"
+	}
+	writePoints(w, name, help, "counter", sum.DataPoints)
+}
+
+func writePoints[N int64 | float64](w *bufio.Writer, name, help, typ string, points []metricdata.DataPoint[N]) {
+	writeHeader(w, name, help, typ)
+	for _, p := range points {
+		writeSample(w, name, labels(p.Attributes.ToSlice(), ""), formatValue(float64(p.Value)))
+	}
+}
+
+func writeHistogram[N int64 | float64](w *bufio.Writer, name, help string, h metricdata.Histogram[N]) {
+	writeHeader(w, name, help, "histogram")
+	for _, p := range h.DataPoints {
+		attrs := p.Attributes.ToSlice()
+		var count uint64
+		for i, bound := range p.Bounds {
+			count += p.BucketCounts[i]
+			writeSample(w, name+"_bucket", labels(attrs, formatValue(bound)), strconv.FormatUint(count, 10))
+		}
+		writeSample(w, name+"_bucket", labels(attrs, "+Inf"), strconv.FormatUint(p.Count, 10))
+		writeSample(w, name+"_sum", labels(attrs, ""), formatValue(float64(p.Sum)))
+		writeSample(w, name+"_count", labels(attrs, ""), strconv.FormatUint(p.Count, 10))
+	}
+}
+
+func writeSample(w *bufio.Writer, name, labels, value string) {
+	_, _ = w.WriteString(name)
+	_, _ = w.WriteString(labels)
+	_ = w.WriteByte(' ')
+	_, _ = w.WriteString(value)
+	_ = w.WriteByte('\n')
+}
+
+// labels formats the attributes and the optional le label of histogram buckets as label set.
+func labels(attrs []attribute.KeyValue, le string) string {
+	if len(attrs) == 0 && le == "" {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, kv := range attrs {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(sanitize(string(kv.Key)))
+		b.WriteString(`="`)
+		b.WriteString(escapeLabelValue(kv.Value.Emit()))
+		b.WriteByte('"')
+	}
+	if le != "" {
+		if len(attrs) > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(`le="`)
+		b.WriteString(le)
+		b.WriteByte('"')
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// unitSuffixes are the name suffixes of units.
+var unitSuffixes = map[string]string{
+	"s":  "_seconds",
+	"ms": "_milliseconds",
+	"By": "_bytes",
+}
+
+// promName converts the OpenTelemetry metric name and unit to a Prometheus metric name.
+func promName(name, unit string) string {
+	name = sanitize(name)
+	if suffix := unitSuffixes[unit]; suffix != "" && !strings.HasSuffix(name, suffix) {
+		name += suffix
+	}
+	return name
+}
+
+// sanitize replaces characters not allowed in metric and label names with underscores.
+func sanitize(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || r == ':' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+func formatValue(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "+Inf"
+	case math.IsInf(f, -1):
+		return "-Inf"
+	case math.IsNaN(f):
+		return "NaN"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(s string) string       { return helpEscaper.Replace(s) }
+func escapeLabelValue(s string) string { return labelEscaper.Replace(s) }
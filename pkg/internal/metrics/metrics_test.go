@@ -0,0 +1,95 @@
+package metrics
+
+import (
+	"context"
+	"net"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+func TestHandler(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	defer func() { _ = provider.Shutdown(context.Background()) }()
+	meter := provider.Meter("test")
+	ctx := context.Background()
+
+	logins := Must(meter.Int64Counter("test.logins", metric.WithDescription("Total logins.")))
+	logins.Add(ctx, 1, metric.WithAttributes(attribute.String("reason", "denied")))
+	logins.Add(ctx, 2, metric.WithAttributes(attribute.String("reason", `say "hi"`)))
+
+	active := Must(meter.Int64UpDownCounter("test.active", metric.WithDescription("Active\nconnections.")))
+	active.Add(ctx, 2)
+	active.Add(ctx, -1)
+
+	duration := Must(meter.Float64Histogram("test.duration",
+		metric.WithDescription("Durations."), metric.WithUnit("s"), metric.WithExplicitBucketBoundaries(0.5, 1)))
+	duration.Record(ctx, 0.2)
+	duration.Record(ctx, 0.7)
+	duration.Record(ctx, 5)
+
+	rec := httptest.NewRecorder()
+	Handler(reader).ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	require.Equal(t, ContentType, rec.Header().Get("Content-Type"))
+	require.Equal(t, `# HELP test_active Active\nconnections.
+# TYPE test_active gauge
+test_active 1
+# HELP test_duration_seconds Durations.
+# TYPE test_duration_seconds histogram
+test_duration_seconds_bucket{le="0.5"} 1
+test_duration_seconds_bucket{le="1"} 2
+test_duration_seconds_bucket{le="+Inf"} 3
+test_duration_seconds_sum 5.9
+test_duration_seconds_count 3
+# HELP test_logins_total Total logins.
+# TYPE test_logins_total counter
+test_logins_total{reason="denied"} 1
+test_logins_total{reason="say \"hi\""} 2
+`, sortedLogins(rec.Body.String()))
+}
+
+// sortedLogins sorts the samples of the logins counter that are collected in random order.
+func sortedLogins(s string) string {
+	const prefix = "test_logins_total{"
+	lines := strings.SplitAfter(s, "\n")
+	i := len(lines) - 3
+	if strings.HasPrefix(lines[i], prefix+`reason="say`) {
+		lines[i], lines[i+1] = lines[i+1], lines[i]
+	}
+	return strings.Join(lines, "")
+}
+
+func TestCountConn(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	defer func() { _ = provider.Shutdown(context.Background()) }()
+	meter := provider.Meter("test")
+	received := Must(meter.Int64Counter("test.received", metric.WithUnit("By")))
+	sent := Must(meter.Int64Counter("test.sent", metric.WithUnit("By")))
+
+	a, b := net.Pipe()
+	defer a.Close()
+	defer b.Close()
+	conn := CountConn(a, received, sent, metric.WithAttributes(attribute.String("connection", "client")))
+	go func() {
+		buf := make([]byte, 5)
+		_, _ = b.Read(buf)
+		_, _ = b.Write([]byte("hi"))
+	}()
+	_, err := conn.Write([]byte("hello"))
+	require.NoError(t, err)
+	buf := make([]byte, 2)
+	_, err = conn.Read(buf)
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	Handler(reader).ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	require.Contains(t, rec.Body.String(), `test_received_bytes_total{connection="client"} 2`)
+	require.Contains(t, rec.Body.String(), `test_sent_bytes_total{connection="client"} 5`)
+}
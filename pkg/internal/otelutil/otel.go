@@ -7,11 +7,15 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/honeycombio/otel-config-go/otelconfig"
+	"go.opentelemetry.io/otel"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+
+	"go.minekube.com/gate/pkg/internal/metrics"
 	"go.minekube.com/gate/pkg/version"
 )
 
 // Init initializes the OpenTelemetry SDK with the OTLP exporter and the corresponding trace and meter providers.
-// It also starts an HTTP server for Prometheus metrics.
+// If OTLP metrics are disabled, the meter provider only feeds the Prometheus metrics endpoint.
 func Init(ctx context.Context) (clean func(), err error) {
 	// default service name
 	serviceName := os.Getenv("OTEL_SERVICE_NAME")
@@ -20,24 +24,33 @@ func Init(ctx context.Context) (clean func(), err error) {
 	}
 
 	log := logr.FromContextOrDiscard(ctx).WithName("otel")
-	eitherEnabled := os.Getenv("OTEL_METRICS_ENABLED") == "true" || os.Getenv("OTEL_TRACES_ENABLED") == "true"
+	metricsEnabled := os.Getenv("OTEL_METRICS_ENABLED") == "true"
+	eitherEnabled := metricsEnabled || os.Getenv("OTEL_TRACES_ENABLED") == "true"
 
 	otelShutdown, err := otelconfig.ConfigureOpenTelemetry(
 		otelconfig.WithServiceName(serviceName),
 		otelconfig.WithServiceVersion(version.String()),
 		otelconfig.WithLogger(&logger{log}),
-		otelconfig.WithMetricsEnabled(os.Getenv("OTEL_METRICS_ENABLED") == "true"),
+		otelconfig.WithMetricsEnabled(metricsEnabled),
 		otelconfig.WithTracesEnabled(os.Getenv("OTEL_TRACES_ENABLED") == "true"),
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	shutdownMeter := func() {}
+	if !metricsEnabled {
+		provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(metrics.Reader))
+		otel.SetMeterProvider(provider)
+		shutdownMeter = func() { _ = provider.Shutdown(context.Background()) }
+	}
+
 	return func() {
 		if eitherEnabled {
 			log.Info("shutting down OpenTelemetry, trying to push remaining telemetry data...")
 		}
 		otelShutdown()
+		shutdownMeter()
 		if eitherEnabled {
 			log.Info("OpenTelemetry shutdown complete")
 		}
@@ -9,17 +9,20 @@ import (
 	"github.com/knadh/koanf/providers/file"
 )
 
+// mu serializes reload callbacks of all watched files.
 var mu sync.Mutex
-var debounceTimer *time.Timer
 
 const debounceDuration = 100 * time.Millisecond
 
+// Watch calls cb when the file at path changes until the context is canceled.
 func Watch(ctx context.Context, path string, cb func() error) error {
 	if ctx.Err() != nil {
 		return nil
 	}
 	log := logr.FromContextOrDiscard(ctx).WithValues("path", path)
-	return file.Provider(path).Watch(func(_ any, err error) {
+	var debounceTimer *time.Timer // debounces changes of this file
+	f := file.Provider(path)
+	err := f.Watch(func(_ any, err error) {
 		if ctx.Err() != nil {
 			return
 		}
@@ -35,6 +38,9 @@ func Watch(ctx context.Context, path string, cb func() error) error {
 		debounceTimer = time.AfterFunc(debounceDuration, func() {
 			mu.Lock()
 			defer mu.Unlock()
+			if ctx.Err() != nil {
+				return
+			}
 
 			log.Info("auto-reloading config")
 			start := time.Now()
@@ -46,4 +52,13 @@ func Watch(ctx context.Context, path string, cb func() error) error {
 		})
 		mu.Unlock()
 	})
+	if err != nil {
+		return err
+	}
+	// Stop the file watcher when done
+	go func() {
+		<-ctx.Done()
+		_ = f.Unwatch()
+	}()
+	return nil
 }
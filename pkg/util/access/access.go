@@ -0,0 +1,349 @@
+// Package access provides a ban list and an allowlist deciding which players
+// may join the proxy, optionally persisted to a JSON file.
+package access
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.minekube.com/gate/pkg/util/fileutil"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+// Kind is the kind of target a ban or allowlist entry applies to.
+type Kind string
+
+const (
+	UUIDKind Kind = "uuid" // A player UUID.
+	NameKind Kind = "name" // A case-insensitive username.
+	IPKind   Kind = "ip"   // A single IP address or a CIDR range.
+)
+
+// Ban bans a player UUID, username, IP address or CIDR range from joining.
+type Ban struct {
+	Kind   Kind   `json:"kind"`
+	Target string `json:"target"` // UUID, lowercase username, IP address or CIDR range
+	// Reason is shown to banned players, as legacy or JSON text component.
+	Reason  string    `json:"reason,omitempty"`
+	Source  string    `json:"source,omitempty"` // Who created the ban
+	Created time.Time `json:"created"`
+	Expires time.Time `json:"expires,omitzero"` // Zero for permanent bans
+
+	prefix netip.Prefix // parsed target of IPKind bans
+}
+
+// Permanent returns true if the ban never expires.
+func (b *Ban) Permanent() bool { return b.Expires.IsZero() }
+
+// Expired returns true if the ban is temporary and expired at the given time.
+func (b *Ban) Expired(now time.Time) bool {
+	return !b.Permanent() && !now.Before(b.Expires)
+}
+
+func (b *Ban) matches(id uuid.UUID, name string, addr netip.Addr) bool {
+	switch b.Kind {
+	case UUIDKind:
+		return id != uuid.Nil && b.Target == id.String()
+	case NameKind:
+		return b.Target == strings.ToLower(name)
+	case IPKind:
+		return addr.IsValid() && b.prefix.Contains(addr.Unmap())
+	default:
+		return false
+	}
+}
+
+var nameRegex = regexp.MustCompile(`^[A-Za-z0-9_]{2,16}$`)
+
+// ParseTarget parses a UUID, IP address, CIDR range or username
+// and returns its kind and normalized form.
+func ParseTarget(s string) (Kind, string, error) {
+	s = strings.TrimSpace(s)
+	if id, err := uuid.Parse(s); err == nil {
+		return UUIDKind, id.String(), nil
+	}
+	if addr, err := netip.ParseAddr(s); err == nil {
+		return IPKind, addr.Unmap().String(), nil
+	}
+	if prefix, err := netip.ParsePrefix(s); err == nil {
+		return IPKind, prefix.Masked().String(), nil
+	}
+	if nameRegex.MatchString(s) {
+		return NameKind, strings.ToLower(s), nil
+	}
+	return "", "", fmt.Errorf("invalid target %q, must be a UUID, IP address, CIDR range or username", s)
+}
+
+func parsePrefix(target string) (netip.Prefix, error) {
+	if strings.Contains(target, "/") {
+		return netip.ParsePrefix(target)
+	}
+	addr, err := netip.ParseAddr(target)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// Store holds bans and the allowlist.
+// It is safe for concurrent use.
+type Store struct {
+	path string // empty if not persisted
+
+	mu        sync.RWMutex
+	bans      []*Ban
+	allowlist allowlist
+}
+
+type allowlist struct {
+	Enabled bool     `json:"enabled"`
+	Entries []string `json:"entries"` // normalized UUIDs and usernames
+}
+
+// file is the JSON format of a persisted Store.
+type file struct {
+	Bans      []*Ban    `json:"bans"`
+	Allowlist allowlist `json:"allowlist"`
+}
+
+// NewStore returns a Store persisted to the JSON file at path, loading it if it exists.
+// The file is created on the first change. If path is empty, the Store is only kept in memory.
+func NewStore(path string) (*Store, error) {
+	s := &Store{path: path}
+	if path == "" {
+		return s, nil
+	}
+	var f file
+	if err := fileutil.ReadJSON(path, &f); err != nil {
+		return nil, fmt.Errorf("error loading access file: %w", err)
+	}
+	for i, b := range f.Bans {
+		if b == nil {
+			continue
+		}
+		kind, target, err := ParseTarget(b.Target)
+		if err != nil || kind != b.Kind {
+			return nil, fmt.Errorf("invalid ban %d in access file %s: invalid %s target %q", i, path, b.Kind, b.Target)
+		}
+		b.Target = target
+		if kind == IPKind {
+			b.prefix, _ = parsePrefix(target)
+		}
+		s.bans = append(s.bans, b)
+	}
+	for i, entry := range f.Allowlist.Entries {
+		kind, target, err := ParseTarget(entry)
+		if err != nil || kind == IPKind {
+			return nil, fmt.Errorf("invalid allowlist entry %d %q in access file %s, must be a UUID or username", i, entry, path)
+		}
+		f.Allowlist.Entries[i] = target
+	}
+	s.allowlist = f.Allowlist
+	return s, nil
+}
+
+// Ban adds the ban, replacing an existing ban of the same target.
+// The target is normalized and Created defaults to now.
+func (s *Store) Ban(b Ban) (Ban, error) {
+	kind, target, err := ParseTarget(b.Target)
+	if err != nil {
+		return Ban{}, err
+	}
+	b.Kind, b.Target = kind, target
+	if kind == IPKind {
+		b.prefix, _ = parsePrefix(target)
+	}
+	now := time.Now()
+	if b.Created.IsZero() {
+		b.Created = now
+	}
+	if b.Expired(now) {
+		return Ban{}, errors.New("ban expires in the past")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	bans := s.bans
+	s.bans = slices.DeleteFunc(slices.Clone(bans), func(e *Ban) bool {
+		return e.Kind == b.Kind && e.Target == b.Target
+	})
+	s.bans = append(s.bans, &b)
+	if err = s.save(); err != nil {
+		s.bans = bans
+		return Ban{}, err
+	}
+	return b, nil
+}
+
+// Unban removes the ban of the target.
+// Returns false if the target was not banned.
+func (s *Store) Unban(target string) (bool, error) {
+	kind, target, err := ParseTarget(target)
+	if err != nil {
+		return false, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	bans := s.bans
+	s.bans = slices.DeleteFunc(slices.Clone(bans), func(b *Ban) bool {
+		return b.Kind == kind && b.Target == target
+	})
+	if len(s.bans) == len(bans) {
+		return false, nil
+	}
+	if err = s.save(); err != nil {
+		s.bans = bans
+		return false, err
+	}
+	return true, nil
+}
+
+// Bans returns the bans that are not expired, oldest first.
+func (s *Store) Bans() []Ban {
+	now := time.Now()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	bans := make([]Ban, 0, len(s.bans))
+	for _, b := range s.bans {
+		if !b.Expired(now) {
+			bans = append(bans, *b)
+		}
+	}
+	slices.SortStableFunc(bans, func(a, b Ban) int { return a.Created.Compare(b.Created) })
+	return bans
+}
+
+// Banned returns the first ban matching the player's UUID, username or IP address.
+// Any of them may be zero to not match bans of that kind.
+func (s *Store) Banned(id uuid.UUID, name string, addr netip.Addr) (Ban, bool) {
+	now := time.Now()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, b := range s.bans {
+		if !b.Expired(now) && b.matches(id, name, addr) {
+			return *b, true
+		}
+	}
+	return Ban{}, false
+}
+
+// AllowlistEnabled returns true if only allowlisted players may join.
+func (s *Store) AllowlistEnabled() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.allowlist.Enabled
+}
+
+// SetAllowlistEnabled enables or disables the allowlist.
+func (s *Store) SetAllowlistEnabled(enabled bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.allowlist.Enabled == enabled {
+		return nil
+	}
+	s.allowlist.Enabled = enabled
+	if err := s.save(); err != nil {
+		s.allowlist.Enabled = !enabled
+		return err
+	}
+	return nil
+}
+
+// Allowlist returns the allowlisted UUIDs and usernames.
+func (s *Store) Allowlist() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return slices.Clone(s.allowlist.Entries)
+}
+
+// Allow adds the UUID or username to the allowlist.
+// Returns false if it is already allowlisted.
+func (s *Store) Allow(entry string) (bool, error) {
+	target, err := parseAllowlistEntry(entry)
+	if err != nil {
+		return false, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entries := s.allowlist.Entries
+	if slices.Contains(entries, target) {
+		return false, nil
+	}
+	s.allowlist.Entries = append(slices.Clone(entries), target)
+	if err = s.save(); err != nil {
+		s.allowlist.Entries = entries
+		return false, err
+	}
+	return true, nil
+}
+
+// Disallow removes the UUID or username from the allowlist.
+// Returns false if it was not allowlisted.
+func (s *Store) Disallow(entry string) (bool, error) {
+	target, err := parseAllowlistEntry(entry)
+	if err != nil {
+		return false, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entries := s.allowlist.Entries
+	s.allowlist.Entries = slices.DeleteFunc(slices.Clone(entries), func(e string) bool { return e == target })
+	if len(s.allowlist.Entries) == len(entries) {
+		return false, nil
+	}
+	if err = s.save(); err != nil {
+		s.allowlist.Entries = entries
+		return false, err
+	}
+	return true, nil
+}
+
+// Allowed returns true if the allowlist is disabled or
+// the player's UUID or username is allowlisted.
+func (s *Store) Allowed(id uuid.UUID, name string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if !s.allowlist.Enabled {
+		return true
+	}
+	return slices.Contains(s.allowlist.Entries, id.String()) ||
+		slices.Contains(s.allowlist.Entries, strings.ToLower(name))
+}
+
+func parseAllowlistEntry(entry string) (string, error) {
+	kind, target, err := ParseTarget(entry)
+	if err != nil {
+		return "", err
+	}
+	if kind == IPKind {
+		return "", fmt.Errorf("invalid allowlist entry %q, must be a UUID or username", entry)
+	}
+	return target, nil
+}
+
+// save writes the store to its file, dropping expired bans.
+// Must be called with s.mu locked.
+func (s *Store) save() error {
+	now := time.Now()
+	s.bans = slices.DeleteFunc(s.bans, func(b *Ban) bool { return b.Expired(now) })
+	if s.path == "" {
+		return nil
+	}
+	f := file{Bans: s.bans, Allowlist: s.allowlist}
+	if f.Bans == nil {
+		f.Bans = []*Ban{}
+	}
+	if f.Allowlist.Entries == nil {
+		f.Allowlist.Entries = []string{}
+	}
+	if err := fileutil.WriteJSON(s.path, f); err != nil {
+		return fmt.Errorf("error writing access file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package access
+
+import (
+	"net/netip"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+func TestParseTarget(t *testing.T) {
+	id := uuid.New()
+	for in, want := range map[string][2]string{
+		id.String():      {string(UUIDKind), id.String()},
+		id.Undashed():    {string(UUIDKind), id.String()},
+		"Notch":          {string(NameKind), "notch"},
+		"10.0.0.1":       {string(IPKind), "10.0.0.1"},
+		"10.0.0.7/24":    {string(IPKind), "10.0.0.0/24"},
+		"::ffff:1.2.3.4": {string(IPKind), "1.2.3.4"},
+	} {
+		kind, target, err := ParseTarget(in)
+		require.NoError(t, err, in)
+		require.Equal(t, want, [2]string{string(kind), target}, in)
+	}
+	_, _, err := ParseTarget("not a name")
+	require.Error(t, err)
+}
+
+func TestBanned(t *testing.T) {
+	s, err := NewStore("")
+	require.NoError(t, err)
+	id := uuid.New()
+	addr := netip.MustParseAddr("192.168.1.10")
+
+	_, ok := s.Banned(id, "Notch", addr)
+	require.False(t, ok)
+
+	_, err = s.Ban(Ban{Target: "192.168.1.0/24", Reason: "griefing"})
+	require.NoError(t, err)
+	b, ok := s.Banned(id, "Notch", addr)
+	require.True(t, ok)
+	require.Equal(t, IPKind, b.Kind)
+	require.Equal(t, "griefing", b.Reason)
+	_, ok = s.Banned(id, "Notch", netip.MustParseAddr("192.168.2.10"))
+	require.False(t, ok)
+
+	_, err = s.Ban(Ban{Target: "notch", Expires: time.Now().Add(-time.Second)})
+	require.Error(t, err, "expired")
+	_, err = s.Ban(Ban{Target: "NOTCH", Expires: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+	b, ok = s.Banned(uuid.Nil, "Notch", netip.Addr{})
+	require.True(t, ok)
+	require.False(t, b.Permanent())
+
+	removed, err := s.Unban("Notch")
+	require.NoError(t, err)
+	require.True(t, removed)
+	removed, err = s.Unban("Notch")
+	require.NoError(t, err)
+	require.False(t, removed)
+	require.Len(t, s.Bans(), 1)
+}
+
+func TestAllowlist(t *testing.T) {
+	s, err := NewStore("")
+	require.NoError(t, err)
+	id := uuid.New()
+	require.True(t, s.Allowed(id, "Notch"), "disabled")
+
+	require.NoError(t, s.SetAllowlistEnabled(true))
+	require.False(t, s.Allowed(id, "Notch"))
+
+	added, err := s.Allow("notch")
+	require.NoError(t, err)
+	require.True(t, added)
+	require.True(t, s.Allowed(uuid.New(), "Notch"))
+
+	_, err = s.Allow("10.0.0.1")
+	require.Error(t, err)
+
+	removed, err := s.Disallow("Notch")
+	require.NoError(t, err)
+	require.True(t, removed)
+	require.False(t, s.Allowed(id, "Notch"))
+}
+
+func TestStorePersisted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data", "access.json")
+	s, err := NewStore(path)
+	require.NoError(t, err)
+	id := uuid.New()
+	_, err = s.Ban(Ban{Target: id.String(), Reason: "&cCheating", Source: "Console"})
+	require.NoError(t, err)
+	_, err = s.Ban(Ban{Target: "10.0.0.0/8"})
+	require.NoError(t, err)
+	require.NoError(t, s.SetAllowlistEnabled(true))
+	_, err = s.Allow("Notch")
+	require.NoError(t, err)
+
+	s, err = NewStore(path)
+	require.NoError(t, err)
+	b, ok := s.Banned(id, "", netip.Addr{})
+	require.True(t, ok)
+	require.Equal(t, "&cCheating", b.Reason)
+	require.Equal(t, "Console", b.Source)
+	_, ok = s.Banned(uuid.Nil, "", netip.MustParseAddr("10.1.2.3"))
+	require.True(t, ok)
+	require.True(t, s.AllowlistEnabled())
+	require.Equal(t, []string{"notch"}, s.Allowlist())
+}
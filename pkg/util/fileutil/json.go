@@ -0,0 +1,66 @@
+// Package fileutil provides helpers for files persisted by the proxy.
+package fileutil
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// ReadJSON decodes the JSON file at path into v.
+// A missing or empty file leaves v unchanged and is not an error.
+func ReadJSON(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("error reading file: %w", err)
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	if err = json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("error decoding file %s: %w", path, err)
+	}
+	return nil
+}
+
+// WriteJSON writes v as indented JSON to the file at path, creating missing directories.
+// The file is replaced atomically by renaming a temp file,
+// so that readers never see a partially written file.
+func WriteJSON(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding file: %w", err)
+	}
+	dir := filepath.Dir(path)
+	if err = os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("error creating directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("error creating temp file: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("error writing temp file: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("error writing temp file: %w", err)
+	}
+	if err = os.Chmod(tmp.Name(), 0o644); err != nil {
+		return fmt.Errorf("error writing temp file: %w", err)
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("error moving temp file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package fileutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data", "file.json")
+	v := map[string]int{"a": 1}
+	require.NoError(t, ReadJSON(path, &v), "missing file")
+	require.Equal(t, map[string]int{"a": 1}, v)
+
+	require.NoError(t, WriteJSON(path, map[string]int{"b": 2}))
+	require.NoError(t, WriteJSON(path, map[string]int{"c": 3}))
+	var got map[string]int
+	require.NoError(t, ReadJSON(path, &got))
+	require.Equal(t, map[string]int{"c": 3}, got)
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "temp files are removed")
+
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o644))
+	require.ErrorContains(t, ReadJSON(path, &got), "error decoding file")
+}
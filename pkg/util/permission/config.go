@@ -0,0 +1,184 @@
+package permission
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+// DefaultGroup is the name of the group every player is a member of.
+const DefaultGroup = "default"
+
+// Config is a permission configuration, usually loaded from a YAML file,
+// granting permissions to players by groups and per-player overrides.
+//
+// A permission node ending with ".*" matches all permissions starting with
+// the prefix, "*" matches all permissions. The most specific matching node wins.
+// Nodes set to false explicitly deny a permission (False).
+//
+// Permissions are resolved in the following order, the first defined value wins:
+//  1. the player's own permissions
+//  2. the player's groups in the listed order, each before the groups it inherits
+//  3. the DefaultGroup
+type Config struct {
+	Groups  map[string]*Group        `yaml:"groups,omitempty" json:"groups,omitempty"`
+	Players map[string]*PlayerConfig `yaml:"players,omitempty" json:"players,omitempty"` // by UUID or username
+
+	indexOnce sync.Once
+	byID      map[uuid.UUID]*PlayerConfig
+	byName    map[string]*PlayerConfig // by lowercase username
+}
+
+// Group is a named set of permissions.
+type Group struct {
+	Inherit     []string        `yaml:"inherit,omitempty" json:"inherit,omitempty"` // Names of groups to inherit permissions from.
+	Permissions map[string]bool `yaml:"permissions,omitempty" json:"permissions,omitempty"`
+}
+
+// PlayerConfig are the groups and permission overrides of a player.
+type PlayerConfig struct {
+	Groups      []string        `yaml:"groups,omitempty" json:"groups,omitempty"`
+	Permissions map[string]bool `yaml:"permissions,omitempty" json:"permissions,omitempty"`
+}
+
+// LoadConfig reads and validates a Config from a YAML file.
+func LoadConfig(path string) (*Config, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c := new(Config)
+	if err = yaml.Unmarshal(b, c); err != nil {
+		return nil, fmt.Errorf("error parsing permissions file %q: %w", path, err)
+	}
+	if err = c.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid permissions file %q: %w", path, err)
+	}
+	return c, nil
+}
+
+// Validate returns an error if a group or player refers to an unknown group.
+func (c *Config) Validate() error {
+	check := func(owner string, groups []string) error {
+		for _, g := range groups {
+			if _, ok := c.Groups[g]; !ok {
+				return fmt.Errorf("%s refers to unknown group %q", owner, g)
+			}
+		}
+		return nil
+	}
+	for name, g := range c.Groups {
+		if g == nil {
+			continue
+		}
+		if err := check(fmt.Sprintf("group %q", name), g.Inherit); err != nil {
+			return err
+		}
+	}
+	for key, p := range c.Players {
+		if p == nil {
+			continue
+		}
+		if err := check(fmt.Sprintf("player %q", key), p.Groups); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Value returns the TriState of the permission for the player.
+func (c *Config) Value(id uuid.UUID, username, permission string) TriState {
+	if c == nil {
+		return Undefined
+	}
+	player := c.player(id, username)
+	if player != nil {
+		if v := nodeValue(player.Permissions, permission); v != Undefined {
+			return v
+		}
+	}
+
+	visited := map[string]bool{}
+	var groupValue func(name string) TriState
+	groupValue = func(name string) TriState {
+		g, ok := c.Groups[name]
+		if !ok || g == nil || visited[name] {
+			return Undefined
+		}
+		visited[name] = true
+		if v := nodeValue(g.Permissions, permission); v != Undefined {
+			return v
+		}
+		for _, inherited := range g.Inherit {
+			if v := groupValue(inherited); v != Undefined {
+				return v
+			}
+		}
+		return Undefined
+	}
+	if player != nil {
+		for _, name := range player.Groups {
+			if v := groupValue(name); v != Undefined {
+				return v
+			}
+		}
+	}
+	return groupValue(DefaultGroup)
+}
+
+// player returns the player config by UUID, falling back to the case-insensitive username.
+func (c *Config) player(id uuid.UUID, username string) *PlayerConfig {
+	c.indexOnce.Do(func() {
+		c.byID = map[uuid.UUID]*PlayerConfig{}
+		c.byName = map[string]*PlayerConfig{}
+		for key, p := range c.Players {
+			if parsed, err := uuid.Parse(key); err == nil {
+				c.byID[parsed] = p
+			} else {
+				c.byName[strings.ToLower(key)] = p
+			}
+		}
+	})
+	if p, ok := c.byID[id]; ok {
+		return p
+	}
+	return c.byName[strings.ToLower(username)]
+}
+
+// nodeValue returns the value of the most specific node matching the permission.
+func nodeValue(nodes map[string]bool, permission string) TriState {
+	if len(nodes) == 0 {
+		return Undefined
+	}
+	if v, ok := nodes[permission]; ok {
+		return triState(v)
+	}
+	// Try wildcards from the most to the least specific,
+	// e.g. "gate.command.*", "gate.*" and "*" for "gate.command.server".
+	for prefix := permission; ; {
+		i := strings.LastIndexByte(prefix, '.')
+		if i < 0 {
+			break
+		}
+		prefix = prefix[:i]
+		if v, ok := nodes[prefix+".*"]; ok {
+			return triState(v)
+		}
+	}
+	if v, ok := nodes["*"]; ok {
+		return triState(v)
+	}
+	return Undefined
+}
+
+func triState(v bool) TriState {
+	if v {
+		return True
+	}
+	return False
+}
@@ -0,0 +1,85 @@
+package permission
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+const testConfig = `
+groups:
+  default:
+    permissions:
+      gate.command.server: true
+  helper:
+    inherit: [default]
+    permissions:
+      gate.command.glist: true
+  admin:
+    inherit: [helper, admin]
+    permissions:
+      gate.command.*: true
+      gate.command.send: false
+players:
+  Alice:
+    groups: [admin]
+  00000000-0000-0000-0000-000000000002:
+    groups: [admin]
+    permissions:
+      gate.command.send: true
+      gate.command.server: false
+`
+
+func TestConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "permissions.yml")
+	require.NoError(t, os.WriteFile(path, []byte(testConfig), 0o644))
+	c, err := LoadConfig(path)
+	require.NoError(t, err)
+
+	alice, _ := uuid.Parse("00000000-0000-0000-0000-000000000001")
+	bob, _ := uuid.Parse("00000000-0000-0000-0000-000000000002")
+	carol, _ := uuid.Parse("00000000-0000-0000-0000-000000000003")
+
+	for _, tc := range []struct {
+		id         uuid.UUID
+		name, perm string
+		want       TriState
+	}{
+		// Default group applies to everyone
+		{carol, "Carol", "gate.command.server", True},
+		{carol, "Carol", "gate.command.glist", Undefined},
+		// Wildcard and explicit negation in a group, name is case-insensitive
+		{alice, "alice", "gate.command.glist", True},
+		{alice, "alice", "gate.command.other", True},
+		{alice, "alice", "gate.command.send", False},
+		{alice, "alice", "gate.other", Undefined},
+		// Player overrides by UUID take precedence over groups
+		{bob, "Bob", "gate.command.send", True},
+		{bob, "Bob", "gate.command.server", False},
+		{bob, "Bob", "gate.command.glist", True},
+	} {
+		require.Equal(t, tc.want, c.Value(tc.id, tc.name, tc.perm), "%s %s", tc.name, tc.perm)
+	}
+
+	var nilConfig *Config
+	require.Equal(t, Undefined, nilConfig.Value(alice, "alice", "gate.command.server"))
+}
+
+func TestConfig_Validate(t *testing.T) {
+	c := &Config{Players: map[string]*PlayerConfig{"alice": {Groups: []string{"missing"}}}}
+	require.ErrorContains(t, c.Validate(), `unknown group "missing"`)
+	c = &Config{Groups: map[string]*Group{"a": {Inherit: []string{"b"}}}}
+	require.Error(t, c.Validate())
+}
+
+func TestNodeValue(t *testing.T) {
+	nodes := map[string]bool{"*": true, "gate.*": false, "gate.command.server": true}
+	require.Equal(t, True, nodeValue(nodes, "gate.command.server"))
+	require.Equal(t, False, nodeValue(nodes, "gate.command.send"))
+	require.Equal(t, True, nodeValue(nodes, "other"))
+	require.Equal(t, Undefined, nodeValue(nil, "other"))
+}
@@ -8,6 +8,9 @@
 // and may not suffice everyone's requirements.
 // Therefore, Gate also makes no assumptions on whether this package is used or not.
 // Plugins may use their own authorization system internally without a touch on this package.
+//
+// Gate can apply a file-backed Config with groups and per-player overrides,
+// see the "permissions" proxy config.
 package permission
 
 // Func is the permission function to obtain the TriState for a permission.